@@ -13,6 +13,31 @@ func main() {
 	stmts, err := gormschema.New("postgres").Load(
 		&models.User{},
 		&models.RefreshToken{},
+		&models.PasswordResetToken{},
+		&models.AuditLog{},
+		&models.Subscription{},
+		&models.Invoice{},
+		&models.BillingEvent{},
+		&models.DailyUsage{},
+		&models.PromoCode{},
+		&models.PlanGrant{},
+		&models.Conversation{},
+		&models.ConversationTurn{},
+		&models.TurnFeedback{},
+		&models.UserSettings{},
+		&models.UserMemory{},
+		&models.ConversationSummary{},
+		&models.VocabularyCard{},
+		&models.PracticeSession{},
+		&models.LearningGoal{},
+		&models.Notification{},
+		&models.Scenario{},
+		&models.ScenarioLocalization{},
+		&models.ScenarioCompletion{},
+		&models.Character{},
+		&models.CustomCharacter{},
+		&models.UserLanguageProfile{},
+		&models.LevelChange{},
 	)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to load gorm schema: %v\n", err)
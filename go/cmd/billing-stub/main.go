@@ -0,0 +1,104 @@
+// billing-stub stands in for the payment provider during local development.
+// It builds a webhook event, signs it with BILLING_WEBHOOK_SECRET and posts it
+// to the server, so subscription flows can be exercised without a provider account:
+//
+//	go run ./cmd/billing-stub -user <users_id> -sub sub_local_1 -type subscription.created -status trialing -trial-days 7
+//	go run ./cmd/billing-stub -sub sub_local_1 -type invoice.payment_failed
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hiroky1983/talk/go/internal/billing"
+	"github.com/hiroky1983/talk/go/internal/config"
+)
+
+func main() {
+	url := flag.String("url", "http://localhost:8000/webhooks/billing", "webhook endpoint")
+	eventType := flag.String("type", billing.EventSubscriptionCreated, "event type")
+	eventID := flag.String("id", "", "event ID (random when empty; reuse one to test redelivery)")
+	userID := flag.String("user", "", "users_id the subscription belongs to (subscription events)")
+	subscriptionID := flag.String("sub", "sub_local_1", "provider subscription ID")
+	plan := flag.String("plan", "premium", "subscription plan (lite, premium)")
+	status := flag.String("status", "active", "subscription status (trialing, active, past_due, canceled)")
+	trialDays := flag.Int("trial-days", 0, "trial length in days")
+	periodDays := flag.Int("period-days", 30, "length of the current billing period in days")
+	amount := flag.Int64("amount", 980, "invoice amount in the currency's minor unit")
+	currency := flag.String("currency", "jpy", "invoice currency")
+	created := flag.Duration("age", 0, "how long ago the event was created (to test out of order delivery)")
+	flag.Parse()
+
+	config.LoadEnv()
+	secret := os.Getenv("BILLING_WEBHOOK_SECRET")
+	if secret == "" {
+		fail("BILLING_WEBHOOK_SECRET is not set")
+	}
+
+	now := time.Now()
+	createdAt := now.Add(-*created)
+	periodEnd := now.Add(time.Duration(*periodDays) * 24 * time.Hour)
+	if *eventID == "" {
+		*eventID = "evt_" + uuid.NewString()
+	}
+
+	event := billing.Event{ID: *eventID, Type: *eventType, Created: createdAt.Unix()}
+	switch *eventType {
+	case billing.EventInvoicePaid, billing.EventInvoicePaymentFailed:
+		event.Data.Invoice = &billing.InvoiceObject{
+			ID:             "in_" + uuid.NewString(),
+			SubscriptionID: *subscriptionID,
+			AmountDue:      *amount,
+			Currency:       *currency,
+			PeriodStart:    now.Unix(),
+			PeriodEnd:      periodEnd.Unix(),
+		}
+	default:
+		subscription := &billing.SubscriptionObject{
+			ID:               *subscriptionID,
+			UserID:           *userID,
+			Plan:             *plan,
+			Status:           *status,
+			CurrentPeriodEnd: periodEnd.Unix(),
+		}
+		if *trialDays > 0 {
+			subscription.TrialEnd = now.Add(time.Duration(*trialDays) * 24 * time.Hour).Unix()
+		}
+		event.Data.Subscription = subscription
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		fail(err.Error())
+	}
+	req, err := http.NewRequest(http.MethodPost, *url, bytes.NewReader(payload))
+	if err != nil {
+		fail(err.Error())
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(billing.SignatureHeader, billing.Sign([]byte(secret), now, payload))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		fail(err.Error())
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+
+	fmt.Printf("%s %s -> %s %s\n", event.Type, event.ID, resp.Status, bytes.TrimSpace(body))
+	if resp.StatusCode >= 300 {
+		os.Exit(1)
+	}
+}
+
+func fail(msg string) {
+	fmt.Fprintln(os.Stderr, "billing-stub:", msg)
+	os.Exit(1)
+}
@@ -0,0 +1,426 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/mistake"
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/scenario"
+	"github.com/hiroky1983/talk/go/internal/search"
+)
+
+// defaultReason is recorded in the audit log when no -reason is given
+const defaultReason = "talkctl"
+
+// userOutput is the JSON shape of a user printed by talkctl
+type userOutput struct {
+	ID         string     `json:"id"`
+	Email      string     `json:"email"`
+	Username   string     `json:"username"`
+	Plan       string     `json:"plan"`
+	Role       string     `json:"role"`
+	DisabledAt *time.Time `json:"disabled_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+func newUserOutput(user *models.User) userOutput {
+	return userOutput{
+		ID:         user.UsersID,
+		Email:      user.Email,
+		Username:   user.Username,
+		Plan:       string(user.Plan),
+		Role:       string(user.Role),
+		DisabledAt: user.DisabledAt,
+		CreatedAt:  user.CreatedAt,
+	}
+}
+
+func (c *cli) printUser(user *models.User) error {
+	out := newUserOutput(user)
+	return c.print(out, func(w io.Writer) {
+		fmt.Fprintf(w, "ID:\t%s\n", out.ID)
+		fmt.Fprintf(w, "Email:\t%s\n", out.Email)
+		fmt.Fprintf(w, "Username:\t%s\n", out.Username)
+		fmt.Fprintf(w, "Plan:\t%s\n", out.Plan)
+		fmt.Fprintf(w, "Role:\t%s\n", out.Role)
+		if out.DisabledAt != nil {
+			fmt.Fprintf(w, "Disabled at:\t%s\n", out.DisabledAt.Format(time.RFC3339))
+		}
+	})
+}
+
+// findUser resolves a user by ID, or by email when the value contains "@"
+func (c *cli) findUser(ctx context.Context, idOrEmail string) (*models.User, error) {
+	if strings.Contains(idOrEmail, "@") {
+		return c.users.GetUserByEmail(ctx, idOrEmail)
+	}
+	return c.users.GetUserByID(ctx, idOrEmail)
+}
+
+func parsePlan(value string) (models.UserPlan, error) {
+	plan := models.UserPlan(strings.ToUpper(value))
+	if !strings.HasPrefix(string(plan), "PLAN_") {
+		plan = "PLAN_" + plan
+	}
+	switch plan {
+	case models.PlanFree, models.PlanLite, models.PlanPremium:
+		return plan, nil
+	}
+	return "", fmt.Errorf("unknown plan %q (want free, lite or premium)", value)
+}
+
+func requireFlag(name, value string) error {
+	if value == "" {
+		return fmt.Errorf("-%s is required", name)
+	}
+	return nil
+}
+
+func runCreateUser(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("create-user")
+	email := fs.String("email", "", "email address (required)")
+	username := fs.String("username", "", "display name (required)")
+	password := fs.String("password", "", "initial password")
+	passwordStdin := fs.Bool("password-stdin", false, "read the password from the first line of stdin")
+	planName := fs.String("plan", "", "initial plan (free, lite, premium)")
+	admin := fs.Bool("admin", false, "grant the admin role")
+	reason := fs.String("reason", defaultReason, "reason recorded in the audit log")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	if *passwordStdin {
+		line, err := bufio.NewReader(c.stdin).ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("failed to read password: %w", err)
+		}
+		*password = strings.TrimRight(line, "\r\n")
+	}
+	for _, f := range []struct{ name, value string }{{"email", *email}, {"username", *username}, {"password", *password}} {
+		if err := requireFlag(f.name, f.value); err != nil {
+			return err
+		}
+	}
+
+	var plan models.UserPlan
+	if *planName != "" {
+		parsed, err := parsePlan(*planName)
+		if err != nil {
+			return err
+		}
+		plan = parsed
+	}
+
+	user, err := c.users.CreateUser(ctx, *email, *password, *username)
+	if err != nil {
+		return err
+	}
+	if plan != "" && plan != user.Plan {
+		if user, err = c.admin.UpdateUserPlan(ctx, "", user.UsersID, plan, *reason); err != nil {
+			return err
+		}
+	}
+	if *admin {
+		if user, err = c.admin.SetUserRole(ctx, "", user.UsersID, models.RoleAdmin, *reason); err != nil {
+			return err
+		}
+	}
+	return c.printUser(user)
+}
+
+func runSetPlan(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("set-plan")
+	userRef := fs.String("user", "", "user ID or email (required)")
+	planName := fs.String("plan", "", "new plan: free, lite or premium (required)")
+	reason := fs.String("reason", defaultReason, "reason recorded in the audit log")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("user", *userRef); err != nil {
+		return err
+	}
+	plan, err := parsePlan(*planName)
+	if err != nil {
+		return err
+	}
+
+	user, err := c.findUser(ctx, *userRef)
+	if err != nil {
+		return err
+	}
+	user, err = c.admin.UpdateUserPlan(ctx, "", user.UsersID, plan, *reason)
+	if err != nil {
+		return err
+	}
+	return c.printUser(user)
+}
+
+func runPromoteAdmin(ctx context.Context, c *cli, args []string) error {
+	return setRole(ctx, c, "promote-admin", models.RoleAdmin, args)
+}
+
+func runDemoteAdmin(ctx context.Context, c *cli, args []string) error {
+	return setRole(ctx, c, "demote-admin", models.RoleUser, args)
+}
+
+func setRole(ctx context.Context, c *cli, name string, role models.UserRole, args []string) error {
+	fs := newFlagSet(name)
+	userRef := fs.String("user", "", "user ID or email (required)")
+	reason := fs.String("reason", defaultReason, "reason recorded in the audit log")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("user", *userRef); err != nil {
+		return err
+	}
+
+	user, err := c.findUser(ctx, *userRef)
+	if err != nil {
+		return err
+	}
+	user, err = c.admin.SetUserRole(ctx, "", user.UsersID, role, *reason)
+	if err != nil {
+		return err
+	}
+	return c.printUser(user)
+}
+
+func runRevokeTokens(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("revoke-tokens")
+	userRef := fs.String("user", "", "user ID or email (required)")
+	reason := fs.String("reason", defaultReason, "reason recorded in the audit log")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("user", *userRef); err != nil {
+		return err
+	}
+
+	user, err := c.findUser(ctx, *userRef)
+	if err != nil {
+		return err
+	}
+	revoked, err := c.admin.RevokeSessions(ctx, "", user.UsersID, *reason)
+	if err != nil {
+		return err
+	}
+
+	out := struct {
+		UserID  string `json:"user_id"`
+		Revoked int64  `json:"revoked_sessions"`
+	}{user.UsersID, revoked}
+	return c.print(out, func(w io.Writer) {
+		fmt.Fprintf(w, "Revoked %d sessions of %s\n", revoked, user.Email)
+	})
+}
+
+func runPurgeExpired(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("purge-expired")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	purged, err := c.admin.PurgeExpired(ctx)
+	if err != nil {
+		return err
+	}
+	return c.print(purged, func(w io.Writer) {
+		fmt.Fprintf(w, "Refresh tokens:\t%d\n", purged.RefreshTokens)
+		fmt.Fprintf(w, "Password reset tokens:\t%d\n", purged.PasswordResetTokens)
+	})
+}
+
+func runPurgeRetention(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("purge-retention")
+	dryRun := fs.Bool("dry-run", false, "only report what would be deleted")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	report, err := c.purger.Purge(ctx, *dryRun)
+	if err != nil {
+		return err
+	}
+	return c.print(report, func(w io.Writer) {
+		if report.DryRun {
+			fmt.Fprintln(w, "Dry run, nothing was deleted")
+		}
+		fmt.Fprintf(w, "Turns with audio:\t%d\n", report.AudioTurns)
+		fmt.Fprintf(w, "Conversations:\t%d\n", report.Conversations)
+	})
+}
+
+func runStats(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("stats")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	stats, err := c.admin.GetUserStats(ctx)
+	if err != nil {
+		return err
+	}
+	return c.print(stats, func(w io.Writer) {
+		fmt.Fprintf(w, "Users:\t%d\n", stats.TotalUsers)
+		for _, plan := range sortedKeys(stats.UsersByPlan) {
+			fmt.Fprintf(w, "  %s:\t%d\n", plan, stats.UsersByPlan[plan])
+		}
+		fmt.Fprintf(w, "Admins:\t%d\n", stats.Admins)
+		fmt.Fprintf(w, "Disabled users:\t%d\n", stats.DisabledUsers)
+		fmt.Fprintf(w, "New users (7 days):\t%d\n", stats.NewUsersLastWeek)
+		fmt.Fprintf(w, "Active sessions:\t%d\n", stats.ActiveSessions)
+	})
+}
+
+// reindexBatchSize is the number of turns or feedback items reindexed per query
+const reindexBatchSize = 500
+
+func runReindexTranscripts(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("reindex-transcripts")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	var reindexed, updated int64
+	afterID := ""
+	for {
+		turns, err := c.conversations.ListTurns(ctx, afterID, reindexBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, turn := range turns {
+			reindexed++
+			lexemes := search.Lexemes(turn.UserTranscript, turn.AIText)
+			if lexemes == turn.SearchText {
+				continue
+			}
+			if err := c.conversations.UpdateSearchText(ctx, turn.ConversationTurnsID, lexemes); err != nil {
+				return err
+			}
+			updated++
+		}
+		if len(turns) < reindexBatchSize {
+			break
+		}
+		afterID = turns[len(turns)-1].ConversationTurnsID
+	}
+
+	out := struct {
+		Turns   int64 `json:"turns"`
+		Updated int64 `json:"updated"`
+	}{reindexed, updated}
+	return c.print(out, func(w io.Writer) {
+		fmt.Fprintf(w, "Reindexed %d turns (%d updated)\n", reindexed, updated)
+	})
+}
+
+func runReindexMistakes(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("reindex-mistakes")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	var reindexed, updated int64
+	afterID := ""
+	for {
+		feedback, err := c.mistakes.ListFeedback(ctx, afterID, reindexBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, f := range feedback {
+			reindexed++
+			pattern := mistake.Pattern(f.Original, f.Correction)
+			if pattern == f.Pattern {
+				continue
+			}
+			if err := c.mistakes.UpdateFeedbackPattern(ctx, f.TurnFeedbacksID, pattern); err != nil {
+				return err
+			}
+			updated++
+		}
+		if len(feedback) < reindexBatchSize {
+			break
+		}
+		afterID = feedback[len(feedback)-1].TurnFeedbacksID
+	}
+
+	out := struct {
+		Feedback int64 `json:"feedback"`
+		Updated  int64 `json:"updated"`
+	}{reindexed, updated}
+	return c.print(out, func(w io.Writer) {
+		fmt.Fprintf(w, "Reindexed %d feedback items (%d updated)\n", reindexed, updated)
+	})
+}
+
+func runRetrySummaries(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("retry-summaries")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	retried, err := c.summaries.RetryFailedSummaries(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	out := struct {
+		Retried int64 `json:"retried"`
+	}{retried}
+	return c.print(out, func(w io.Writer) {
+		fmt.Fprintf(w, "Scheduled %d failed summaries again\n", retried)
+	})
+}
+
+func runImportScenarios(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("import-scenarios")
+	file := fs.String("file", "", "catalog JSON file (default: the catalog shipped with the server)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	var entries []scenario.Entry
+	var err error
+	if *file == "" {
+		entries, err = scenario.DefaultCatalog()
+	} else {
+		var f *os.File
+		f, err = os.Open(*file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		entries, err = scenario.ParseCatalog(f)
+	}
+	if err != nil {
+		return err
+	}
+
+	scenarios := make([]*models.Scenario, 0, len(entries))
+	for i := range entries {
+		s, err := entries[i].Model(i)
+		if err != nil {
+			return err
+		}
+		scenarios = append(scenarios, s)
+	}
+	counts, err := c.scenarios.ImportScenarios(ctx, scenarios)
+	if err != nil {
+		return err
+	}
+
+	out := struct {
+		Created int `json:"created"`
+		Updated int `json:"updated"`
+		Retired int `json:"retired"`
+	}{counts.Created, counts.Updated, counts.Retired}
+	return c.print(out, func(w io.Writer) {
+		fmt.Fprintf(w, "Imported %d scenarios (%d created, %d updated, %d retired)\n", len(scenarios), counts.Created, counts.Updated, counts.Retired)
+	})
+}
@@ -0,0 +1,192 @@
+// talkctl is the operator tool for the talk backend.
+// It talks directly to the database using the same configuration as the server,
+// so it behaves the same locally and inside the Docker container:
+//
+//	go run ./cmd/talkctl stats
+//	docker compose exec app go run ./cmd/talkctl -json stats
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/config"
+	"github.com/hiroky1983/talk/go/internal/database"
+	"github.com/hiroky1983/talk/go/internal/gateway"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"github.com/hiroky1983/talk/go/internal/retention"
+	"github.com/hiroky1983/talk/go/internal/storage"
+	"gorm.io/gorm/logger"
+)
+
+// errUsage signals that the command line was invalid and usage has been printed
+var errUsage = errors.New("invalid usage")
+
+type command struct {
+	name    string
+	summary string
+	run     func(ctx context.Context, c *cli, args []string) error
+}
+
+var commands = []command{
+	{"create-user", "Create a user account", runCreateUser},
+	{"set-plan", "Change the plan of a user", runSetPlan},
+	{"promote-admin", "Grant the admin role to a user", runPromoteAdmin},
+	{"demote-admin", "Revoke the admin role from a user", runDemoteAdmin},
+	{"revoke-tokens", "Revoke every session (refresh token) of a user", runRevokeTokens},
+	{"purge-expired", "Delete expired tokens", runPurgeExpired},
+	{"purge-retention", "Delete recorded audio and transcripts past their retention", runPurgeRetention},
+	{"stats", "Print usage statistics", runStats},
+	{"reindex-transcripts", "Rebuild the search index of conversation transcripts", runReindexTranscripts},
+	{"reindex-mistakes", "Rebuild the mistake patterns of stored feedback", runReindexMistakes},
+	{"retry-summaries", "Schedule failed conversation summaries again", runRetrySummaries},
+	{"import-scenarios", "Create or update role-play scenarios from a catalog file", runImportScenarios},
+}
+
+// cli holds the dependencies shared by every command
+type cli struct {
+	users         repository.UserRepository
+	admin         repository.AdminRepository
+	conversations repository.ConversationRepository
+	summaries     repository.SummaryRepository
+	mistakes      repository.MistakeRepository
+	scenarios     repository.ScenarioRepository
+	purger        *retention.Purger
+	jsonOutput    bool
+	stdout        io.Writer
+	stdin         io.Reader
+}
+
+func main() {
+	flag.Usage = usage
+	jsonOutput := flag.Bool("json", false, "print results as JSON")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+	cmd, ok := findCommand(flag.Arg(0))
+	if !ok {
+		fmt.Fprintf(os.Stderr, "talkctl: unknown command %q\n\n", flag.Arg(0))
+		usage()
+		os.Exit(2)
+	}
+
+	config.LoadEnv()
+	db, err := database.NewGormDB()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "talkctl:", err)
+		os.Exit(1)
+	}
+	// Keep stdout clean for -json output: only SQL warnings, written to stderr
+	db.Logger = logger.New(log.New(os.Stderr, "", log.LstdFlags), logger.Config{
+		SlowThreshold:             time.Second,
+		LogLevel:                  logger.Warn,
+		IgnoreRecordNotFoundError: true,
+		ParameterizedQueries:      true,
+	})
+
+	blobs, err := storage.NewFileStore(storage.DirFromEnv())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "talkctl:", err)
+		os.Exit(1)
+	}
+	policies, err := retention.LoadPolicies()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "talkctl:", err)
+		os.Exit(1)
+	}
+
+	c := &cli{
+		users:         gateway.NewUserRepository(db),
+		admin:         gateway.NewAdminRepository(db),
+		conversations: gateway.NewConversationRepository(db),
+		summaries:     gateway.NewSummaryRepository(db),
+		mistakes:      gateway.NewMistakeRepository(db),
+		scenarios:     gateway.NewScenarioRepository(db),
+		purger:        retention.NewPurger(gateway.NewRetentionRepository(db), blobs, policies),
+		jsonOutput:    *jsonOutput,
+		stdout:        os.Stdout,
+		stdin:         os.Stdin,
+	}
+	if err := cmd.run(context.Background(), c, flag.Args()[1:]); err != nil {
+		if errors.Is(err, errUsage) {
+			os.Exit(2)
+		}
+		fmt.Fprintf(os.Stderr, "talkctl %s: %v\n", cmd.name, err)
+		os.Exit(1)
+	}
+}
+
+func findCommand(name string) (command, bool) {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+	return command{}, false
+}
+
+func usage() {
+	w := tabwriter.NewWriter(os.Stderr, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "Usage: talkctl [-json] <command> [flags]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %s\t%s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Run 'talkctl <command> -h' for command flags.")
+	w.Flush()
+}
+
+// newFlagSet creates a flag set for a subcommand that reports parse errors as errUsage
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet("talkctl "+name, flag.ContinueOnError)
+	fs.SetOutput(os.Stderr)
+	return fs
+}
+
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "unexpected arguments: %v\n", fs.Args())
+		fs.Usage()
+		return errUsage
+	}
+	return nil
+}
+
+// print writes v as indented JSON when -json is set, otherwise it calls text
+func (c *cli) print(v any, text func(w io.Writer)) error {
+	if c.jsonOutput {
+		enc := json.NewEncoder(c.stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+	w := tabwriter.NewWriter(c.stdout, 0, 4, 2, ' ', 0)
+	text(w)
+	return w.Flush()
+}
+
+// sortedKeys returns the keys of a count map in a stable order for text output
+func sortedKeys[K ~string](m map[K]int64) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
@@ -22,6 +22,59 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// What kind of mistake a feedback item corrects
+type FeedbackCategory int32
+
+const (
+	FeedbackCategory_FEEDBACK_CATEGORY_UNSPECIFIED   FeedbackCategory = 0
+	FeedbackCategory_FEEDBACK_CATEGORY_GRAMMAR       FeedbackCategory = 1
+	FeedbackCategory_FEEDBACK_CATEGORY_VOCABULARY    FeedbackCategory = 2
+	FeedbackCategory_FEEDBACK_CATEGORY_PRONUNCIATION FeedbackCategory = 3
+)
+
+// Enum value maps for FeedbackCategory.
+var (
+	FeedbackCategory_name = map[int32]string{
+		0: "FEEDBACK_CATEGORY_UNSPECIFIED",
+		1: "FEEDBACK_CATEGORY_GRAMMAR",
+		2: "FEEDBACK_CATEGORY_VOCABULARY",
+		3: "FEEDBACK_CATEGORY_PRONUNCIATION",
+	}
+	FeedbackCategory_value = map[string]int32{
+		"FEEDBACK_CATEGORY_UNSPECIFIED":   0,
+		"FEEDBACK_CATEGORY_GRAMMAR":       1,
+		"FEEDBACK_CATEGORY_VOCABULARY":    2,
+		"FEEDBACK_CATEGORY_PRONUNCIATION": 3,
+	}
+)
+
+func (x FeedbackCategory) Enum() *FeedbackCategory {
+	p := new(FeedbackCategory)
+	*p = x
+	return p
+}
+
+func (x FeedbackCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeedbackCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_ai_ai_conversation_proto_enumTypes[0].Descriptor()
+}
+
+func (FeedbackCategory) Type() protoreflect.EnumType {
+	return &file_ai_ai_conversation_proto_enumTypes[0]
+}
+
+func (x FeedbackCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeedbackCategory.Descriptor instead.
+func (FeedbackCategory) EnumDescriptor() ([]byte, []int) {
+	return file_ai_ai_conversation_proto_rawDescGZIP(), []int{0}
+}
+
 // AI Conversation message types
 // Request for the StreamChat bidirectional streaming RPC
 type ChatRequest struct {
@@ -139,14 +192,23 @@ func (*ChatRequest_TextMessage) isChatRequest_Content() {}
 func (*ChatRequest_EndOfInput) isChatRequest_Content() {}
 
 type ChatConfiguration struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Language      string                 `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`   // Language code (vi, en, ja)
-	Character     string                 `protobuf:"bytes,4,opt,name=character,proto3" json:"character,omitempty"` // Character type (friend, parent, sister)
-	Plan          Plan                   `protobuf:"varint,5,opt,name=plan,proto3,enum=ai.v1.Plan" json:"plan,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UserId              string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username            string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Language            string                 `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`   // Language code (vi, en, ja)
+	Character           string                 `protobuf:"bytes,4,opt,name=character,proto3" json:"character,omitempty"` // Character type (friend, parent, sister)
+	Plan                Plan                   `protobuf:"varint,5,opt,name=plan,proto3,enum=ai.v1.Plan" json:"plan,omitempty"`
+	History             []*HistoryTurn         `protobuf:"bytes,6,rep,name=history,proto3" json:"history,omitempty"`                                                     // Earlier turns of a resumed conversation, oldest first
+	Memories            []string               `protobuf:"bytes,7,rep,name=memories,proto3" json:"memories,omitempty"`                                                   // Facts remembered about the user, most recently updated first
+	PrivacyMode         bool                   `protobuf:"varint,8,opt,name=privacy_mode,json=privacyMode,proto3" json:"privacy_mode,omitempty"`                         // The user wants nothing recorded; do not log or store the conversation's content
+	NativeLanguage      string                 `protobuf:"bytes,9,opt,name=native_language,json=nativeLanguage,proto3" json:"native_language,omitempty"`                 // Language code the learner reads explanations in, e.g. for feedback
+	Scenario            *Scenario              `protobuf:"bytes,10,opt,name=scenario,proto3" json:"scenario,omitempty"`                                                  // Role-play scenario to play; unset for a free conversation
+	CharacterDefinition *Character             `protobuf:"bytes,11,opt,name=character_definition,json=characterDefinition,proto3" json:"character_definition,omitempty"` // Definition of the character named by character; required, the AI service has no characters of its own
+	PlacementTest       bool                   `protobuf:"varint,12,opt,name=placement_test,json=placementTest,proto3" json:"placement_test,omitempty"`                  // Run a placement test: ask placement_prompts in order and score each answer with placement_score
+	PlacementPrompts    []*PlacementPrompt     `protobuf:"bytes,13,rep,name=placement_prompts,json=placementPrompts,proto3" json:"placement_prompts,omitempty"`          // Graded questions of the placement test, easiest first
+	Difficulty          string                 `protobuf:"bytes,14,opt,name=difficulty,proto3" json:"difficulty,omitempty"`                                              // Learner's level to pitch the conversation at, CEFR_LEVEL_A1 to CEFR_LEVEL_C2; empty while unknown
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *ChatConfiguration) Reset() {
@@ -214,35 +276,93 @@ func (x *ChatConfiguration) GetPlan() Plan {
 	return Plan_PLAN_UNSPECIFIED
 }
 
-// Response for the StreamChat bidirectional streaming RPC
-type ChatResponse struct {
-	state      protoimpl.MessageState `protogen:"open.v1"`
-	ResponseId string                 `protobuf:"bytes,1,opt,name=response_id,json=responseId,proto3" json:"response_id,omitempty"`
-	// Types that are valid to be assigned to Content:
-	//
-	//	*ChatResponse_AudioChunk
-	//	*ChatResponse_TextMessage
-	Content       isChatResponse_Content `protobuf_oneof:"content"`
-	Language      string                 `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+func (x *ChatConfiguration) GetHistory() []*HistoryTurn {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *ChatConfiguration) GetMemories() []string {
+	if x != nil {
+		return x.Memories
+	}
+	return nil
+}
+
+func (x *ChatConfiguration) GetPrivacyMode() bool {
+	if x != nil {
+		return x.PrivacyMode
+	}
+	return false
+}
+
+func (x *ChatConfiguration) GetNativeLanguage() string {
+	if x != nil {
+		return x.NativeLanguage
+	}
+	return ""
+}
+
+func (x *ChatConfiguration) GetScenario() *Scenario {
+	if x != nil {
+		return x.Scenario
+	}
+	return nil
+}
+
+func (x *ChatConfiguration) GetCharacterDefinition() *Character {
+	if x != nil {
+		return x.CharacterDefinition
+	}
+	return nil
+}
+
+func (x *ChatConfiguration) GetPlacementTest() bool {
+	if x != nil {
+		return x.PlacementTest
+	}
+	return false
+}
+
+func (x *ChatConfiguration) GetPlacementPrompts() []*PlacementPrompt {
+	if x != nil {
+		return x.PlacementPrompts
+	}
+	return nil
+}
+
+func (x *ChatConfiguration) GetDifficulty() string {
+	if x != nil {
+		return x.Difficulty
+	}
+	return ""
+}
+
+// A question of the placement test, written in the conversation's language
+type PlacementPrompt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromptId      string                 `protobuf:"bytes,1,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
+	Level         string                 `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"` // CEFR_LEVEL_A1 to CEFR_LEVEL_C2
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChatResponse) Reset() {
-	*x = ChatResponse{}
+func (x *PlacementPrompt) Reset() {
+	*x = PlacementPrompt{}
 	mi := &file_ai_ai_conversation_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChatResponse) String() string {
+func (x *PlacementPrompt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatResponse) ProtoMessage() {}
+func (*PlacementPrompt) ProtoMessage() {}
 
-func (x *ChatResponse) ProtoReflect() protoreflect.Message {
+func (x *PlacementPrompt) ProtoReflect() protoreflect.Message {
 	mi := &file_ai_ai_conversation_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -254,101 +374,1000 @@ func (x *ChatResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatResponse.ProtoReflect.Descriptor instead.
-func (*ChatResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlacementPrompt.ProtoReflect.Descriptor instead.
+func (*PlacementPrompt) Descriptor() ([]byte, []int) {
 	return file_ai_ai_conversation_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ChatResponse) GetResponseId() string {
+func (x *PlacementPrompt) GetPromptId() string {
 	if x != nil {
-		return x.ResponseId
+		return x.PromptId
 	}
 	return ""
 }
 
-func (x *ChatResponse) GetContent() isChatResponse_Content {
+func (x *PlacementPrompt) GetLevel() string {
 	if x != nil {
-		return x.Content
+		return x.Level
 	}
-	return nil
+	return ""
 }
 
-func (x *ChatResponse) GetAudioChunk() []byte {
+func (x *PlacementPrompt) GetText() string {
 	if x != nil {
-		if x, ok := x.Content.(*ChatResponse_AudioChunk); ok {
-			return x.AudioChunk
+		return x.Text
+	}
+	return ""
+}
+
+// The rubric of the learner's answer to a placement prompt, each criterion from 0 to 4
+type PlacementScore struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PromptId       string                 `protobuf:"bytes,1,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
+	Grammar        int32                  `protobuf:"varint,2,opt,name=grammar,proto3" json:"grammar,omitempty"`
+	Vocabulary     int32                  `protobuf:"varint,3,opt,name=vocabulary,proto3" json:"vocabulary,omitempty"`
+	Fluency        int32                  `protobuf:"varint,4,opt,name=fluency,proto3" json:"fluency,omitempty"`
+	TaskCompletion int32                  `protobuf:"varint,5,opt,name=task_completion,json=taskCompletion,proto3" json:"task_completion,omitempty"` // How fully the answer addresses the prompt
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PlacementScore) Reset() {
+	*x = PlacementScore{}
+	mi := &file_ai_ai_conversation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlacementScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacementScore) ProtoMessage() {}
+
+func (x *PlacementScore) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_ai_conversation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ChatResponse) GetTextMessage() string {
+// Deprecated: Use PlacementScore.ProtoReflect.Descriptor instead.
+func (*PlacementScore) Descriptor() ([]byte, []int) {
+	return file_ai_ai_conversation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PlacementScore) GetPromptId() string {
 	if x != nil {
-		if x, ok := x.Content.(*ChatResponse_TextMessage); ok {
-			return x.TextMessage
+		return x.PromptId
+	}
+	return ""
+}
+
+func (x *PlacementScore) GetGrammar() int32 {
+	if x != nil {
+		return x.Grammar
+	}
+	return 0
+}
+
+func (x *PlacementScore) GetVocabulary() int32 {
+	if x != nil {
+		return x.Vocabulary
+	}
+	return 0
+}
+
+func (x *PlacementScore) GetFluency() int32 {
+	if x != nil {
+		return x.Fluency
+	}
+	return 0
+}
+
+func (x *PlacementScore) GetTaskCompletion() int32 {
+	if x != nil {
+		return x.TaskCompletion
+	}
+	return 0
+}
+
+// A conversation partner from the character catalog
+type Character struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"` // In the learner's native language
+	Persona       string                 `protobuf:"bytes,3,opt,name=persona,proto3" json:"persona,omitempty"`                            // Who the character is and how they speak
+	Voice         string                 `protobuf:"bytes,4,opt,name=voice,proto3" json:"voice,omitempty"`                                // Voice ID for the conversation's language; empty leaves the choice to the AI service
+	Gender        string                 `protobuf:"bytes,5,opt,name=gender,proto3" json:"gender,omitempty"`                              // CHARACTER_GENDER_FEMALE, _MALE or _NEUTRAL
+	AgeBand       string                 `protobuf:"bytes,6,opt,name=age_band,json=ageBand,proto3" json:"age_band,omitempty"`             // CHARACTER_AGE_BAND_CHILD, _TEEN, _YOUNG_ADULT, _ADULT or _SENIOR
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Character) Reset() {
+	*x = Character{}
+	mi := &file_ai_ai_conversation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Character) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Character) ProtoMessage() {}
+
+func (x *Character) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_ai_conversation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Character.ProtoReflect.Descriptor instead.
+func (*Character) Descriptor() ([]byte, []int) {
+	return file_ai_ai_conversation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Character) GetKey() string {
+	if x != nil {
+		return x.Key
 	}
 	return ""
 }
 
-func (x *ChatResponse) GetLanguage() string {
+func (x *Character) GetDisplayName() string {
 	if x != nil {
-		return x.Language
+		return x.DisplayName
 	}
 	return ""
 }
 
-func (x *ChatResponse) GetTimestamp() *timestamppb.Timestamp {
+func (x *Character) GetPersona() string {
 	if x != nil {
-		return x.Timestamp
+		return x.Persona
 	}
-	return nil
+	return ""
 }
 
-type isChatResponse_Content interface {
-	isChatResponse_Content()
+func (x *Character) GetVoice() string {
+	if x != nil {
+		return x.Voice
+	}
+	return ""
 }
 
-type ChatResponse_AudioChunk struct {
-	AudioChunk []byte `protobuf:"bytes,2,opt,name=audio_chunk,json=audioChunk,proto3,oneof"` // Streaming audio response
+func (x *Character) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
 }
 
-type ChatResponse_TextMessage struct {
-	TextMessage string `protobuf:"bytes,3,opt,name=text_message,json=textMessage,proto3,oneof"` // Streaming text/transcript response
+func (x *Character) GetAgeBand() string {
+	if x != nil {
+		return x.AgeBand
+	}
+	return ""
 }
 
-func (*ChatResponse_AudioChunk) isChatResponse_Content() {}
+// A role-play situation written in the language of the conversation
+type Scenario struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScenarioId    string                 `protobuf:"bytes,1,opt,name=scenario_id,json=scenarioId,proto3" json:"scenario_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Difficulty    string                 `protobuf:"bytes,4,opt,name=difficulty,proto3" json:"difficulty,omitempty"`                      // SCENARIO_DIFFICULTY_BEGINNER, _INTERMEDIATE or _ADVANCED
+	Goals         []string               `protobuf:"bytes,5,rep,name=goals,proto3" json:"goals,omitempty"`                                // What the learner should accomplish, in order
+	Vocabulary    []string               `protobuf:"bytes,6,rep,name=vocabulary,proto3" json:"vocabulary,omitempty"`                      // Words and phrases the learner should use
+	SetupPrompt   string                 `protobuf:"bytes,7,opt,name=setup_prompt,json=setupPrompt,proto3" json:"setup_prompt,omitempty"` // Instructions for playing the scenario
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-func (*ChatResponse_TextMessage) isChatResponse_Content() {}
+func (x *Scenario) Reset() {
+	*x = Scenario{}
+	mi := &file_ai_ai_conversation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-var File_ai_ai_conversation_proto protoreflect.FileDescriptor
+func (x *Scenario) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-const file_ai_ai_conversation_proto_rawDesc = "" +
-	"\n" +
-	"\x18ai/ai_conversation.proto\x12\x05ai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\rai/user.proto\"\xb6\x01\n" +
-	"\vChatRequest\x120\n" +
-	"\x05setup\x18\x01 \x01(\v2\x18.ai.v1.ChatConfigurationH\x00R\x05setup\x12!\n" +
-	"\vaudio_chunk\x18\x02 \x01(\fH\x00R\n" +
-	"audioChunk\x12#\n" +
-	"\ftext_message\x18\x03 \x01(\tH\x00R\vtextMessage\x12\"\n" +
-	"\fend_of_input\x18\x04 \x01(\bH\x00R\n" +
-	"endOfInputB\t\n" +
-	"\acontent\"\xa3\x01\n" +
-	"\x11ChatConfiguration\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
-	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
-	"\blanguage\x18\x03 \x01(\tR\blanguage\x12\x1c\n" +
-	"\tcharacter\x18\x04 \x01(\tR\tcharacter\x12\x1f\n" +
-	"\x04plan\x18\x05 \x01(\x0e2\v.ai.v1.PlanR\x04plan\"\xd8\x01\n" +
-	"\fChatResponse\x12\x1f\n" +
-	"\vresponse_id\x18\x01 \x01(\tR\n" +
-	"responseId\x12!\n" +
-	"\vaudio_chunk\x18\x02 \x01(\fH\x00R\n" +
-	"audioChunk\x12#\n" +
-	"\ftext_message\x18\x03 \x01(\tH\x00R\vtextMessage\x12\x1a\n" +
-	"\blanguage\x18\x04 \x01(\tR\blanguage\x128\n" +
-	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestampB\t\n" +
-	"\acontentB\x80\x01\n" +
+func (*Scenario) ProtoMessage() {}
+
+func (x *Scenario) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_ai_conversation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scenario.ProtoReflect.Descriptor instead.
+func (*Scenario) Descriptor() ([]byte, []int) {
+	return file_ai_ai_conversation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Scenario) GetScenarioId() string {
+	if x != nil {
+		return x.ScenarioId
+	}
+	return ""
+}
+
+func (x *Scenario) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Scenario) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Scenario) GetDifficulty() string {
+	if x != nil {
+		return x.Difficulty
+	}
+	return ""
+}
+
+func (x *Scenario) GetGoals() []string {
+	if x != nil {
+		return x.Goals
+	}
+	return nil
+}
+
+func (x *Scenario) GetVocabulary() []string {
+	if x != nil {
+		return x.Vocabulary
+	}
+	return nil
+}
+
+func (x *Scenario) GetSetupPrompt() string {
+	if x != nil {
+		return x.SetupPrompt
+	}
+	return ""
+}
+
+// Reports that the learner accomplished a goal of the scenario
+type ScenarioGoal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // Index in ChatConfiguration.scenario.goals
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScenarioGoal) Reset() {
+	*x = ScenarioGoal{}
+	mi := &file_ai_ai_conversation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScenarioGoal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScenarioGoal) ProtoMessage() {}
+
+func (x *ScenarioGoal) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_ai_conversation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScenarioGoal.ProtoReflect.Descriptor instead.
+func (*ScenarioGoal) Descriptor() ([]byte, []int) {
+	return file_ai_ai_conversation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ScenarioGoal) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+// A previous turn of a resumed conversation
+type HistoryTurn struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserTranscript string                 `protobuf:"bytes,1,opt,name=user_transcript,json=userTranscript,proto3" json:"user_transcript,omitempty"`
+	AiText         string                 `protobuf:"bytes,2,opt,name=ai_text,json=aiText,proto3" json:"ai_text,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *HistoryTurn) Reset() {
+	*x = HistoryTurn{}
+	mi := &file_ai_ai_conversation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryTurn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryTurn) ProtoMessage() {}
+
+func (x *HistoryTurn) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_ai_conversation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryTurn.ProtoReflect.Descriptor instead.
+func (*HistoryTurn) Descriptor() ([]byte, []int) {
+	return file_ai_ai_conversation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *HistoryTurn) GetUserTranscript() string {
+	if x != nil {
+		return x.UserTranscript
+	}
+	return ""
+}
+
+func (x *HistoryTurn) GetAiText() string {
+	if x != nil {
+		return x.AiText
+	}
+	return ""
+}
+
+// Response for the StreamChat bidirectional streaming RPC
+type ChatResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ResponseId string                 `protobuf:"bytes,1,opt,name=response_id,json=responseId,proto3" json:"response_id,omitempty"`
+	// Types that are valid to be assigned to Content:
+	//
+	//	*ChatResponse_AudioChunk
+	//	*ChatResponse_TextMessage
+	//	*ChatResponse_UserTranscript
+	//	*ChatResponse_Memory
+	//	*ChatResponse_Feedback
+	//	*ChatResponse_GoalAchieved
+	//	*ChatResponse_PlacementScore
+	Content       isChatResponse_Content `protobuf_oneof:"content"`
+	Language      string                 `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatResponse) Reset() {
+	*x = ChatResponse{}
+	mi := &file_ai_ai_conversation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatResponse) ProtoMessage() {}
+
+func (x *ChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_ai_conversation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatResponse.ProtoReflect.Descriptor instead.
+func (*ChatResponse) Descriptor() ([]byte, []int) {
+	return file_ai_ai_conversation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ChatResponse) GetResponseId() string {
+	if x != nil {
+		return x.ResponseId
+	}
+	return ""
+}
+
+func (x *ChatResponse) GetContent() isChatResponse_Content {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ChatResponse) GetAudioChunk() []byte {
+	if x != nil {
+		if x, ok := x.Content.(*ChatResponse_AudioChunk); ok {
+			return x.AudioChunk
+		}
+	}
+	return nil
+}
+
+func (x *ChatResponse) GetTextMessage() string {
+	if x != nil {
+		if x, ok := x.Content.(*ChatResponse_TextMessage); ok {
+			return x.TextMessage
+		}
+	}
+	return ""
+}
+
+func (x *ChatResponse) GetUserTranscript() string {
+	if x != nil {
+		if x, ok := x.Content.(*ChatResponse_UserTranscript); ok {
+			return x.UserTranscript
+		}
+	}
+	return ""
+}
+
+func (x *ChatResponse) GetMemory() string {
+	if x != nil {
+		if x, ok := x.Content.(*ChatResponse_Memory); ok {
+			return x.Memory
+		}
+	}
+	return ""
+}
+
+func (x *ChatResponse) GetFeedback() *Feedback {
+	if x != nil {
+		if x, ok := x.Content.(*ChatResponse_Feedback); ok {
+			return x.Feedback
+		}
+	}
+	return nil
+}
+
+func (x *ChatResponse) GetGoalAchieved() *ScenarioGoal {
+	if x != nil {
+		if x, ok := x.Content.(*ChatResponse_GoalAchieved); ok {
+			return x.GoalAchieved
+		}
+	}
+	return nil
+}
+
+func (x *ChatResponse) GetPlacementScore() *PlacementScore {
+	if x != nil {
+		if x, ok := x.Content.(*ChatResponse_PlacementScore); ok {
+			return x.PlacementScore
+		}
+	}
+	return nil
+}
+
+func (x *ChatResponse) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ChatResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type isChatResponse_Content interface {
+	isChatResponse_Content()
+}
+
+type ChatResponse_AudioChunk struct {
+	AudioChunk []byte `protobuf:"bytes,2,opt,name=audio_chunk,json=audioChunk,proto3,oneof"` // Streaming audio response
+}
+
+type ChatResponse_TextMessage struct {
+	TextMessage string `protobuf:"bytes,3,opt,name=text_message,json=textMessage,proto3,oneof"` // Streaming text/transcript response
+}
+
+type ChatResponse_UserTranscript struct {
+	UserTranscript string `protobuf:"bytes,6,opt,name=user_transcript,json=userTranscript,proto3,oneof"` // Transcript of the user's speech in the current turn
+}
+
+type ChatResponse_Memory struct {
+	Memory string `protobuf:"bytes,7,opt,name=memory,proto3,oneof"` // A short fact about the user proposed to be remembered in later conversations
+}
+
+type ChatResponse_Feedback struct {
+	Feedback *Feedback `protobuf:"bytes,8,opt,name=feedback,proto3,oneof"` // A correction of what the user said in the current turn
+}
+
+type ChatResponse_GoalAchieved struct {
+	GoalAchieved *ScenarioGoal `protobuf:"bytes,9,opt,name=goal_achieved,json=goalAchieved,proto3,oneof"` // The user accomplished a goal of the scenario
+}
+
+type ChatResponse_PlacementScore struct {
+	PlacementScore *PlacementScore `protobuf:"bytes,10,opt,name=placement_score,json=placementScore,proto3,oneof"` // The user finished answering a placement prompt
+}
+
+func (*ChatResponse_AudioChunk) isChatResponse_Content() {}
+
+func (*ChatResponse_TextMessage) isChatResponse_Content() {}
+
+func (*ChatResponse_UserTranscript) isChatResponse_Content() {}
+
+func (*ChatResponse_Memory) isChatResponse_Content() {}
+
+func (*ChatResponse_Feedback) isChatResponse_Content() {}
+
+func (*ChatResponse_GoalAchieved) isChatResponse_Content() {}
+
+func (*ChatResponse_PlacementScore) isChatResponse_Content() {}
+
+// A correction of the learner's speech: "you said X, a native would say Y"
+type Feedback struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Original      string                 `protobuf:"bytes,1,opt,name=original,proto3" json:"original,omitempty"`     // Span of the user's transcript that is wrong
+	Correction    string                 `protobuf:"bytes,2,opt,name=correction,proto3" json:"correction,omitempty"` // What a native speaker would say instead
+	Category      FeedbackCategory       `protobuf:"varint,3,opt,name=category,proto3,enum=ai.v1.FeedbackCategory" json:"category,omitempty"`
+	Explanation   string                 `protobuf:"bytes,4,opt,name=explanation,proto3" json:"explanation,omitempty"` // Why, in ChatConfiguration.native_language
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Feedback) Reset() {
+	*x = Feedback{}
+	mi := &file_ai_ai_conversation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Feedback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Feedback) ProtoMessage() {}
+
+func (x *Feedback) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_ai_conversation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Feedback.ProtoReflect.Descriptor instead.
+func (*Feedback) Descriptor() ([]byte, []int) {
+	return file_ai_ai_conversation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Feedback) GetOriginal() string {
+	if x != nil {
+		return x.Original
+	}
+	return ""
+}
+
+func (x *Feedback) GetCorrection() string {
+	if x != nil {
+		return x.Correction
+	}
+	return ""
+}
+
+func (x *Feedback) GetCategory() FeedbackCategory {
+	if x != nil {
+		return x.Category
+	}
+	return FeedbackCategory_FEEDBACK_CATEGORY_UNSPECIFIED
+}
+
+func (x *Feedback) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+// Request for the Summarize RPC
+type SummarizeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Language       string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"` // Language the learner practiced
+	Character      string                 `protobuf:"bytes,3,opt,name=character,proto3" json:"character,omitempty"`
+	Turns          []*HistoryTurn         `protobuf:"bytes,4,rep,name=turns,proto3" json:"turns,omitempty"` // Every turn of the conversation, oldest first
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SummarizeRequest) Reset() {
+	*x = SummarizeRequest{}
+	mi := &file_ai_ai_conversation_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SummarizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SummarizeRequest) ProtoMessage() {}
+
+func (x *SummarizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_ai_conversation_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SummarizeRequest.ProtoReflect.Descriptor instead.
+func (*SummarizeRequest) Descriptor() ([]byte, []int) {
+	return file_ai_ai_conversation_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SummarizeRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *SummarizeRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *SummarizeRequest) GetCharacter() string {
+	if x != nil {
+		return x.Character
+	}
+	return ""
+}
+
+func (x *SummarizeRequest) GetTurns() []*HistoryTurn {
+	if x != nil {
+		return x.Turns
+	}
+	return nil
+}
+
+// A word or phrase the learner met in a conversation
+type VocabularyItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Term          string                 `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	Meaning       string                 `protobuf:"bytes,2,opt,name=meaning,proto3" json:"meaning,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyItem) Reset() {
+	*x = VocabularyItem{}
+	mi := &file_ai_ai_conversation_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyItem) ProtoMessage() {}
+
+func (x *VocabularyItem) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_ai_conversation_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyItem.ProtoReflect.Descriptor instead.
+func (*VocabularyItem) Descriptor() ([]byte, []int) {
+	return file_ai_ai_conversation_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *VocabularyItem) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *VocabularyItem) GetMeaning() string {
+	if x != nil {
+		return x.Meaning
+	}
+	return ""
+}
+
+// A mistake the learner made and how to say it correctly
+type Mistake struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Original      string                 `protobuf:"bytes,1,opt,name=original,proto3" json:"original,omitempty"`
+	Correction    string                 `protobuf:"bytes,2,opt,name=correction,proto3" json:"correction,omitempty"`
+	Explanation   string                 `protobuf:"bytes,3,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mistake) Reset() {
+	*x = Mistake{}
+	mi := &file_ai_ai_conversation_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mistake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mistake) ProtoMessage() {}
+
+func (x *Mistake) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_ai_conversation_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mistake.ProtoReflect.Descriptor instead.
+func (*Mistake) Descriptor() ([]byte, []int) {
+	return file_ai_ai_conversation_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Mistake) GetOriginal() string {
+	if x != nil {
+		return x.Original
+	}
+	return ""
+}
+
+func (x *Mistake) GetCorrection() string {
+	if x != nil {
+		return x.Correction
+	}
+	return ""
+}
+
+func (x *Mistake) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+// Recap of a conversation for the learner to review
+type SummarizeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topics        []string               `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+	Vocabulary    []*VocabularyItem      `protobuf:"bytes,2,rep,name=vocabulary,proto3" json:"vocabulary,omitempty"`
+	Mistakes      []*Mistake             `protobuf:"bytes,3,rep,name=mistakes,proto3" json:"mistakes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SummarizeResponse) Reset() {
+	*x = SummarizeResponse{}
+	mi := &file_ai_ai_conversation_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SummarizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SummarizeResponse) ProtoMessage() {}
+
+func (x *SummarizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_ai_conversation_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SummarizeResponse.ProtoReflect.Descriptor instead.
+func (*SummarizeResponse) Descriptor() ([]byte, []int) {
+	return file_ai_ai_conversation_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SummarizeResponse) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *SummarizeResponse) GetVocabulary() []*VocabularyItem {
+	if x != nil {
+		return x.Vocabulary
+	}
+	return nil
+}
+
+func (x *SummarizeResponse) GetMistakes() []*Mistake {
+	if x != nil {
+		return x.Mistakes
+	}
+	return nil
+}
+
+var File_ai_ai_conversation_proto protoreflect.FileDescriptor
+
+const file_ai_ai_conversation_proto_rawDesc = "" +
+	"\n" +
+	"\x18ai/ai_conversation.proto\x12\x05ai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\rai/user.proto\"\xb6\x01\n" +
+	"\vChatRequest\x120\n" +
+	"\x05setup\x18\x01 \x01(\v2\x18.ai.v1.ChatConfigurationH\x00R\x05setup\x12!\n" +
+	"\vaudio_chunk\x18\x02 \x01(\fH\x00R\n" +
+	"audioChunk\x12#\n" +
+	"\ftext_message\x18\x03 \x01(\tH\x00R\vtextMessage\x12\"\n" +
+	"\fend_of_input\x18\x04 \x01(\bH\x00R\n" +
+	"endOfInputB\t\n" +
+	"\acontent\"\xb7\x04\n" +
+	"\x11ChatConfiguration\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\blanguage\x18\x03 \x01(\tR\blanguage\x12\x1c\n" +
+	"\tcharacter\x18\x04 \x01(\tR\tcharacter\x12\x1f\n" +
+	"\x04plan\x18\x05 \x01(\x0e2\v.ai.v1.PlanR\x04plan\x12,\n" +
+	"\ahistory\x18\x06 \x03(\v2\x12.ai.v1.HistoryTurnR\ahistory\x12\x1a\n" +
+	"\bmemories\x18\a \x03(\tR\bmemories\x12!\n" +
+	"\fprivacy_mode\x18\b \x01(\bR\vprivacyMode\x12'\n" +
+	"\x0fnative_language\x18\t \x01(\tR\x0enativeLanguage\x12+\n" +
+	"\bscenario\x18\n" +
+	" \x01(\v2\x0f.ai.v1.ScenarioR\bscenario\x12C\n" +
+	"\x14character_definition\x18\v \x01(\v2\x10.ai.v1.CharacterR\x13characterDefinition\x12%\n" +
+	"\x0eplacement_test\x18\f \x01(\bR\rplacementTest\x12C\n" +
+	"\x11placement_prompts\x18\r \x03(\v2\x16.ai.v1.PlacementPromptR\x10placementPrompts\x12\x1e\n" +
+	"\n" +
+	"difficulty\x18\x0e \x01(\tR\n" +
+	"difficulty\"X\n" +
+	"\x0fPlacementPrompt\x12\x1b\n" +
+	"\tprompt_id\x18\x01 \x01(\tR\bpromptId\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\tR\x05level\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\"\xaa\x01\n" +
+	"\x0ePlacementScore\x12\x1b\n" +
+	"\tprompt_id\x18\x01 \x01(\tR\bpromptId\x12\x18\n" +
+	"\agrammar\x18\x02 \x01(\x05R\agrammar\x12\x1e\n" +
+	"\n" +
+	"vocabulary\x18\x03 \x01(\x05R\n" +
+	"vocabulary\x12\x18\n" +
+	"\afluency\x18\x04 \x01(\x05R\afluency\x12'\n" +
+	"\x0ftask_completion\x18\x05 \x01(\x05R\x0etaskCompletion\"\xa3\x01\n" +
+	"\tCharacter\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x18\n" +
+	"\apersona\x18\x03 \x01(\tR\apersona\x12\x14\n" +
+	"\x05voice\x18\x04 \x01(\tR\x05voice\x12\x16\n" +
+	"\x06gender\x18\x05 \x01(\tR\x06gender\x12\x19\n" +
+	"\bage_band\x18\x06 \x01(\tR\aageBand\"\xdc\x01\n" +
+	"\bScenario\x12\x1f\n" +
+	"\vscenario_id\x18\x01 \x01(\tR\n" +
+	"scenarioId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1e\n" +
+	"\n" +
+	"difficulty\x18\x04 \x01(\tR\n" +
+	"difficulty\x12\x14\n" +
+	"\x05goals\x18\x05 \x03(\tR\x05goals\x12\x1e\n" +
+	"\n" +
+	"vocabulary\x18\x06 \x03(\tR\n" +
+	"vocabulary\x12!\n" +
+	"\fsetup_prompt\x18\a \x01(\tR\vsetupPrompt\"$\n" +
+	"\fScenarioGoal\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\"O\n" +
+	"\vHistoryTurn\x12'\n" +
+	"\x0fuser_transcript\x18\x01 \x01(\tR\x0euserTranscript\x12\x17\n" +
+	"\aai_text\x18\x02 \x01(\tR\x06aiText\"\xca\x03\n" +
+	"\fChatResponse\x12\x1f\n" +
+	"\vresponse_id\x18\x01 \x01(\tR\n" +
+	"responseId\x12!\n" +
+	"\vaudio_chunk\x18\x02 \x01(\fH\x00R\n" +
+	"audioChunk\x12#\n" +
+	"\ftext_message\x18\x03 \x01(\tH\x00R\vtextMessage\x12)\n" +
+	"\x0fuser_transcript\x18\x06 \x01(\tH\x00R\x0euserTranscript\x12\x18\n" +
+	"\x06memory\x18\a \x01(\tH\x00R\x06memory\x12-\n" +
+	"\bfeedback\x18\b \x01(\v2\x0f.ai.v1.FeedbackH\x00R\bfeedback\x12:\n" +
+	"\rgoal_achieved\x18\t \x01(\v2\x13.ai.v1.ScenarioGoalH\x00R\fgoalAchieved\x12@\n" +
+	"\x0fplacement_score\x18\n" +
+	" \x01(\v2\x15.ai.v1.PlacementScoreH\x00R\x0eplacementScore\x12\x1a\n" +
+	"\blanguage\x18\x04 \x01(\tR\blanguage\x128\n" +
+	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestampB\t\n" +
+	"\acontent\"\x9d\x01\n" +
+	"\bFeedback\x12\x1a\n" +
+	"\boriginal\x18\x01 \x01(\tR\boriginal\x12\x1e\n" +
+	"\n" +
+	"correction\x18\x02 \x01(\tR\n" +
+	"correction\x123\n" +
+	"\bcategory\x18\x03 \x01(\x0e2\x17.ai.v1.FeedbackCategoryR\bcategory\x12 \n" +
+	"\vexplanation\x18\x04 \x01(\tR\vexplanation\"\x9f\x01\n" +
+	"\x10SummarizeRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1a\n" +
+	"\blanguage\x18\x02 \x01(\tR\blanguage\x12\x1c\n" +
+	"\tcharacter\x18\x03 \x01(\tR\tcharacter\x12(\n" +
+	"\x05turns\x18\x04 \x03(\v2\x12.ai.v1.HistoryTurnR\x05turns\">\n" +
+	"\x0eVocabularyItem\x12\x12\n" +
+	"\x04term\x18\x01 \x01(\tR\x04term\x12\x18\n" +
+	"\ameaning\x18\x02 \x01(\tR\ameaning\"g\n" +
+	"\aMistake\x12\x1a\n" +
+	"\boriginal\x18\x01 \x01(\tR\boriginal\x12\x1e\n" +
+	"\n" +
+	"correction\x18\x02 \x01(\tR\n" +
+	"correction\x12 \n" +
+	"\vexplanation\x18\x03 \x01(\tR\vexplanation\"\x8e\x01\n" +
+	"\x11SummarizeResponse\x12\x16\n" +
+	"\x06topics\x18\x01 \x03(\tR\x06topics\x125\n" +
+	"\n" +
+	"vocabulary\x18\x02 \x03(\v2\x15.ai.v1.VocabularyItemR\n" +
+	"vocabulary\x12*\n" +
+	"\bmistakes\x18\x03 \x03(\v2\x0e.ai.v1.MistakeR\bmistakes*\x9b\x01\n" +
+	"\x10FeedbackCategory\x12!\n" +
+	"\x1dFEEDBACK_CATEGORY_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19FEEDBACK_CATEGORY_GRAMMAR\x10\x01\x12 \n" +
+	"\x1cFEEDBACK_CATEGORY_VOCABULARY\x10\x02\x12#\n" +
+	"\x1fFEEDBACK_CATEGORY_PRONUNCIATION\x10\x03B\x80\x01\n" +
 	"\tcom.ai.v1B\x13AiConversationProtoP\x01Z)github.com/hiroky1983/talk/go/gen/ai;aiv1\xa2\x02\x03AXX\xaa\x02\x05Ai.V1\xca\x02\x05Ai\\V1\xe2\x02\x11Ai\\V1\\GPBMetadata\xea\x02\x06Ai::V1b\x06proto3"
 
 var (
@@ -363,23 +1382,47 @@ func file_ai_ai_conversation_proto_rawDescGZIP() []byte {
 	return file_ai_ai_conversation_proto_rawDescData
 }
 
-var file_ai_ai_conversation_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_ai_ai_conversation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ai_ai_conversation_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_ai_ai_conversation_proto_goTypes = []any{
-	(*ChatRequest)(nil),           // 0: ai.v1.ChatRequest
-	(*ChatConfiguration)(nil),     // 1: ai.v1.ChatConfiguration
-	(*ChatResponse)(nil),          // 2: ai.v1.ChatResponse
-	(Plan)(0),                     // 3: ai.v1.Plan
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(FeedbackCategory)(0),         // 0: ai.v1.FeedbackCategory
+	(*ChatRequest)(nil),           // 1: ai.v1.ChatRequest
+	(*ChatConfiguration)(nil),     // 2: ai.v1.ChatConfiguration
+	(*PlacementPrompt)(nil),       // 3: ai.v1.PlacementPrompt
+	(*PlacementScore)(nil),        // 4: ai.v1.PlacementScore
+	(*Character)(nil),             // 5: ai.v1.Character
+	(*Scenario)(nil),              // 6: ai.v1.Scenario
+	(*ScenarioGoal)(nil),          // 7: ai.v1.ScenarioGoal
+	(*HistoryTurn)(nil),           // 8: ai.v1.HistoryTurn
+	(*ChatResponse)(nil),          // 9: ai.v1.ChatResponse
+	(*Feedback)(nil),              // 10: ai.v1.Feedback
+	(*SummarizeRequest)(nil),      // 11: ai.v1.SummarizeRequest
+	(*VocabularyItem)(nil),        // 12: ai.v1.VocabularyItem
+	(*Mistake)(nil),               // 13: ai.v1.Mistake
+	(*SummarizeResponse)(nil),     // 14: ai.v1.SummarizeResponse
+	(Plan)(0),                     // 15: ai.v1.Plan
+	(*timestamppb.Timestamp)(nil), // 16: google.protobuf.Timestamp
 }
 var file_ai_ai_conversation_proto_depIdxs = []int32{
-	1, // 0: ai.v1.ChatRequest.setup:type_name -> ai.v1.ChatConfiguration
-	3, // 1: ai.v1.ChatConfiguration.plan:type_name -> ai.v1.Plan
-	4, // 2: ai.v1.ChatResponse.timestamp:type_name -> google.protobuf.Timestamp
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	2,  // 0: ai.v1.ChatRequest.setup:type_name -> ai.v1.ChatConfiguration
+	15, // 1: ai.v1.ChatConfiguration.plan:type_name -> ai.v1.Plan
+	8,  // 2: ai.v1.ChatConfiguration.history:type_name -> ai.v1.HistoryTurn
+	6,  // 3: ai.v1.ChatConfiguration.scenario:type_name -> ai.v1.Scenario
+	5,  // 4: ai.v1.ChatConfiguration.character_definition:type_name -> ai.v1.Character
+	3,  // 5: ai.v1.ChatConfiguration.placement_prompts:type_name -> ai.v1.PlacementPrompt
+	10, // 6: ai.v1.ChatResponse.feedback:type_name -> ai.v1.Feedback
+	7,  // 7: ai.v1.ChatResponse.goal_achieved:type_name -> ai.v1.ScenarioGoal
+	4,  // 8: ai.v1.ChatResponse.placement_score:type_name -> ai.v1.PlacementScore
+	16, // 9: ai.v1.ChatResponse.timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 10: ai.v1.Feedback.category:type_name -> ai.v1.FeedbackCategory
+	8,  // 11: ai.v1.SummarizeRequest.turns:type_name -> ai.v1.HistoryTurn
+	12, // 12: ai.v1.SummarizeResponse.vocabulary:type_name -> ai.v1.VocabularyItem
+	13, // 13: ai.v1.SummarizeResponse.mistakes:type_name -> ai.v1.Mistake
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_ai_ai_conversation_proto_init() }
@@ -394,22 +1437,28 @@ func file_ai_ai_conversation_proto_init() {
 		(*ChatRequest_TextMessage)(nil),
 		(*ChatRequest_EndOfInput)(nil),
 	}
-	file_ai_ai_conversation_proto_msgTypes[2].OneofWrappers = []any{
+	file_ai_ai_conversation_proto_msgTypes[8].OneofWrappers = []any{
 		(*ChatResponse_AudioChunk)(nil),
 		(*ChatResponse_TextMessage)(nil),
+		(*ChatResponse_UserTranscript)(nil),
+		(*ChatResponse_Memory)(nil),
+		(*ChatResponse_Feedback)(nil),
+		(*ChatResponse_GoalAchieved)(nil),
+		(*ChatResponse_PlacementScore)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ai_ai_conversation_proto_rawDesc), len(file_ai_ai_conversation_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      1,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_ai_ai_conversation_proto_goTypes,
 		DependencyIndexes: file_ai_ai_conversation_proto_depIdxs,
+		EnumInfos:         file_ai_ai_conversation_proto_enumTypes,
 		MessageInfos:      file_ai_ai_conversation_proto_msgTypes,
 	}.Build()
 	File_ai_ai_conversation_proto = out.File
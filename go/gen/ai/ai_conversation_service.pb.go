@@ -24,21 +24,26 @@ var File_ai_ai_conversation_service_proto protoreflect.FileDescriptor
 
 const file_ai_ai_conversation_service_proto_rawDesc = "" +
 	"\n" +
-	" ai/ai_conversation_service.proto\x12\x05ai.v1\x1a\x18ai/ai_conversation.proto2T\n" +
+	" ai/ai_conversation_service.proto\x12\x05ai.v1\x1a\x18ai/ai_conversation.proto2\x96\x01\n" +
 	"\x15AIConversationService\x12;\n" +
 	"\n" +
-	"StreamChat\x12\x12.ai.v1.ChatRequest\x1a\x13.ai.v1.ChatResponse\"\x00(\x010\x01B\x87\x01\n" +
+	"StreamChat\x12\x12.ai.v1.ChatRequest\x1a\x13.ai.v1.ChatResponse\"\x00(\x010\x01\x12@\n" +
+	"\tSummarize\x12\x17.ai.v1.SummarizeRequest\x1a\x18.ai.v1.SummarizeResponse\"\x00B\x87\x01\n" +
 	"\tcom.ai.v1B\x1aAiConversationServiceProtoP\x01Z)github.com/hiroky1983/talk/go/gen/ai;aiv1\xa2\x02\x03AXX\xaa\x02\x05Ai.V1\xca\x02\x05Ai\\V1\xe2\x02\x11Ai\\V1\\GPBMetadata\xea\x02\x06Ai::V1b\x06proto3"
 
 var file_ai_ai_conversation_service_proto_goTypes = []any{
-	(*ChatRequest)(nil),  // 0: ai.v1.ChatRequest
-	(*ChatResponse)(nil), // 1: ai.v1.ChatResponse
+	(*ChatRequest)(nil),       // 0: ai.v1.ChatRequest
+	(*SummarizeRequest)(nil),  // 1: ai.v1.SummarizeRequest
+	(*ChatResponse)(nil),      // 2: ai.v1.ChatResponse
+	(*SummarizeResponse)(nil), // 3: ai.v1.SummarizeResponse
 }
 var file_ai_ai_conversation_service_proto_depIdxs = []int32{
 	0, // 0: ai.v1.AIConversationService.StreamChat:input_type -> ai.v1.ChatRequest
-	1, // 1: ai.v1.AIConversationService.StreamChat:output_type -> ai.v1.ChatResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
+	1, // 1: ai.v1.AIConversationService.Summarize:input_type -> ai.v1.SummarizeRequest
+	2, // 2: ai.v1.AIConversationService.StreamChat:output_type -> ai.v1.ChatResponse
+	3, // 3: ai.v1.AIConversationService.Summarize:output_type -> ai.v1.SummarizeResponse
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
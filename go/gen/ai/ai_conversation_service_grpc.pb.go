@@ -20,6 +20,7 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	AIConversationService_StreamChat_FullMethodName = "/ai.v1.AIConversationService/StreamChat"
+	AIConversationService_Summarize_FullMethodName  = "/ai.v1.AIConversationService/Summarize"
 )
 
 // AIConversationServiceClient is the client API for AIConversationService service.
@@ -31,6 +32,8 @@ type AIConversationServiceClient interface {
 	// Sends a message to the AI and receives a streaming response
 	// Establishes a bidirectional stream for conversation (audio/text)
 	StreamChat(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ChatRequest, ChatResponse], error)
+	// Summarizes a finished conversation into topics, vocabulary and mistakes
+	Summarize(ctx context.Context, in *SummarizeRequest, opts ...grpc.CallOption) (*SummarizeResponse, error)
 }
 
 type aIConversationServiceClient struct {
@@ -54,6 +57,16 @@ func (c *aIConversationServiceClient) StreamChat(ctx context.Context, opts ...gr
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type AIConversationService_StreamChatClient = grpc.BidiStreamingClient[ChatRequest, ChatResponse]
 
+func (c *aIConversationServiceClient) Summarize(ctx context.Context, in *SummarizeRequest, opts ...grpc.CallOption) (*SummarizeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SummarizeResponse)
+	err := c.cc.Invoke(ctx, AIConversationService_Summarize_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AIConversationServiceServer is the server API for AIConversationService service.
 // All implementations must embed UnimplementedAIConversationServiceServer
 // for forward compatibility.
@@ -63,6 +76,8 @@ type AIConversationServiceServer interface {
 	// Sends a message to the AI and receives a streaming response
 	// Establishes a bidirectional stream for conversation (audio/text)
 	StreamChat(grpc.BidiStreamingServer[ChatRequest, ChatResponse]) error
+	// Summarizes a finished conversation into topics, vocabulary and mistakes
+	Summarize(context.Context, *SummarizeRequest) (*SummarizeResponse, error)
 	mustEmbedUnimplementedAIConversationServiceServer()
 }
 
@@ -76,6 +91,9 @@ type UnimplementedAIConversationServiceServer struct{}
 func (UnimplementedAIConversationServiceServer) StreamChat(grpc.BidiStreamingServer[ChatRequest, ChatResponse]) error {
 	return status.Error(codes.Unimplemented, "method StreamChat not implemented")
 }
+func (UnimplementedAIConversationServiceServer) Summarize(context.Context, *SummarizeRequest) (*SummarizeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Summarize not implemented")
+}
 func (UnimplementedAIConversationServiceServer) mustEmbedUnimplementedAIConversationServiceServer() {}
 func (UnimplementedAIConversationServiceServer) testEmbeddedByValue()                               {}
 
@@ -104,13 +122,36 @@ func _AIConversationService_StreamChat_Handler(srv interface{}, stream grpc.Serv
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type AIConversationService_StreamChatServer = grpc.BidiStreamingServer[ChatRequest, ChatResponse]
 
+func _AIConversationService_Summarize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SummarizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIConversationServiceServer).Summarize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIConversationService_Summarize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIConversationServiceServer).Summarize(ctx, req.(*SummarizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AIConversationService_ServiceDesc is the grpc.ServiceDesc for AIConversationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var AIConversationService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "ai.v1.AIConversationService",
 	HandlerType: (*AIConversationServiceServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Summarize",
+			Handler:    _AIConversationService_Summarize_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "StreamChat",
@@ -37,12 +37,17 @@ const (
 	UserServiceCreateUserProcedure = "/app.v1.UserService/CreateUser"
 	// UserServiceGetUserProcedure is the fully-qualified name of the UserService's GetUser RPC.
 	UserServiceGetUserProcedure = "/app.v1.UserService/GetUser"
+	// UserServiceResetPasswordProcedure is the fully-qualified name of the UserService's ResetPassword
+	// RPC.
+	UserServiceResetPasswordProcedure = "/app.v1.UserService/ResetPassword"
 )
 
 // UserServiceClient is a client for the app.v1.UserService service.
 type UserServiceClient interface {
 	CreateUser(context.Context, *connect.Request[app.User]) (*connect.Response[app.User], error)
 	GetUser(context.Context, *connect.Request[app.GetUserRequest]) (*connect.Response[app.User], error)
+	// ResetPassword sets a new password with a reset token and signs out every session
+	ResetPassword(context.Context, *connect.Request[app.ResetPasswordRequest]) (*connect.Response[app.ResetPasswordResponse], error)
 }
 
 // NewUserServiceClient constructs a client for the app.v1.UserService service. By default, it uses
@@ -68,13 +73,20 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("GetUser")),
 			connect.WithClientOptions(opts...),
 		),
+		resetPassword: connect.NewClient[app.ResetPasswordRequest, app.ResetPasswordResponse](
+			httpClient,
+			baseURL+UserServiceResetPasswordProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ResetPassword")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // userServiceClient implements UserServiceClient.
 type userServiceClient struct {
-	createUser *connect.Client[app.User, app.User]
-	getUser    *connect.Client[app.GetUserRequest, app.User]
+	createUser    *connect.Client[app.User, app.User]
+	getUser       *connect.Client[app.GetUserRequest, app.User]
+	resetPassword *connect.Client[app.ResetPasswordRequest, app.ResetPasswordResponse]
 }
 
 // CreateUser calls app.v1.UserService.CreateUser.
@@ -87,10 +99,17 @@ func (c *userServiceClient) GetUser(ctx context.Context, req *connect.Request[ap
 	return c.getUser.CallUnary(ctx, req)
 }
 
+// ResetPassword calls app.v1.UserService.ResetPassword.
+func (c *userServiceClient) ResetPassword(ctx context.Context, req *connect.Request[app.ResetPasswordRequest]) (*connect.Response[app.ResetPasswordResponse], error) {
+	return c.resetPassword.CallUnary(ctx, req)
+}
+
 // UserServiceHandler is an implementation of the app.v1.UserService service.
 type UserServiceHandler interface {
 	CreateUser(context.Context, *connect.Request[app.User]) (*connect.Response[app.User], error)
 	GetUser(context.Context, *connect.Request[app.GetUserRequest]) (*connect.Response[app.User], error)
+	// ResetPassword sets a new password with a reset token and signs out every session
+	ResetPassword(context.Context, *connect.Request[app.ResetPasswordRequest]) (*connect.Response[app.ResetPasswordResponse], error)
 }
 
 // NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -112,12 +131,20 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("GetUser")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceResetPasswordHandler := connect.NewUnaryHandler(
+		UserServiceResetPasswordProcedure,
+		svc.ResetPassword,
+		connect.WithSchema(userServiceMethods.ByName("ResetPassword")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/app.v1.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UserServiceCreateUserProcedure:
 			userServiceCreateUserHandler.ServeHTTP(w, r)
 		case UserServiceGetUserProcedure:
 			userServiceGetUserHandler.ServeHTTP(w, r)
+		case UserServiceResetPasswordProcedure:
+			userServiceResetPasswordHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -134,3 +161,7 @@ func (UnimplementedUserServiceHandler) CreateUser(context.Context, *connect.Requ
 func (UnimplementedUserServiceHandler) GetUser(context.Context, *connect.Request[app.GetUserRequest]) (*connect.Response[app.User], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("app.v1.UserService.GetUser is not implemented"))
 }
+
+func (UnimplementedUserServiceHandler) ResetPassword(context.Context, *connect.Request[app.ResetPasswordRequest]) (*connect.Response[app.ResetPasswordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("app.v1.UserService.ResetPassword is not implemented"))
+}
@@ -0,0 +1,62 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+)
+
+// Format describes raw PCM audio
+type Format struct {
+	SampleRate    int
+	Channels      int
+	BitsPerSample int
+}
+
+var (
+	// UserFormat is the PCM format the browser streams the learner's microphone in
+	UserFormat = Format{SampleRate: 16000, Channels: 1, BitsPerSample: 16}
+	// AIFormat is the PCM format of the AI service's audio_chunk responses
+	AIFormat = Format{SampleRate: 24000, Channels: 1, BitsPerSample: 16}
+)
+
+// BytesPerSecond returns the data rate of the format
+func (f Format) BytesPerSecond() int {
+	return f.SampleRate * f.Channels * f.BitsPerSample / 8
+}
+
+// wavHeaderSize is the size of a canonical 44 byte RIFF/WAVE header
+const wavHeaderSize = 44
+
+// WAVHeader returns the RIFF/WAVE header for dataSize bytes of PCM data in format f
+func WAVHeader(f Format, dataSize int) []byte {
+	blockAlign := f.Channels * f.BitsPerSample / 8
+	header := make([]byte, 0, wavHeaderSize)
+	header = append(header, "RIFF"...)
+	header = binary.LittleEndian.AppendUint32(header, uint32(wavHeaderSize-8+dataSize))
+	header = append(header, "WAVE"...)
+	header = append(header, "fmt "...)
+	header = binary.LittleEndian.AppendUint32(header, 16) // fmt chunk size
+	header = binary.LittleEndian.AppendUint16(header, 1)  // PCM
+	header = binary.LittleEndian.AppendUint16(header, uint16(f.Channels))
+	header = binary.LittleEndian.AppendUint32(header, uint32(f.SampleRate))
+	header = binary.LittleEndian.AppendUint32(header, uint32(f.BytesPerSecond()))
+	header = binary.LittleEndian.AppendUint16(header, uint16(blockAlign))
+	header = binary.LittleEndian.AppendUint16(header, uint16(f.BitsPerSample))
+	header = append(header, "data"...)
+	header = binary.LittleEndian.AppendUint32(header, uint32(dataSize))
+	return header
+}
+
+// IsWAV reports whether data already starts with a RIFF/WAVE header
+func IsWAV(data []byte) bool {
+	return len(data) >= 12 && bytes.Equal(data[0:4], []byte("RIFF")) && bytes.Equal(data[8:12], []byte("WAVE"))
+}
+
+// WAVReader returns data as a WAV file, adding a header in format f unless data already is one
+func WAVReader(f Format, data []byte) io.Reader {
+	if IsWAV(data) {
+		return bytes.NewReader(data)
+	}
+	return io.MultiReader(bytes.NewReader(WAVHeader(f, len(data))), bytes.NewReader(data))
+}
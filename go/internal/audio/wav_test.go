@@ -0,0 +1,38 @@
+package audio
+
+import (
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWAVHeader_DescribesPCM(t *testing.T) {
+	header := WAVHeader(UserFormat, 32000)
+
+	require.Len(t, header, 44)
+	assert.Equal(t, "RIFF", string(header[0:4]))
+	assert.Equal(t, uint32(36+32000), binary.LittleEndian.Uint32(header[4:8]))
+	assert.Equal(t, "WAVE", string(header[8:12]))
+	assert.Equal(t, uint16(1), binary.LittleEndian.Uint16(header[22:24]))
+	assert.Equal(t, uint32(16000), binary.LittleEndian.Uint32(header[24:28]))
+	assert.Equal(t, uint32(32000), binary.LittleEndian.Uint32(header[28:32]))
+	assert.Equal(t, uint16(2), binary.LittleEndian.Uint16(header[32:34]))
+	assert.Equal(t, uint16(16), binary.LittleEndian.Uint16(header[34:36]))
+	assert.Equal(t, "data", string(header[36:40]))
+	assert.Equal(t, uint32(32000), binary.LittleEndian.Uint32(header[40:44]))
+}
+
+func TestWAVReader_KeepsExistingWAV(t *testing.T) {
+	pcm := []byte{1, 2, 3, 4}
+	wrapped, err := io.ReadAll(WAVReader(AIFormat, pcm))
+	require.NoError(t, err)
+	assert.Len(t, wrapped, 48)
+	assert.True(t, IsWAV(wrapped))
+
+	again, err := io.ReadAll(WAVReader(AIFormat, wrapped))
+	require.NoError(t, err)
+	assert.Equal(t, wrapped, again)
+}
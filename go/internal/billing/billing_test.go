@@ -0,0 +1,89 @@
+package billing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerify_ValidSignature(t *testing.T) {
+	secret := []byte("whsec_test")
+	payload := []byte(`{"id":"evt_1","type":"subscription.created"}`)
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	header := Sign(secret, now, payload)
+
+	assert.NoError(t, Verify(secret, header, payload, now.Add(time.Minute), DefaultTolerance))
+}
+
+func TestVerify_TamperedPayload(t *testing.T) {
+	secret := []byte("whsec_test")
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	header := Sign(secret, now, []byte(`{"plan":"lite"}`))
+
+	assert.ErrorIs(t, Verify(secret, header, []byte(`{"plan":"premium"}`), now, DefaultTolerance), ErrInvalidSignature)
+}
+
+func TestVerify_WrongSecret(t *testing.T) {
+	payload := []byte(`{}`)
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	header := Sign([]byte("other"), now, payload)
+
+	assert.ErrorIs(t, Verify([]byte("whsec_test"), header, payload, now, DefaultTolerance), ErrInvalidSignature)
+}
+
+func TestVerify_Expired(t *testing.T) {
+	secret := []byte("whsec_test")
+	payload := []byte(`{}`)
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	header := Sign(secret, now.Add(-time.Hour), payload)
+
+	assert.ErrorIs(t, Verify(secret, header, payload, now, DefaultTolerance), ErrSignatureExpired)
+}
+
+func TestVerify_MalformedHeader(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, header := range []string{"", "garbage", "t=abc,v1=00", "t=1700000000"} {
+		assert.ErrorIs(t, Verify([]byte("whsec_test"), header, []byte(`{}`), now, DefaultTolerance), ErrInvalidSignature, header)
+	}
+}
+
+func TestTransition(t *testing.T) {
+	tests := []struct {
+		from    models.SubscriptionStatus
+		to      models.SubscriptionStatus
+		allowed bool
+	}{
+		{"", models.SubscriptionTrialing, true},
+		{"", models.SubscriptionActive, true},
+		{models.SubscriptionTrialing, models.SubscriptionActive, true},
+		{models.SubscriptionTrialing, models.SubscriptionCanceled, true},
+		{models.SubscriptionActive, models.SubscriptionPastDue, true},
+		{models.SubscriptionActive, models.SubscriptionTrialing, false},
+		{models.SubscriptionPastDue, models.SubscriptionActive, true},
+		{models.SubscriptionPastDue, models.SubscriptionCanceled, true},
+		{models.SubscriptionCanceled, models.SubscriptionActive, false},
+		{models.SubscriptionCanceled, models.SubscriptionCanceled, true},
+	}
+
+	for _, tt := range tests {
+		err := Transition(tt.from, tt.to)
+		if tt.allowed {
+			assert.NoError(t, err, "%q -> %q", tt.from, tt.to)
+		} else {
+			assert.ErrorIs(t, err, ErrInvalidTransition, "%q -> %q", tt.from, tt.to)
+		}
+	}
+}
+
+func TestEventValidate_MissingObject(t *testing.T) {
+	event := Event{ID: "evt_1", Type: EventInvoicePaid}
+
+	assert.ErrorIs(t, event.Validate(), ErrInvalidEvent)
+}
@@ -0,0 +1,126 @@
+package billing
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+// Event types sent by the payment provider
+const (
+	EventSubscriptionCreated  = "subscription.created"
+	EventSubscriptionUpdated  = "subscription.updated"
+	EventSubscriptionDeleted  = "subscription.deleted"
+	EventInvoicePaid          = "invoice.paid"
+	EventInvoicePaymentFailed = "invoice.payment_failed"
+)
+
+var (
+	// ErrInvalidEvent is returned when an event payload is malformed or refers to unknown values
+	ErrInvalidEvent = errors.New("invalid billing event")
+	// ErrInvalidTransition is returned when an event would move a subscription into a status it cannot reach
+	ErrInvalidTransition = errors.New("invalid subscription status transition")
+)
+
+// Event is the webhook payload sent by the payment provider
+type Event struct {
+	ID      string    `json:"id"`
+	Type    string    `json:"type"`
+	Created int64     `json:"created"` // Unix seconds
+	Data    EventData `json:"data"`
+}
+
+// EventData holds the objects an event refers to
+type EventData struct {
+	Subscription *SubscriptionObject `json:"subscription,omitempty"`
+	Invoice      *InvoiceObject      `json:"invoice,omitempty"`
+}
+
+// SubscriptionObject is the provider's view of a subscription
+type SubscriptionObject struct {
+	ID               string `json:"id"`
+	UserID           string `json:"user_id"` // Our users_id, attached as customer metadata at checkout
+	Plan             string `json:"plan"`
+	Status           string `json:"status"` // trialing, active, past_due, canceled
+	TrialEnd         int64  `json:"trial_end,omitempty"`
+	CurrentPeriodEnd int64  `json:"current_period_end,omitempty"`
+}
+
+// InvoiceObject is the provider's view of an invoice
+type InvoiceObject struct {
+	ID             string `json:"id"`
+	SubscriptionID string `json:"subscription_id"`
+	AmountDue      int64  `json:"amount_due"`
+	Currency       string `json:"currency"`
+	PeriodStart    int64  `json:"period_start,omitempty"`
+	PeriodEnd      int64  `json:"period_end,omitempty"`
+}
+
+// CreatedAt returns the time the provider created the event
+func (e *Event) CreatedAt() time.Time {
+	return time.Unix(e.Created, 0)
+}
+
+// Validate checks that the event carries the object its type requires
+func (e *Event) Validate() error {
+	if e.ID == "" || e.Type == "" {
+		return fmt.Errorf("%w: id and type are required", ErrInvalidEvent)
+	}
+	switch e.Type {
+	case EventSubscriptionCreated, EventSubscriptionUpdated, EventSubscriptionDeleted:
+		if e.Data.Subscription == nil || e.Data.Subscription.ID == "" {
+			return fmt.Errorf("%w: %s requires a subscription", ErrInvalidEvent, e.Type)
+		}
+	case EventInvoicePaid, EventInvoicePaymentFailed:
+		if e.Data.Invoice == nil || e.Data.Invoice.ID == "" || e.Data.Invoice.SubscriptionID == "" {
+			return fmt.Errorf("%w: %s requires an invoice with a subscription", ErrInvalidEvent, e.Type)
+		}
+	}
+	return nil
+}
+
+var providerStatuses = map[string]models.SubscriptionStatus{
+	"trialing": models.SubscriptionTrialing,
+	"active":   models.SubscriptionActive,
+	"past_due": models.SubscriptionPastDue,
+	"canceled": models.SubscriptionCanceled,
+}
+
+// ParseStatus converts a provider status into a subscription status
+func ParseStatus(status string) (models.SubscriptionStatus, error) {
+	parsed, ok := providerStatuses[status]
+	if !ok {
+		return "", fmt.Errorf("%w: unknown subscription status %q", ErrInvalidEvent, status)
+	}
+	return parsed, nil
+}
+
+// allowedTransitions lists the statuses reachable from each status.
+// The empty status stands for a subscription we have not seen before.
+var allowedTransitions = map[models.SubscriptionStatus][]models.SubscriptionStatus{
+	"":                          {models.SubscriptionTrialing, models.SubscriptionActive, models.SubscriptionPastDue, models.SubscriptionCanceled},
+	models.SubscriptionTrialing: {models.SubscriptionTrialing, models.SubscriptionActive, models.SubscriptionPastDue, models.SubscriptionCanceled},
+	models.SubscriptionActive:   {models.SubscriptionActive, models.SubscriptionPastDue, models.SubscriptionCanceled},
+	models.SubscriptionPastDue:  {models.SubscriptionPastDue, models.SubscriptionActive, models.SubscriptionCanceled},
+	models.SubscriptionCanceled: {models.SubscriptionCanceled},
+}
+
+// Transition validates moving a subscription from one status to another
+func Transition(from, to models.SubscriptionStatus) error {
+	for _, allowed := range allowedTransitions[from] {
+		if allowed == to {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %q -> %q", ErrInvalidTransition, from, to)
+}
+
+func unixTime(seconds int64) *time.Time {
+	if seconds == 0 {
+		return nil
+	}
+	t := time.Unix(seconds, 0)
+	return &t
+}
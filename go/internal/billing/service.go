@@ -0,0 +1,189 @@
+package billing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+)
+
+// PlanRefresher recomputes a user's effective plan after their subscription changed
+type PlanRefresher interface {
+	Refresh(ctx context.Context, userID string) (models.UserPlan, error)
+}
+
+// Service applies payment provider events to subscriptions and invoices
+type Service struct {
+	subscriptions repository.SubscriptionRepository
+	plans         PlanRefresher
+	now           func() time.Time
+}
+
+// NewService creates a new billing service
+func NewService(subscriptions repository.SubscriptionRepository, plans PlanRefresher) *Service {
+	return &Service{
+		subscriptions: subscriptions,
+		plans:         plans,
+		now:           time.Now,
+	}
+}
+
+// HandleEvent applies a webhook event. Redelivered and out of order events are ignored,
+// events that would cause an invalid status transition return ErrInvalidTransition.
+func (s *Service) HandleEvent(ctx context.Context, event *Event) error {
+	if err := event.Validate(); err != nil {
+		return err
+	}
+
+	processed, err := s.subscriptions.HasProcessedEvent(ctx, event.ID)
+	if err != nil {
+		return err
+	}
+	if processed {
+		log.Printf("Billing event %s already processed, skipping", event.ID)
+		return nil
+	}
+
+	var userID string
+	switch event.Type {
+	case EventSubscriptionCreated, EventSubscriptionUpdated, EventSubscriptionDeleted:
+		userID, err = s.applySubscription(ctx, event)
+	case EventInvoicePaid, EventInvoicePaymentFailed:
+		userID, err = s.applyInvoice(ctx, event)
+	default:
+		log.Printf("Ignoring billing event %s of unsupported type %s", event.ID, event.Type)
+	}
+	if err != nil {
+		return err
+	}
+
+	if userID != "" {
+		if _, err := s.plans.Refresh(ctx, userID); err != nil {
+			return err
+		}
+	}
+	return s.subscriptions.RecordEvent(ctx, &models.BillingEvent{
+		ProviderEventID: event.ID,
+		Type:            event.Type,
+	})
+}
+
+// applySubscription upserts the subscription described by the event and returns its user ID
+func (s *Service) applySubscription(ctx context.Context, event *Event) (string, error) {
+	obj := event.Data.Subscription
+	status, err := ParseStatus(obj.Status)
+	if err != nil {
+		return "", err
+	}
+	if event.Type == EventSubscriptionDeleted {
+		status = models.SubscriptionCanceled
+	}
+
+	subscription, err := s.subscriptions.GetSubscriptionByProviderID(ctx, obj.ID)
+	switch {
+	case errors.Is(err, repository.ErrSubscriptionNotFound):
+		if obj.UserID == "" {
+			return "", fmt.Errorf("%w: subscription %s has no user_id", ErrInvalidEvent, obj.ID)
+		}
+		subscription = &models.Subscription{
+			UserID:                 obj.UserID,
+			ProviderSubscriptionID: obj.ID,
+		}
+	case err != nil:
+		return "", err
+	case event.CreatedAt().Before(subscription.LastEventAt):
+		log.Printf("Ignoring stale billing event %s for subscription %s", event.ID, obj.ID)
+		return "", nil
+	}
+
+	if err := Transition(subscription.Status, status); err != nil {
+		return "", err
+	}
+	plan, err := parsePlan(obj.Plan)
+	if err != nil {
+		return "", err
+	}
+
+	subscription.Plan = plan
+	subscription.Status = status
+	subscription.TrialEndsAt = unixTime(obj.TrialEnd)
+	subscription.CurrentPeriodEnd = unixTime(obj.CurrentPeriodEnd)
+	subscription.LastEventAt = event.CreatedAt()
+	if status == models.SubscriptionCanceled && subscription.CanceledAt == nil {
+		canceledAt := event.CreatedAt()
+		subscription.CanceledAt = &canceledAt
+	}
+	if err := s.subscriptions.SaveSubscription(ctx, subscription); err != nil {
+		return "", err
+	}
+	log.Printf("Subscription %s of user %s is now %s (%s)", obj.ID, subscription.UserID, status, plan)
+	return subscription.UserID, nil
+}
+
+// applyInvoice records the invoice, moves the subscription between active and past due
+// accordingly and returns the subscription's user ID
+func (s *Service) applyInvoice(ctx context.Context, event *Event) (string, error) {
+	obj := event.Data.Invoice
+	subscription, err := s.subscriptions.GetSubscriptionByProviderID(ctx, obj.SubscriptionID)
+	if err != nil {
+		return "", err
+	}
+
+	invoice := &models.Invoice{
+		SubscriptionID:    subscription.SubscriptionsID,
+		UserID:            subscription.UserID,
+		ProviderInvoiceID: obj.ID,
+		AmountDue:         obj.AmountDue,
+		Currency:          strings.ToUpper(obj.Currency),
+		PeriodStart:       unixTime(obj.PeriodStart),
+		PeriodEnd:         unixTime(obj.PeriodEnd),
+	}
+	status := models.SubscriptionActive
+	if event.Type == EventInvoicePaid {
+		invoice.Status = models.InvoicePaid
+		paidAt := event.CreatedAt()
+		invoice.PaidAt = &paidAt
+	} else {
+		invoice.Status = models.InvoiceFailed
+		status = models.SubscriptionPastDue
+	}
+	if err := s.subscriptions.SaveInvoice(ctx, invoice); err != nil {
+		return "", err
+	}
+
+	// Payments for a canceled subscription (e.g. the final invoice) do not revive it
+	if subscription.Status == models.SubscriptionCanceled || event.CreatedAt().Before(subscription.LastEventAt) {
+		return subscription.UserID, nil
+	}
+	if err := Transition(subscription.Status, status); err != nil {
+		return "", err
+	}
+	subscription.Status = status
+	if invoice.PeriodEnd != nil && event.Type == EventInvoicePaid {
+		subscription.CurrentPeriodEnd = invoice.PeriodEnd
+	}
+	subscription.LastEventAt = event.CreatedAt()
+	if err := s.subscriptions.SaveSubscription(ctx, subscription); err != nil {
+		return "", err
+	}
+	log.Printf("Invoice %s is %s, subscription %s is now %s", obj.ID, invoice.Status, obj.SubscriptionID, status)
+	return subscription.UserID, nil
+}
+
+// parsePlan converts a provider plan name (premium, lite, PLAN_PREMIUM) into a paid plan
+func parsePlan(value string) (models.UserPlan, error) {
+	plan := models.UserPlan(strings.ToUpper(value))
+	if !strings.HasPrefix(string(plan), "PLAN_") {
+		plan = "PLAN_" + plan
+	}
+	switch plan {
+	case models.PlanLite, models.PlanPremium:
+		return plan, nil
+	}
+	return "", fmt.Errorf("%w: unknown subscription plan %q", ErrInvalidEvent, value)
+}
@@ -0,0 +1,71 @@
+package billing
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hiroky1983/talk/go/middleware"
+)
+
+// maxWebhookBodySize limits the size of a webhook payload
+const maxWebhookBodySize = 1 << 20
+
+// WebhookHandler receives signed events from the payment provider
+type WebhookHandler struct {
+	service *Service
+	secret  []byte
+}
+
+// NewWebhookHandler creates a new webhook handler verifying payloads with secret
+func NewWebhookHandler(service *Service, secret string) *WebhookHandler {
+	return &WebhookHandler{
+		service: service,
+		secret:  []byte(secret),
+	}
+}
+
+// HandleWebhook verifies and applies a single event.
+// Only failures worth retrying (database errors) are answered with 5xx.
+func (h *WebhookHandler) HandleWebhook(c *gin.Context) {
+	requestID, _ := middleware.GetRequestID(c)
+
+	payload, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxWebhookBodySize))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read body"})
+		return
+	}
+	if err := Verify(h.secret, c.GetHeader(SignatureHeader), payload, time.Now(), DefaultTolerance); err != nil {
+		log.Printf("[%s] Rejected billing webhook: %v", requestID, err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var event Event
+	if err := json.Unmarshal(payload, &event); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid event payload"})
+		return
+	}
+
+	if err := h.service.HandleEvent(c.Request.Context(), &event); err != nil {
+		if errors.Is(err, ErrInvalidEvent) {
+			log.Printf("[%s] Rejected billing event %s: %v", requestID, event.ID, err)
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, ErrInvalidTransition) {
+			// Retrying would not help, acknowledge so the provider stops redelivering
+			log.Printf("[%s] Ignoring billing event %s: %v", requestID, event.ID, err)
+			c.JSON(http.StatusOK, gin.H{"status": "ignored"})
+			return
+		}
+		log.Printf("[%s] Failed to handle billing event %s: %v", requestID, event.ID, err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to process event"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
@@ -0,0 +1,161 @@
+// Package character validates the conversation characters of the catalog and decides how
+// a character is presented to a user: whether their plan includes it, what it is called in
+// their locale and which voice it speaks a practice language with.
+//
+// Characters are stored in the characters table and edited by admins through AdminService,
+// so personas and voices can change without a release of the server or the AI service.
+package character
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hiroky1983/talk/go/internal/entitlement"
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+const (
+	// MaxPersonaLength is the longest persona in characters
+	MaxPersonaLength = 4000
+	// MaxDisplayNameLength is the longest display name in characters
+	MaxDisplayNameLength = 50
+	// FallbackLocale names a character when it has no display name in the user's locale
+	FallbackLocale = "en"
+	// maxKeyLength and maxLanguageLength match the sizes of their columns
+	maxKeyLength      = 50
+	maxLanguageLength = 10
+)
+
+// Definition is a character as edited by admins, with its voices and display names decoded
+type Definition struct {
+	Key          string
+	Persona      string
+	Gender       models.CharacterGender
+	AgeBand      models.CharacterAgeBand
+	MinPlan      models.UserPlan
+	Voices       map[string]string // Voice IDs by practice language
+	DisplayNames map[string]string // Names by UI locale
+	Position     int
+	Active       bool
+}
+
+// Validate checks that the definition can be stored and played
+func (d *Definition) Validate() error {
+	if d.Key == "" || len(d.Key) > maxKeyLength || strings.Trim(d.Key, "abcdefghijklmnopqrstuvwxyz0123456789-_") != "" {
+		return fmt.Errorf("invalid key %q: use lowercase letters, digits, hyphens and underscores", d.Key)
+	}
+	if strings.TrimSpace(d.Persona) == "" {
+		return errors.New("persona is empty")
+	}
+	if utf8.RuneCountInString(d.Persona) > MaxPersonaLength {
+		return fmt.Errorf("persona exceeds %d characters", MaxPersonaLength)
+	}
+	switch d.Gender {
+	case models.CharacterGenderFemale, models.CharacterGenderMale, models.CharacterGenderNeutral:
+	default:
+		return fmt.Errorf("unknown gender %q", d.Gender)
+	}
+	switch d.AgeBand {
+	case models.CharacterAgeBandChild, models.CharacterAgeBandTeen, models.CharacterAgeBandYoungAdult,
+		models.CharacterAgeBandAdult, models.CharacterAgeBandSenior:
+	default:
+		return fmt.Errorf("unknown age band %q", d.AgeBand)
+	}
+	switch d.MinPlan {
+	case models.PlanFree, models.PlanLite, models.PlanPremium:
+	default:
+		return fmt.Errorf("unknown plan %q", d.MinPlan)
+	}
+	if len(d.Voices) == 0 {
+		return errors.New("no voices")
+	}
+	for language, voice := range d.Voices {
+		if language == "" || len(language) > maxLanguageLength {
+			return fmt.Errorf("invalid language %q", language)
+		}
+		if strings.TrimSpace(voice) == "" {
+			return fmt.Errorf("voice for %s is empty", language)
+		}
+	}
+	if _, ok := d.DisplayNames[FallbackLocale]; !ok {
+		return fmt.Errorf("no display name in %s", FallbackLocale)
+	}
+	for locale, name := range d.DisplayNames {
+		if locale == "" || len(locale) > maxLanguageLength {
+			return fmt.Errorf("invalid locale %q", locale)
+		}
+		if strings.TrimSpace(name) == "" || utf8.RuneCountInString(name) > MaxDisplayNameLength {
+			return fmt.Errorf("display name in %s must have 1 to %d characters", locale, MaxDisplayNameLength)
+		}
+	}
+	return nil
+}
+
+// Apply copies the definition into a character model, encoding its maps
+func (d *Definition) Apply(c *models.Character) error {
+	voices, err := json.Marshal(d.Voices)
+	if err != nil {
+		return err
+	}
+	names, err := json.Marshal(d.DisplayNames)
+	if err != nil {
+		return err
+	}
+	c.Key = d.Key
+	c.Persona = d.Persona
+	c.Gender = d.Gender
+	c.AgeBand = d.AgeBand
+	c.MinPlan = d.MinPlan
+	c.Voices = string(voices)
+	c.DisplayNames = string(names)
+	c.Position = d.Position
+	c.Active = d.Active
+	return nil
+}
+
+// Available reports whether users on plan can talk to the character
+func Available(c *models.Character, plan models.UserPlan) bool {
+	return !entitlement.IsUpgrade(plan, c.MinPlan)
+}
+
+// DisplayName returns the character's name in the locale, falling back to FallbackLocale and then to its key
+func DisplayName(c *models.Character, locale string) string {
+	names, err := c.DisplayNameMap()
+	if err != nil {
+		return c.Key
+	}
+	if name, ok := names[locale]; ok {
+		return name
+	}
+	if name, ok := names[FallbackLocale]; ok {
+		return name
+	}
+	return c.Key
+}
+
+// Voice returns the voice the character speaks the language with, or "" to leave the choice to the AI service
+func Voice(c *models.Character, language string) (string, error) {
+	voices, err := c.VoiceMap()
+	if err != nil {
+		return "", err
+	}
+	return voices[language], nil
+}
+
+// Languages returns the practice languages the character has a voice for, sorted
+func Languages(c *models.Character) ([]string, error) {
+	voices, err := c.VoiceMap()
+	if err != nil {
+		return nil, err
+	}
+	languages := make([]string, 0, len(voices))
+	for language := range voices {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+	return languages, nil
+}
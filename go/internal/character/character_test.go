@@ -0,0 +1,86 @@
+package character
+
+import (
+	"testing"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validDefinition() Definition {
+	return Definition{
+		Key:          "friend",
+		Persona:      "You are Juan, a cheerful friend.",
+		Gender:       models.CharacterGenderMale,
+		AgeBand:      models.CharacterAgeBandYoungAdult,
+		MinPlan:      models.PlanFree,
+		Voices:       map[string]string{"vi": "Puck"},
+		DisplayNames: map[string]string{"en": "Juan", "ja": "フアン"},
+		Active:       true,
+	}
+}
+
+func TestDefinitionValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		edit    func(d *Definition)
+		wantErr string
+	}{
+		{name: "valid", edit: func(d *Definition) {}},
+		{name: "invalid key", edit: func(d *Definition) { d.Key = "Friend" }, wantErr: "invalid key"},
+		{name: "empty persona", edit: func(d *Definition) { d.Persona = " " }, wantErr: "persona is empty"},
+		{name: "unknown gender", edit: func(d *Definition) { d.Gender = "MALE" }, wantErr: "unknown gender"},
+		{name: "unknown age band", edit: func(d *Definition) { d.AgeBand = "" }, wantErr: "unknown age band"},
+		{name: "unknown plan", edit: func(d *Definition) { d.MinPlan = "PLAN_GOLD" }, wantErr: "unknown plan"},
+		{name: "no voices", edit: func(d *Definition) { d.Voices = nil }, wantErr: "no voices"},
+		{name: "empty voice", edit: func(d *Definition) { d.Voices["ja"] = "" }, wantErr: "voice for ja"},
+		{name: "no fallback name", edit: func(d *Definition) { delete(d.DisplayNames, "en") }, wantErr: "no display name in en"},
+		{name: "empty name", edit: func(d *Definition) { d.DisplayNames["vi"] = "" }, wantErr: "display name in vi"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := validDefinition()
+			tt.edit(&d)
+			err := d.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	d := validDefinition()
+	var c models.Character
+	require.NoError(t, d.Apply(&c))
+
+	assert.Equal(t, "friend", c.Key)
+	voice, err := Voice(&c, "vi")
+	require.NoError(t, err)
+	assert.Equal(t, "Puck", voice)
+	voice, err = Voice(&c, "ko")
+	require.NoError(t, err)
+	assert.Empty(t, voice)
+	languages, err := Languages(&c)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"vi"}, languages)
+}
+
+func TestAvailable(t *testing.T) {
+	c := &models.Character{MinPlan: models.PlanLite}
+	assert.False(t, Available(c, models.PlanFree))
+	assert.True(t, Available(c, models.PlanLite))
+	assert.True(t, Available(c, models.PlanPremium))
+}
+
+func TestDisplayName(t *testing.T) {
+	c := &models.Character{Key: "friend", DisplayNames: `{"en": "Juan", "ja": "フアン"}`}
+	assert.Equal(t, "フアン", DisplayName(c, "ja"))
+	assert.Equal(t, "Juan", DisplayName(c, "vi"))
+
+	c.DisplayNames = `{}`
+	assert.Equal(t, "friend", DisplayName(c, "ja"))
+}
@@ -0,0 +1,119 @@
+package character
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+const (
+	// MaxNameLength is the longest custom character name in characters
+	MaxNameLength = 50
+	// MaxPersonalityLength is the longest personality of a custom character in characters
+	MaxPersonalityLength = 1000
+	// MaxSpeakingStyleLength is the longest speaking style of a custom character in characters
+	MaxSpeakingStyleLength = 500
+	// MaxCustomPerUser is how many custom characters a user keeps
+	MaxCustomPerUser = 20
+	// CustomKeyPrefix starts the character key of conversations with a custom character, followed by its ID
+	CustomKeyPrefix = "custom:"
+	// shareTokenBytes gives share tokens of 128 random bits
+	shareTokenBytes = 16
+)
+
+// CustomVoices are the voices users can give their characters
+var CustomVoices = []string{"Aoede", "Charon", "Fenrir", "Kore", "Leda", "Orus", "Puck", "Zephyr"}
+
+// Custom is a character as designed by a user
+type Custom struct {
+	Name          string
+	Personality   string
+	SpeakingStyle string
+	Formality     models.CharacterFormality
+	Voice         string
+}
+
+// Normalize trims the texts of a custom character and checks them against the length limits and the content policy
+func (c *Custom) Normalize() error {
+	c.Name = strings.Join(strings.Fields(c.Name), " ")
+	c.Personality = strings.TrimSpace(c.Personality)
+	c.SpeakingStyle = strings.TrimSpace(c.SpeakingStyle)
+
+	if c.Name == "" || utf8.RuneCountInString(c.Name) > MaxNameLength {
+		return fmt.Errorf("name must have 1 to %d characters", MaxNameLength)
+	}
+	if c.Personality == "" || utf8.RuneCountInString(c.Personality) > MaxPersonalityLength {
+		return fmt.Errorf("personality must have 1 to %d characters", MaxPersonalityLength)
+	}
+	if utf8.RuneCountInString(c.SpeakingStyle) > MaxSpeakingStyleLength {
+		return fmt.Errorf("speaking style exceeds %d characters", MaxSpeakingStyleLength)
+	}
+	switch c.Formality {
+	case models.CharacterFormalityCasual, models.CharacterFormalityNeutral, models.CharacterFormalityFormal:
+	default:
+		return fmt.Errorf("unknown formality %q", c.Formality)
+	}
+	if !slices.Contains(CustomVoices, c.Voice) {
+		return fmt.Errorf("voice %q is not offered", c.Voice)
+	}
+	for _, text := range []string{c.Name, c.Personality, c.SpeakingStyle} {
+		if err := CheckContent(text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Apply copies the design into a custom character model
+func (c *Custom) Apply(m *models.CustomCharacter) {
+	m.Name = c.Name
+	m.Personality = c.Personality
+	m.SpeakingStyle = c.SpeakingStyle
+	m.Formality = c.Formality
+	m.Voice = c.Voice
+}
+
+// formalityInstructions tell the AI how to address the learner
+var formalityInstructions = map[models.CharacterFormality]string{
+	models.CharacterFormalityCasual:  "Speak casually, like a close friend.",
+	models.CharacterFormalityNeutral: "Speak in a friendly but polite way.",
+	models.CharacterFormalityFormal:  "Speak formally and politely, as to someone you have just met.",
+}
+
+// CustomPersona writes the instructions the AI plays a custom character with.
+// The user's texts are quoted as a description so they cannot pose as instructions.
+func CustomPersona(c *models.CustomCharacter) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "You are %s, a conversation partner designed by the learner.\n", c.Name)
+	fmt.Fprintf(&b, "The learner describes your personality as: %q\n", c.Personality)
+	if c.SpeakingStyle != "" {
+		fmt.Fprintf(&b, "The learner describes your speaking style as: %q\n", c.SpeakingStyle)
+	}
+	b.WriteString(formalityInstructions[c.Formality])
+	b.WriteString("\nStay in character, keep the conversation appropriate for language practice and keep your responses concise.")
+	return b.String()
+}
+
+// CustomKey returns the character key recorded for conversations with a custom character
+func CustomKey(customCharacterID string) string {
+	return CustomKeyPrefix + customCharacterID
+}
+
+// ParseCustomKey returns the custom character ID of a character key, if it names one
+func ParseCustomKey(key string) (string, bool) {
+	return strings.CutPrefix(key, CustomKeyPrefix)
+}
+
+// GenerateShareToken returns a random token for the link of a shared custom character
+func GenerateShareToken() (string, error) {
+	random := make([]byte, shareTokenBytes)
+	if _, err := rand.Read(random); err != nil {
+		return "", fmt.Errorf("failed to generate share token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(random), nil
+}
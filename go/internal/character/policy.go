@@ -0,0 +1,50 @@
+package character
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrContentPolicy is returned for texts a custom character must not contain
+var ErrContentPolicy = errors.New("text violates the content policy")
+
+// blockedPhrases are rejected in the texts of custom characters, matched case-insensitively
+// after collapsing whitespace. They catch attempts to override the AI's instructions,
+// links, and content unsuitable for a language learning app.
+var blockedPhrases = []string{
+	// Instruction overrides
+	"ignore previous instructions",
+	"ignore all previous",
+	"ignore the above",
+	"disregard previous",
+	"disregard all previous",
+	"system prompt",
+	"developer mode",
+	"jailbreak",
+	// Links
+	"http://",
+	"https://",
+	"www.",
+	// Unsuitable content
+	"nsfw",
+	"sexual",
+	"erotic",
+	"porn",
+	"nude",
+	"self-harm",
+	"suicide",
+	"terrorist",
+	"racist",
+}
+
+// CheckContent returns ErrContentPolicy when text contains a blocked phrase
+func CheckContent(text string) error {
+	normalized := strings.ToLower(strings.Join(strings.Fields(text), " "))
+	for _, phrase := range blockedPhrases {
+		if strings.Contains(normalized, phrase) {
+			return fmt.Errorf("%w: %q is not allowed", ErrContentPolicy, phrase)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package config
+
+import (
+	"log"
+
+	"github.com/joho/godotenv"
+)
+
+// LoadEnv loads environment variables from a .env file.
+// It tries the current directory first and then go/.env so binaries work
+// from both the go directory and the project root. In Docker the variables
+// come from docker-compose and a missing file is not an error.
+func LoadEnv() {
+	err := godotenv.Load()
+	if err != nil {
+		// Try loading from go/.env if running from project root
+		err = godotenv.Load("go/.env")
+		if err != nil {
+			log.Println("No .env file found, using environment variables")
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package conversation
+
+import (
+	"fmt"
+	"path"
+)
+
+// Track identifies whose speech a recorded audio object holds
+type Track string
+
+const (
+	TrackUser Track = "user"
+	TrackAI   Track = "ai"
+)
+
+// ParseTrack parses the track name used in playback URLs
+func ParseTrack(s string) (Track, bool) {
+	switch Track(s) {
+	case TrackUser, TrackAI:
+		return Track(s), true
+	}
+	return "", false
+}
+
+// maxTrackBytes caps the audio recorded per track and turn; longer speech is truncated
+const maxTrackBytes = 16 << 20
+
+// UserAudioPrefix returns the blob key prefix holding every recording of a user
+func UserAudioPrefix(userID string) string {
+	return path.Join("audio", userID)
+}
+
+// AudioPrefix returns the blob key prefix holding every recording of a conversation
+func AudioPrefix(userID, conversationID string) string {
+	return path.Join(UserAudioPrefix(userID), conversationID)
+}
+
+// AudioKey returns the blob key of the recording of a turn's track
+func AudioKey(userID, conversationID string, seq int, track Track) string {
+	return path.Join(AudioPrefix(userID, conversationID), fmt.Sprintf("%04d-%s.wav", seq, track))
+}
+
+// appendAudio appends data to a track buffer, dropping what exceeds maxTrackBytes
+func appendAudio(track, data []byte) []byte {
+	if room := maxTrackBytes - len(track); len(data) > room {
+		data = data[:max(room, 0)]
+	}
+	return append(track, data...)
+}
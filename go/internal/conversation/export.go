@@ -0,0 +1,332 @@
+package conversation
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+)
+
+// Format is a transcript export format
+type Format string
+
+const (
+	FormatSRT      Format = "srt"
+	FormatVTT      Format = "vtt"
+	FormatMarkdown Format = "md"
+	FormatJSON     Format = "json"
+)
+
+// ParseFormat parses the format query parameter of an export
+func ParseFormat(s string) (Format, bool) {
+	switch f := Format(strings.ToLower(s)); f {
+	case FormatSRT, FormatVTT, FormatMarkdown, FormatJSON:
+		return f, true
+	}
+	return "", false
+}
+
+// ContentType returns the MIME type of the format
+func (f Format) ContentType() string {
+	switch f {
+	case FormatSRT:
+		return "application/x-subrip; charset=utf-8"
+	case FormatVTT:
+		return "text/vtt; charset=utf-8"
+	case FormatMarkdown:
+		return "text/markdown; charset=utf-8"
+	}
+	return "application/json; charset=utf-8"
+}
+
+// exportBatchSize is the number of turns loaded per query while exporting
+const exportBatchSize = 200
+
+// minCueDuration keeps subtitle cues of instant responses visible
+const minCueDuration = time.Second
+
+// userSpeaker labels the learner's lines in exports
+const userSpeaker = "You"
+
+// exporter renders a transcript incrementally so long conversations are never held in memory
+type exporter interface {
+	begin(conversation *models.Conversation, characterName string) error
+	turn(turn *models.ConversationTurn) error
+	end() error
+}
+
+// Export writes the transcript of conversation to w in format, labelling the AI's lines with
+// characterName and loading the turns in batches. w is flushed after every batch when it
+// implements http.Flusher.
+func Export(ctx context.Context, w io.Writer, format Format, conversation *models.Conversation, characterName string, conversations repository.ConversationRepository) error {
+	buf := bufio.NewWriter(w)
+	e := newExporter(format, buf)
+	if err := e.begin(conversation, characterName); err != nil {
+		return err
+	}
+	afterSeq := 0
+	for {
+		turns, err := conversations.ListConversationTurns(ctx, conversation.ConversationsID, afterSeq, exportBatchSize)
+		if err != nil {
+			return err
+		}
+		for i := range turns {
+			if err := e.turn(&turns[i]); err != nil {
+				return err
+			}
+		}
+		if err := buf.Flush(); err != nil {
+			return err
+		}
+		if f, ok := w.(interface{ Flush() }); ok {
+			f.Flush()
+		}
+		if len(turns) < exportBatchSize {
+			break
+		}
+		afterSeq = turns[len(turns)-1].Seq
+	}
+	if err := e.end(); err != nil {
+		return err
+	}
+	return buf.Flush()
+}
+
+func newExporter(format Format, w io.Writer) exporter {
+	switch format {
+	case FormatSRT:
+		return &subtitleExporter{w: w}
+	case FormatVTT:
+		return &subtitleExporter{w: w, vtt: true}
+	case FormatMarkdown:
+		return &markdownExporter{w: w}
+	}
+	return &jsonExporter{w: w}
+}
+
+// cue is a subtitle line
+type cue struct {
+	start   time.Duration
+	end     time.Duration
+	speaker string
+	text    string
+}
+
+// turnCues returns the cues of a turn relative to the start of the conversation.
+// The learner speaks from the start of the turn until the AI answers, and the AI
+// speaks from its first to its last ChatResponse timestamp.
+func turnCues(conversation *models.Conversation, characterName string, turn *models.ConversationTurn) []cue {
+	offset := func(t time.Time) time.Duration {
+		return max(t.Sub(conversation.StartedAt), 0)
+	}
+	end := turn.StartedAt
+	if turn.EndedAt != nil {
+		end = *turn.EndedAt
+	}
+
+	var cues []cue
+	if text := strings.TrimSpace(turn.UserTranscript); text != "" {
+		userEnd := end
+		if turn.AIStartedAt != nil {
+			userEnd = *turn.AIStartedAt
+		}
+		cues = append(cues, newCue(offset(turn.StartedAt), offset(userEnd), userSpeaker, text))
+	}
+	if text := strings.TrimSpace(turn.AIText); text != "" {
+		aiStart, aiEnd := turn.StartedAt, end
+		if turn.AIStartedAt != nil {
+			aiStart = *turn.AIStartedAt
+		}
+		if turn.AIEndedAt != nil && turn.AIEndedAt.After(aiEnd) {
+			aiEnd = *turn.AIEndedAt
+		}
+		cues = append(cues, newCue(offset(aiStart), offset(aiEnd), characterName, text))
+	}
+	return cues
+}
+
+func newCue(start, end time.Duration, speaker, text string) cue {
+	return cue{start: start, end: max(end, start+minCueDuration), speaker: speaker, text: text}
+}
+
+// subtitleExporter writes SubRip (SRT) or WebVTT cues
+type subtitleExporter struct {
+	w             io.Writer
+	vtt           bool
+	conversation  *models.Conversation
+	characterName string
+	index         int
+}
+
+func (e *subtitleExporter) begin(conversation *models.Conversation, characterName string) error {
+	e.conversation, e.characterName = conversation, characterName
+	if e.vtt {
+		_, err := io.WriteString(e.w, "WEBVTT\n\n")
+		return err
+	}
+	return nil
+}
+
+func (e *subtitleExporter) turn(turn *models.ConversationTurn) error {
+	for _, c := range turnCues(e.conversation, e.characterName, turn) {
+		e.index++
+		var err error
+		if e.vtt {
+			_, err = fmt.Fprintf(e.w, "%d\n%s --> %s\n<v %s>%s\n\n", e.index,
+				formatCueTime(c.start, '.'), formatCueTime(c.end, '.'), c.speaker, escapeVTT(c.text))
+		} else {
+			_, err = fmt.Fprintf(e.w, "%d\n%s --> %s\n%s: %s\n\n", e.index,
+				formatCueTime(c.start, ','), formatCueTime(c.end, ','), c.speaker, c.text)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *subtitleExporter) end() error {
+	return nil
+}
+
+// formatCueTime formats d as HH:MM:SS followed by sep and milliseconds
+func formatCueTime(d time.Duration, sep byte) string {
+	ms := d.Milliseconds()
+	return fmt.Sprintf("%02d:%02d:%02d%c%03d", ms/3600000, ms/60000%60, ms/1000%60, sep, ms%1000)
+}
+
+// escapeVTT escapes the characters WebVTT cue text treats as markup
+func escapeVTT(text string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(text)
+}
+
+// markdownExporter writes the transcript as Markdown with speaker labels
+type markdownExporter struct {
+	w             io.Writer
+	conversation  *models.Conversation
+	characterName string
+}
+
+func (e *markdownExporter) begin(conversation *models.Conversation, characterName string) error {
+	e.conversation, e.characterName = conversation, characterName
+	_, err := fmt.Fprintf(e.w, "# Conversation with %s\n\n- Language: %s\n- Started: %s\n",
+		characterName, conversation.Language, conversation.StartedAt.UTC().Format(time.RFC3339))
+	if err == nil && conversation.EndedAt != nil {
+		_, err = fmt.Fprintf(e.w, "- Ended: %s\n", conversation.EndedAt.UTC().Format(time.RFC3339))
+	}
+	return err
+}
+
+func (e *markdownExporter) turn(turn *models.ConversationTurn) error {
+	for _, c := range turnCues(e.conversation, e.characterName, turn) {
+		if _, err := fmt.Fprintf(e.w, "\n**%s** (%s)\n\n%s\n", c.speaker, formatCueTime(c.start, '.')[:8], c.text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *markdownExporter) end() error {
+	return nil
+}
+
+// ExportSchema identifies the layout of JSON exports. Change it when the layout changes incompatibly.
+const ExportSchema = "talk.transcript.v1"
+
+type jsonConversation struct {
+	ID            string     `json:"id"`
+	Language      string     `json:"language"`
+	Character     string     `json:"character"`
+	CharacterName string     `json:"character_name"`
+	Plan          string     `json:"plan"`
+	StartedAt     time.Time  `json:"started_at"`
+	EndedAt       *time.Time `json:"ended_at"`
+	CloseReason   string     `json:"close_reason"`
+}
+
+type jsonSpeech struct {
+	Text      string     `json:"text"`
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	EndedAt   *time.Time `json:"ended_at,omitempty"`
+}
+
+type jsonFeedback struct {
+	Category    string `json:"category"`
+	Original    string `json:"original"`
+	Correction  string `json:"correction"`
+	Explanation string `json:"explanation"`
+}
+
+type jsonTurn struct {
+	Seq       int            `json:"seq"`
+	StartedAt time.Time      `json:"started_at"`
+	EndedAt   *time.Time     `json:"ended_at"`
+	User      jsonSpeech     `json:"user"`
+	AI        jsonSpeech     `json:"ai"`
+	Feedback  []jsonFeedback `json:"feedback,omitempty"`
+}
+
+// jsonExporter writes {"schema": ..., "conversation": {...}, "turns": [...]} one turn at a time
+type jsonExporter struct {
+	w     io.Writer
+	turns int
+}
+
+func (e *jsonExporter) begin(conversation *models.Conversation, characterName string) error {
+	header, err := json.Marshal(jsonConversation{
+		ID:            conversation.ConversationsID,
+		Language:      conversation.Language,
+		Character:     conversation.Character,
+		CharacterName: characterName,
+		Plan:          string(conversation.Plan),
+		StartedAt:     conversation.StartedAt,
+		EndedAt:       conversation.EndedAt,
+		CloseReason:   string(conversation.CloseReason),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(e.w, `{"schema":%q,"conversation":%s,"turns":[`, ExportSchema, header)
+	return err
+}
+
+func (e *jsonExporter) turn(turn *models.ConversationTurn) error {
+	out := jsonTurn{
+		Seq:       turn.Seq,
+		StartedAt: turn.StartedAt,
+		EndedAt:   turn.EndedAt,
+		User:      jsonSpeech{Text: turn.UserTranscript},
+		AI:        jsonSpeech{Text: turn.AIText, StartedAt: turn.AIStartedAt, EndedAt: turn.AIEndedAt},
+	}
+	for _, f := range turn.Feedback {
+		out.Feedback = append(out.Feedback, jsonFeedback{
+			Category:    f.Category.Name(),
+			Original:    f.Original,
+			Correction:  f.Correction,
+			Explanation: f.Explanation,
+		})
+	}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return err
+	}
+	if e.turns > 0 {
+		if _, err := io.WriteString(e.w, ","); err != nil {
+			return err
+		}
+	}
+	e.turns++
+	_, err = e.w.Write(data)
+	return err
+}
+
+func (e *jsonExporter) end() error {
+	_, err := io.WriteString(e.w, "]}\n")
+	return err
+}
@@ -0,0 +1,103 @@
+package conversation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/hiroky1983/talk/go/internal/character"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"github.com/hiroky1983/talk/go/middleware"
+)
+
+// ExportHandler streams transcripts of stored conversations to their owner
+type ExportHandler struct {
+	conversations    repository.ConversationRepository
+	characters       repository.CharacterRepository
+	customCharacters repository.CustomCharacterRepository
+}
+
+// NewExportHandler creates a new export handler
+func NewExportHandler(conversations repository.ConversationRepository, characters repository.CharacterRepository, customCharacters repository.CustomCharacterRepository) *ExportHandler {
+	return &ExportHandler{conversations: conversations, characters: characters, customCharacters: customCharacters}
+}
+
+// ServeExport serves GET /conversations/:conversation_id/export?format=srt|vtt|md|json&locale=<locale> as a download.
+// The AI's lines are labelled with the character's display name in locale.
+func (h *ExportHandler) ServeExport(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		return
+	}
+	conversationID := c.Param("conversation_id")
+	if _, err := uuid.Parse(conversationID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation_id"})
+		return
+	}
+	format, ok := ParseFormat(c.DefaultQuery("format", string(FormatJSON)))
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "format must be one of srt, vtt, md or json"})
+		return
+	}
+	ctx := c.Request.Context()
+
+	conversation, err := h.conversations.GetConversation(ctx, userID, conversationID)
+	if err != nil {
+		if errors.Is(err, repository.ErrConversationNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "conversation not found"})
+			return
+		}
+		log.Printf("ServeExport failed: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+	characterName, err := h.characterName(ctx, conversation.Character, c.DefaultQuery("locale", character.FallbackLocale))
+	if err != nil {
+		log.Printf("ServeExport failed: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	c.Header("Content-Type", format.ContentType())
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="conversation-%s.%s"`, conversationID, format))
+	c.Header("Cache-Control", "private, no-store")
+	c.Status(http.StatusOK)
+	// The status is already sent once turns stream, so a failure can only truncate the body.
+	if err := Export(ctx, c.Writer, format, conversation, characterName, h.conversations); err != nil {
+		log.Printf("ServeExport failed after streaming started: %v", err)
+		c.Abort()
+	}
+}
+
+// characterName returns the name of the conversation's character in locale, or the name
+// its owner gave a custom character. A character missing from the catalog is named by its
+// key, and a deleted custom character "AI".
+func (h *ExportHandler) characterName(ctx context.Context, key, locale string) (string, error) {
+	if key == "" {
+		return "AI", nil
+	}
+	if customID, ok := character.ParseCustomKey(key); ok {
+		// The character may be another user's shared one, so it is looked up by ID alone
+		partner, err := h.customCharacters.GetCustomCharacterByID(ctx, customID)
+		switch {
+		case errors.Is(err, repository.ErrCustomCharacterNotFound):
+			return "AI", nil
+		case err != nil:
+			return "", fmt.Errorf("failed to get custom character: %w", err)
+		}
+		return partner.Name, nil
+	}
+	ch, err := h.characters.GetCharacterByKey(ctx, key)
+	switch {
+	case errors.Is(err, repository.ErrCharacterNotFound):
+		return key, nil
+	case err != nil:
+		return "", fmt.Errorf("failed to get character: %w", err)
+	}
+	return character.DisplayName(ch, locale), nil
+}
@@ -0,0 +1,159 @@
+package conversation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// turnLister serves ListConversationTurns from memory; other methods are not used by Export
+type turnLister struct {
+	repository.ConversationRepository
+	turns []models.ConversationTurn
+	calls int
+}
+
+func (l *turnLister) ListConversationTurns(_ context.Context, _ string, afterSeq, limit int) ([]models.ConversationTurn, error) {
+	l.calls++
+	var turns []models.ConversationTurn
+	for _, turn := range l.turns {
+		if turn.Seq > afterSeq && len(turns) < limit {
+			turns = append(turns, turn)
+		}
+	}
+	return turns, nil
+}
+
+var exportStart = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+func at(d time.Duration) *time.Time {
+	t := exportStart.Add(d)
+	return &t
+}
+
+func exportFixture() (*models.Conversation, *turnLister) {
+	conversation := &models.Conversation{
+		ConversationsID: "c0ffee00-0000-4000-8000-000000000001",
+		Language:        "vi",
+		Character:       "friend",
+		Plan:            models.PlanFree,
+		StartedAt:       exportStart,
+		EndedAt:         at(time.Minute),
+		CloseReason:     models.CloseReasonClientClosed,
+	}
+	turns := &turnLister{turns: []models.ConversationTurn{
+		{
+			Seq:            1,
+			UserTranscript: "xin chào",
+			AIText:         "Chào bạn <3",
+			StartedAt:      exportStart.Add(1500 * time.Millisecond),
+			EndedAt:        at(6 * time.Second),
+			AIStartedAt:    at(3250 * time.Millisecond),
+			AIEndedAt:      at(5 * time.Second),
+			Feedback: []models.TurnFeedback{
+				{Category: models.FeedbackCategoryVocabulary, Original: "xin chào", Correction: "chào bạn", Explanation: "友達にはこちらが自然"},
+			},
+		},
+		{
+			Seq:       2,
+			AIText:    "Bạn khỏe không?",
+			StartedAt: exportStart.Add(10 * time.Second),
+			EndedAt:   at(10 * time.Second),
+		},
+	}}
+	return conversation, turns
+}
+
+func export(t *testing.T, format Format) string {
+	t.Helper()
+	conversation, turns := exportFixture()
+	var buf bytes.Buffer
+	require.NoError(t, Export(context.Background(), &buf, format, conversation, "Friend", turns))
+	return buf.String()
+}
+
+func TestFormatCueTime(t *testing.T) {
+	d := time.Hour + 2*time.Minute + 3*time.Second + 45*time.Millisecond
+	assert.Equal(t, "01:02:03,045", formatCueTime(d, ','))
+	assert.Equal(t, "01:02:03.045", formatCueTime(d, '.'))
+	assert.Equal(t, "00:00:00.000", formatCueTime(0, '.'))
+}
+
+func TestExport_SRT(t *testing.T) {
+	want := "1\n00:00:01,500 --> 00:00:03,250\nYou: xin chào\n\n" +
+		"2\n00:00:03,250 --> 00:00:06,000\nFriend: Chào bạn <3\n\n" +
+		"3\n00:00:10,000 --> 00:00:11,000\nFriend: Bạn khỏe không?\n\n"
+	assert.Equal(t, want, export(t, FormatSRT))
+}
+
+func TestExport_VTT(t *testing.T) {
+	want := "WEBVTT\n\n" +
+		"1\n00:00:01.500 --> 00:00:03.250\n<v You>xin chào\n\n" +
+		"2\n00:00:03.250 --> 00:00:06.000\n<v Friend>Chào bạn &lt;3\n\n" +
+		"3\n00:00:10.000 --> 00:00:11.000\n<v Friend>Bạn khỏe không?\n\n"
+	assert.Equal(t, want, export(t, FormatVTT))
+}
+
+func TestExport_Markdown(t *testing.T) {
+	want := "# Conversation with Friend\n\n- Language: vi\n- Started: 2026-10-01T12:00:00Z\n- Ended: 2026-10-01T12:01:00Z\n" +
+		"\n**You** (00:00:01)\n\nxin chào\n" +
+		"\n**Friend** (00:00:03)\n\nChào bạn <3\n" +
+		"\n**Friend** (00:00:10)\n\nBạn khỏe không?\n"
+	assert.Equal(t, want, export(t, FormatMarkdown))
+}
+
+func TestExport_JSON(t *testing.T) {
+	var got struct {
+		Schema       string           `json:"schema"`
+		Conversation jsonConversation `json:"conversation"`
+		Turns        []jsonTurn       `json:"turns"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(export(t, FormatJSON)), &got))
+
+	assert.Equal(t, ExportSchema, got.Schema)
+	assert.Equal(t, "c0ffee00-0000-4000-8000-000000000001", got.Conversation.ID)
+	assert.Equal(t, "Friend", got.Conversation.CharacterName)
+	assert.Equal(t, "CLOSE_REASON_CLIENT_CLOSED", got.Conversation.CloseReason)
+	require.Len(t, got.Turns, 2)
+	assert.Equal(t, "xin chào", got.Turns[0].User.Text)
+	assert.Equal(t, *at(3250 * time.Millisecond), *got.Turns[0].AI.StartedAt)
+	assert.Nil(t, got.Turns[1].AI.StartedAt)
+	assert.Equal(t, []jsonFeedback{{Category: "vocabulary", Original: "xin chào", Correction: "chào bạn", Explanation: "友達にはこちらが自然"}}, got.Turns[0].Feedback)
+	assert.Empty(t, got.Turns[1].Feedback)
+}
+
+func TestExport_LoadsTurnsInBatches(t *testing.T) {
+	conversation, turns := exportFixture()
+	turns.turns = nil
+	for seq := 1; seq <= exportBatchSize+1; seq++ {
+		turns.turns = append(turns.turns, models.ConversationTurn{
+			Seq:       seq,
+			AIText:    fmt.Sprintf("line %d", seq),
+			StartedAt: exportStart.Add(time.Duration(seq) * time.Second),
+		})
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, Export(context.Background(), &buf, FormatSRT, conversation, "Friend", turns))
+
+	assert.Equal(t, 2, turns.calls)
+	assert.Contains(t, buf.String(), fmt.Sprintf("%d\n", exportBatchSize+1))
+	assert.Contains(t, buf.String(), fmt.Sprintf("Friend: line %d\n", exportBatchSize+1))
+}
+
+func TestParseFormat(t *testing.T) {
+	format, ok := ParseFormat("VTT")
+	assert.True(t, ok)
+	assert.Equal(t, FormatVTT, format)
+
+	_, ok = ParseFormat("docx")
+	assert.False(t, ok)
+}
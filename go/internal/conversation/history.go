@@ -0,0 +1,85 @@
+package conversation
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+)
+
+// HistoryBudget limits how much of a resumed conversation is sent to the AI service
+type HistoryBudget struct {
+	MaxTurns int // Most recent turns considered
+	MaxChars int // Characters of transcripts and replies sent, counting the newest turns first
+}
+
+// DefaultHistoryBudget is used when the budget is not configured
+var DefaultHistoryBudget = HistoryBudget{MaxTurns: 20, MaxChars: 4000}
+
+// LoadHistoryBudget returns DefaultHistoryBudget overridden by HISTORY_MAX_TURNS and
+// HISTORY_MAX_CHARS (0 resumes conversations without sending history)
+func LoadHistoryBudget() (HistoryBudget, error) {
+	budget := DefaultHistoryBudget
+	var err error
+	if budget.MaxTurns, err = countFromEnv("HISTORY_MAX_TURNS", budget.MaxTurns); err != nil {
+		return HistoryBudget{}, err
+	}
+	if budget.MaxChars, err = countFromEnv("HISTORY_MAX_CHARS", budget.MaxChars); err != nil {
+		return HistoryBudget{}, err
+	}
+	return budget, nil
+}
+
+func countFromEnv(key string, fallback int) (int, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	count, err := strconv.Atoi(value)
+	if err != nil || count < 0 {
+		return 0, fmt.Errorf("invalid %s %q: must be a non-negative number", key, value)
+	}
+	return count, nil
+}
+
+// Resumption is a stored conversation continued by a new connection
+type Resumption struct {
+	Conversation *models.Conversation
+	History      []models.ConversationTurn // Oldest first, within the budget
+}
+
+// Resume loads a conversation of the user and its latest turns so a new connection can continue it.
+// It returns repository.ErrConversationNotFound when the user does not own the conversation.
+func Resume(ctx context.Context, conversations repository.ConversationRepository, userID, conversationID string, budget HistoryBudget) (*Resumption, error) {
+	conversation, err := conversations.GetConversation(ctx, userID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	resumption := &Resumption{Conversation: conversation}
+	if budget.MaxTurns == 0 {
+		return resumption, nil
+	}
+	turns, err := conversations.ListRecentConversationTurns(ctx, conversationID, budget.MaxTurns)
+	if err != nil {
+		return nil, err
+	}
+	resumption.History = trimHistory(turns, budget.MaxChars)
+	return resumption, nil
+}
+
+// trimHistory drops the oldest turns until the rest fit in maxChars.
+// A turn is never cut, so a single long turn may leave no history.
+func trimHistory(turns []models.ConversationTurn, maxChars int) []models.ConversationTurn {
+	chars := 0
+	for i := len(turns) - 1; i >= 0; i-- {
+		chars += utf8.RuneCountInString(turns[i].UserTranscript) + utf8.RuneCountInString(turns[i].AIText)
+		if chars > maxChars {
+			return turns[i+1:]
+		}
+	}
+	return turns
+}
@@ -0,0 +1,90 @@
+package conversation
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// storedConversation serves a single conversation owned by user-1
+type storedConversation struct {
+	repository.ConversationRepository
+	turns []models.ConversationTurn
+	limit int
+}
+
+func (s *storedConversation) GetConversation(_ context.Context, userID, conversationID string) (*models.Conversation, error) {
+	if userID != "user-1" || conversationID != "conversation-1" {
+		return nil, repository.ErrConversationNotFound
+	}
+	return &models.Conversation{ConversationsID: conversationID, UserID: userID, Language: "vi", Character: "sister"}, nil
+}
+
+func (s *storedConversation) ListRecentConversationTurns(_ context.Context, _ string, limit int) ([]models.ConversationTurn, error) {
+	s.limit = limit
+	return s.turns[max(len(s.turns)-limit, 0):], nil
+}
+
+func historyTurns(texts ...string) []models.ConversationTurn {
+	turns := make([]models.ConversationTurn, len(texts))
+	for i, text := range texts {
+		turns[i] = models.ConversationTurn{Seq: i + 1, UserTranscript: text, AIText: text}
+	}
+	return turns
+}
+
+func TestTrimHistory(t *testing.T) {
+	turns := historyTurns("một", "hai", "ba")
+
+	tests := []struct {
+		name     string
+		maxChars int
+		wantSeqs []int
+	}{
+		{name: "everything fits", maxChars: 100, wantSeqs: []int{1, 2, 3}},
+		{name: "exact fit", maxChars: 10, wantSeqs: []int{2, 3}},
+		{name: "turn over budget dropped", maxChars: 9, wantSeqs: []int{3}},
+		{name: "newest turn too long", maxChars: 3, wantSeqs: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seqs := []int{}
+			for _, turn := range trimHistory(turns, tt.maxChars) {
+				seqs = append(seqs, turn.Seq)
+			}
+			assert.Equal(t, tt.wantSeqs, seqs)
+		})
+	}
+}
+
+func TestResume_LoadsLatestTurnsWithinBudget(t *testing.T) {
+	store := &storedConversation{turns: historyTurns("a", "b", "c", strings.Repeat("d", 10))}
+
+	resumption, err := Resume(context.Background(), store, "user-1", "conversation-1", HistoryBudget{MaxTurns: 3, MaxChars: 22})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, store.limit)
+	assert.Equal(t, "sister", resumption.Conversation.Character)
+	require.Len(t, resumption.History, 2)
+	assert.Equal(t, 3, resumption.History[0].Seq)
+}
+
+func TestResume_WithoutHistoryLoadsNoTurns(t *testing.T) {
+	store := &storedConversation{turns: historyTurns("a", "b")}
+
+	resumption, err := Resume(context.Background(), store, "user-1", "conversation-1", HistoryBudget{})
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, store.limit)
+	assert.Empty(t, resumption.History)
+}
+
+func TestResume_RejectsOtherUsersConversation(t *testing.T) {
+	_, err := Resume(context.Background(), &storedConversation{}, "user-2", "conversation-1", DefaultHistoryBudget)
+	assert.ErrorIs(t, err, repository.ErrConversationNotFound)
+}
@@ -0,0 +1,12 @@
+package conversation
+
+import "slices"
+
+// Languages are the codes of the languages a conversation can be practised and explained in.
+// The AI service transcribes, answers and speaks each of them.
+var Languages = []string{"en", "ja", "vi"}
+
+// SupportedLanguage reports whether language is one of Languages
+func SupportedLanguage(language string) bool {
+	return slices.Contains(Languages, language)
+}
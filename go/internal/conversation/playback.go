@@ -0,0 +1,82 @@
+package conversation
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"path"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"github.com/hiroky1983/talk/go/internal/storage"
+	"github.com/hiroky1983/talk/go/middleware"
+)
+
+// PlaybackHandler serves recorded turn audio to the owner of the conversation
+type PlaybackHandler struct {
+	conversations repository.ConversationRepository
+	blobs         storage.BlobStore
+}
+
+// NewPlaybackHandler creates a new playback handler
+func NewPlaybackHandler(conversations repository.ConversationRepository, blobs storage.BlobStore) *PlaybackHandler {
+	return &PlaybackHandler{
+		conversations: conversations,
+		blobs:         blobs,
+	}
+}
+
+// ServeTurnAudio serves GET /conversations/:conversation_id/turns/:seq/audio/:track as WAV.
+// Range requests are supported so players can seek.
+func (h *PlaybackHandler) ServeTurnAudio(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		return
+	}
+	conversationID := c.Param("conversation_id")
+	seq, err := strconv.Atoi(c.Param("seq"))
+	track, trackOK := ParseTrack(c.Param("track"))
+	if _, uuidErr := uuid.Parse(conversationID); uuidErr != nil || err != nil || seq <= 0 || !trackOK {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid audio path"})
+		return
+	}
+	ctx := c.Request.Context()
+
+	turn, err := h.conversations.GetTurn(ctx, userID, conversationID, seq)
+	if err != nil {
+		if errors.Is(err, repository.ErrConversationNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "audio not found"})
+			return
+		}
+		log.Printf("ServeTurnAudio failed: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+	key := turn.UserAudioKey
+	if track == TrackAI {
+		key = turn.AIAudioKey
+	}
+	if key == "" {
+		c.JSON(http.StatusNotFound, gin.H{"error": "audio not found"})
+		return
+	}
+
+	blob, err := h.blobs.Open(ctx, key)
+	if err != nil {
+		if errors.Is(err, storage.ErrBlobNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "audio not found"})
+			return
+		}
+		log.Printf("ServeTurnAudio failed: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+	defer blob.Close()
+
+	c.Header("Content-Type", "audio/wav")
+	c.Header("Cache-Control", "private, max-age=3600")
+	http.ServeContent(c.Writer, c.Request, path.Base(key), blob.ModTime(), blob)
+}
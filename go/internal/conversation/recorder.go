@@ -0,0 +1,235 @@
+package conversation
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hiroky1983/talk/go/internal/audio"
+	"github.com/hiroky1983/talk/go/internal/memory"
+	"github.com/hiroky1983/talk/go/internal/mistake"
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"github.com/hiroky1983/talk/go/internal/search"
+	"github.com/hiroky1983/talk/go/internal/storage"
+)
+
+// DefaultQueueSize is the number of pending writes buffered before records are dropped
+const DefaultQueueSize = 1024
+
+// writeTimeout bounds a single database write of the recorder
+const writeTimeout = 10 * time.Second
+
+// job is a pending database write
+type job struct {
+	name string
+	run  func(ctx context.Context) error
+}
+
+// Recorder persists conversations in the background.
+// Writes are queued and applied in order by Run, so recording never blocks the audio path;
+// when the queue is full, writes are dropped and logged.
+type Recorder struct {
+	conversations repository.ConversationRepository
+	memories      repository.MemoryRepository
+	summaries     repository.SummaryRepository
+	progress      repository.ProgressRepository
+	scenarios     repository.ScenarioRepository
+	proficiency   repository.ProficiencyRepository
+	blobs         storage.BlobStore
+	jobs          chan job
+	now           func() time.Time
+}
+
+// NewRecorder creates a new recorder storing turn audio in blobs and buffering up to queueSize writes
+func NewRecorder(
+	conversations repository.ConversationRepository,
+	memories repository.MemoryRepository,
+	summaries repository.SummaryRepository,
+	progress repository.ProgressRepository,
+	scenarios repository.ScenarioRepository,
+	proficiency repository.ProficiencyRepository,
+	blobs storage.BlobStore,
+	queueSize int,
+) *Recorder {
+	if queueSize <= 0 {
+		queueSize = DefaultQueueSize
+	}
+	return &Recorder{
+		conversations: conversations,
+		memories:      memories,
+		summaries:     summaries,
+		progress:      progress,
+		scenarios:     scenarios,
+		proficiency:   proficiency,
+		blobs:         blobs,
+		jobs:          make(chan job, queueSize),
+		now:           time.Now,
+	}
+}
+
+// Run applies queued writes until ctx is canceled
+func (r *Recorder) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case j := <-r.jobs:
+			writeCtx, cancel := context.WithTimeout(ctx, writeTimeout)
+			if err := j.run(writeCtx); err != nil {
+				log.Printf("Failed to %s: %v", j.name, err)
+			}
+			cancel()
+		}
+	}
+}
+
+// enqueue queues a write without blocking
+func (r *Recorder) enqueue(name string, run func(ctx context.Context) error) {
+	select {
+	case r.jobs <- job{name: name, run: run}:
+	default:
+		log.Printf("Conversation recorder queue is full, dropped write to %s", name)
+	}
+}
+
+// Params describes a conversation to record
+type Params struct {
+	UserID      string
+	Language    string
+	Character   string
+	ScenarioID  *string // Role-play scenario the conversation plays, if any
+	Plan        models.UserPlan
+	RecordAudio bool        // False when the user opted out of audio recording
+	Private     bool        // True in privacy mode, where nothing of the conversation is stored
+	Resume      *Resumption // Continues a stored conversation instead of starting a new one
+}
+
+// Start records the start of a conversation and returns the session recording its turns.
+// A resumed conversation gets its new turns appended after the stored ones, numbered by the database
+// so a session still writing to the conversation, e.g. before a reconnect, never has its turns replaced.
+// A private conversation is neither created nor resumed in storage; its session discards every turn.
+func (r *Recorder) Start(params Params) *Session {
+	if params.Private {
+		conversationID := uuid.NewString()
+		if params.Resume != nil {
+			conversationID = params.Resume.Conversation.ConversationsID
+		}
+		return newPrivateSession(conversationID, r.now)
+	}
+
+	saveTurn := func(record turnRecord) { r.saveTurn(params.UserID, record) }
+	if params.Resume != nil {
+		return newSession(params.Resume.Conversation.ConversationsID, params.RecordAudio, r.now, saveTurn, r.end)
+	}
+
+	conversation := &models.Conversation{
+		ConversationsID: uuid.NewString(),
+		UserID:          params.UserID,
+		Language:        params.Language,
+		Character:       params.Character,
+		ScenarioID:      params.ScenarioID,
+		Plan:            params.Plan,
+		StartedAt:       r.now(),
+	}
+	r.enqueue("create conversation", func(ctx context.Context) error {
+		return r.conversations.CreateConversation(ctx, conversation)
+	})
+	return newSession(conversation.ConversationsID, params.RecordAudio, r.now, saveTurn, r.end)
+}
+
+// saveTurn stores the turn with its search lexemes and the mistake patterns of its feedback,
+// and then its audio as WAV files under the sequence number the turn was saved with.
+// A turn is kept without its audio when storing the audio fails.
+func (r *Recorder) saveTurn(userID string, record turnRecord) {
+	r.enqueue("save conversation turn", func(ctx context.Context) error {
+		turn := record.turn
+		turn.SearchText = search.Lexemes(turn.UserTranscript, turn.AIText)
+		for i := range turn.Feedback {
+			turn.Feedback[i].Pattern = mistake.Pattern(turn.Feedback[i].Original, turn.Feedback[i].Correction)
+		}
+		if err := r.conversations.SaveTurn(ctx, &turn); err != nil {
+			return err
+		}
+
+		userAudioKey := r.putAudio(ctx, userID, &turn, TrackUser, audio.UserFormat, record.userAudio)
+		aiAudioKey := r.putAudio(ctx, userID, &turn, TrackAI, audio.AIFormat, record.aiAudio)
+		if userAudioKey == "" && aiAudioKey == "" {
+			return nil
+		}
+		return r.conversations.SetTurnAudio(ctx, turn.ConversationTurnsID, userAudioKey, aiAudioKey)
+	})
+}
+
+// putAudio stores a track of a turn and returns its key, or "" if there is nothing stored
+func (r *Recorder) putAudio(ctx context.Context, userID string, turn *models.ConversationTurn, track Track, format audio.Format, data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+	key := AudioKey(userID, turn.ConversationID, turn.Seq, track)
+	if err := r.blobs.Put(ctx, key, audio.WAVReader(format, data)); err != nil {
+		log.Printf("Failed to store %s audio of conversation turn: %v", track, err)
+		return ""
+	}
+	return key
+}
+
+func (r *Recorder) end(conversationID string, endedAt time.Time, reason models.CloseReason) {
+	r.enqueue("end conversation", func(ctx context.Context) error {
+		return r.conversations.EndConversation(ctx, conversationID, endedAt, reason)
+	})
+}
+
+// Remember stores a memory the AI service proposed during a conversation.
+// Invalid and duplicate proposals are ignored, as are proposals once the user has MaxPerUser memories.
+func (r *Recorder) Remember(userID, conversationID, content string) {
+	content, err := memory.Normalize(content)
+	if err != nil {
+		log.Printf("Ignored memory proposed in conversation %s: %v", conversationID, err)
+		return
+	}
+	r.enqueue("save memory", func(ctx context.Context) error {
+		memories, err := r.memories.ListMemories(ctx, userID, 0)
+		if err != nil {
+			return err
+		}
+		if len(memories) >= memory.MaxPerUser || memory.Contains(memories, content) {
+			return nil
+		}
+		return r.memories.CreateMemory(ctx, &models.UserMemory{
+			UserID:         userID,
+			Content:        content,
+			ConversationID: &conversationID,
+		})
+	})
+}
+
+// RequestSummary queues a summary of the conversation once its turns are written
+func (r *Recorder) RequestSummary(conversationID string) {
+	requestedAt := r.now()
+	r.enqueue("request conversation summary", func(ctx context.Context) error {
+		return r.summaries.RequestSummary(ctx, conversationID, requestedAt)
+	})
+}
+
+// RecordPractice stores the statistics of a finished session for the user's learning progress
+func (r *Recorder) RecordPractice(practice *models.PracticeSession) {
+	r.enqueue("record practice session", func(ctx context.Context) error {
+		return r.progress.CreatePracticeSession(ctx, practice)
+	})
+}
+
+// RecordScenario stores the goals the user achieved in a session played in a scenario
+func (r *Recorder) RecordScenario(completion *models.ScenarioCompletion) {
+	r.enqueue("record scenario completion", func(ctx context.Context) error {
+		return r.scenarios.CreateScenarioCompletion(ctx, completion)
+	})
+}
+
+// RecordPlacement stores the level a placement test estimated on the user's language profile
+func (r *Recorder) RecordPlacement(profile *models.UserLanguageProfile) {
+	r.enqueue("record placement", func(ctx context.Context) error {
+		return r.proficiency.SavePlacement(ctx, profile)
+	})
+}
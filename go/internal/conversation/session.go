@@ -0,0 +1,233 @@
+package conversation
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+// turnRecord is a finished turn with the audio recorded during it
+type turnRecord struct {
+	turn      models.ConversationTurn
+	userAudio []byte
+	aiAudio   []byte
+}
+
+// Session groups the messages of one conversation into turns.
+// A turn starts when the user speaks after the AI answered, or when AI text arrives
+// with a response_id other than the one the current turn is answered with.
+// It is safe for concurrent use by the WebSocket and AI stream loops.
+type Session struct {
+	mu             sync.Mutex
+	conversationID string
+	recordAudio    bool
+	private        bool // Turns and the end of the conversation are discarded instead of saved
+	now            func() time.Time
+	saveTurn       func(record turnRecord)
+	end            func(conversationID string, endedAt time.Time, reason models.CloseReason)
+
+	turn        *models.ConversationTurn
+	userAudio   []byte
+	aiAudio     []byte
+	aiResponded bool
+	savedTurns  int
+	closeReason models.CloseReason
+	ended       bool
+}
+
+func newSession(
+	conversationID string,
+	recordAudio bool,
+	now func() time.Time,
+	saveTurn func(record turnRecord),
+	end func(conversationID string, endedAt time.Time, reason models.CloseReason),
+) *Session {
+	return &Session{
+		conversationID: conversationID,
+		recordAudio:    recordAudio,
+		now:            now,
+		saveTurn:       saveTurn,
+		end:            end,
+	}
+}
+
+// newPrivateSession creates a session that groups turns like any other but never saves them
+func newPrivateSession(conversationID string, now func() time.Time) *Session {
+	return &Session{
+		conversationID: conversationID,
+		private:        true,
+		now:            now,
+	}
+}
+
+// ConversationID returns the ID of the recorded conversation
+func (s *Session) ConversationID() string {
+	return s.conversationID
+}
+
+// SavedTurns returns how many turns the session has saved so far
+func (s *Session) SavedTurns() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.savedTurns
+}
+
+// OnUserAudio records a chunk of the user's speech
+func (s *Session) OnUserAudio(data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ended {
+		return
+	}
+	s.userTurn()
+	if s.recordAudio {
+		s.userAudio = appendAudio(s.userAudio, data)
+	}
+}
+
+// OnUserTranscript appends the transcript of the user's speech to the current turn
+func (s *Session) OnUserTranscript(text string) {
+	if text == "" {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ended {
+		return
+	}
+	turn := s.userTurn()
+	turn.UserTranscript += text
+}
+
+// maxFeedbackPerTurn bounds the corrections kept for a single turn
+const maxFeedbackPerTurn = 20
+
+// OnFeedback attaches a correction of the user's speech to the current turn.
+// Corrections without an original span or a correction, or beyond maxFeedbackPerTurn, are dropped.
+func (s *Session) OnFeedback(feedback models.TurnFeedback) {
+	if feedback.Original == "" && feedback.Correction == "" {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ended {
+		return
+	}
+	if s.turn == nil {
+		s.startTurn()
+	}
+	if len(s.turn.Feedback) < maxFeedbackPerTurn {
+		s.turn.Feedback = append(s.turn.Feedback, feedback)
+	}
+}
+
+// OnAIAudio records a chunk of the AI's spoken answer sent at the ChatResponse timestamp at
+func (s *Session) OnAIAudio(data []byte, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ended {
+		return
+	}
+	if s.turn == nil {
+		s.startTurn()
+	}
+	s.aiResponse(at)
+	if s.recordAudio {
+		s.aiAudio = appendAudio(s.aiAudio, data)
+	}
+}
+
+// OnAIText appends AI text of the response responseID, sent at the ChatResponse timestamp at, to the turn it answers
+func (s *Session) OnAIText(responseID, text string, at time.Time) {
+	if text == "" {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ended {
+		return
+	}
+	if s.turn == nil || (s.turn.ResponseID != "" && s.turn.ResponseID != responseID) {
+		s.startTurn()
+	}
+	s.aiResponse(at)
+	s.turn.ResponseID = responseID
+	s.turn.AIText += text
+}
+
+// aiResponse marks the current turn as answered at the time at
+func (s *Session) aiResponse(at time.Time) {
+	s.aiResponded = true
+	if s.turn.AIStartedAt == nil {
+		s.turn.AIStartedAt = &at
+	}
+	s.turn.AIEndedAt = &at
+}
+
+// SetCloseReason sets why the conversation ends. The first reason set wins.
+func (s *Session) SetCloseReason(reason models.CloseReason) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closeReason == "" {
+		s.closeReason = reason
+	}
+}
+
+// End saves the last turn and records the end of the conversation.
+// Without a close reason the client is assumed to have closed the conversation.
+func (s *Session) End() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ended {
+		return
+	}
+	s.ended = true
+	now := s.now()
+	s.finishTurn(now)
+	reason := s.closeReason
+	if reason == "" {
+		reason = models.CloseReasonClientClosed
+	}
+	if s.private {
+		return
+	}
+	s.end(s.conversationID, now, reason)
+}
+
+// userTurn returns the turn user speech belongs to, starting one once the AI has answered
+func (s *Session) userTurn() *models.ConversationTurn {
+	if s.turn == nil || s.aiResponded {
+		s.startTurn()
+	}
+	return s.turn
+}
+
+// startTurn saves the current turn and starts the next one
+func (s *Session) startTurn() {
+	now := s.now()
+	s.finishTurn(now)
+	s.turn = &models.ConversationTurn{
+		ConversationID: s.conversationID,
+		StartedAt:      now,
+	}
+}
+
+// finishTurn saves the current turn, unless nothing was said or recorded in it
+func (s *Session) finishTurn(endedAt time.Time) {
+	if s.turn == nil {
+		return
+	}
+	record := turnRecord{turn: *s.turn, userAudio: s.userAudio, aiAudio: s.aiAudio}
+	s.turn, s.userAudio, s.aiAudio, s.aiResponded = nil, nil, nil, false
+	if record.turn.UserTranscript == "" && record.turn.AIText == "" && len(record.turn.Feedback) == 0 &&
+		len(record.userAudio) == 0 && len(record.aiAudio) == 0 {
+		return
+	}
+	if s.private {
+		return
+	}
+	record.turn.EndedAt = &endedAt
+	s.savedTurns++
+	s.saveTurn(record)
+}
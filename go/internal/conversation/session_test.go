@@ -0,0 +1,142 @@
+package conversation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+type recorded struct {
+	turns     []models.ConversationTurn
+	userAudio [][]byte
+	aiAudio   [][]byte
+	reason    models.CloseReason
+	ended     int
+}
+
+func newTestSession() (*Session, *recorded) {
+	rec := &recorded{}
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	session := newSession("conversation-1", true,
+		func() time.Time { return now },
+		func(record turnRecord) {
+			rec.turns = append(rec.turns, record.turn)
+			rec.userAudio = append(rec.userAudio, record.userAudio)
+			rec.aiAudio = append(rec.aiAudio, record.aiAudio)
+		},
+		func(_ string, _ time.Time, reason models.CloseReason) {
+			rec.reason = reason
+			rec.ended++
+		},
+	)
+	return session, rec
+}
+
+func TestSession_GroupsTurns(t *testing.T) {
+	session, rec := newTestSession()
+
+	session.OnUserAudio(nil)
+	session.OnUserTranscript("xin chào")
+	session.OnAIText("r1", "Chào ", time.Time{})
+	session.OnAIText("r1", "bạn", time.Time{})
+	session.OnUserAudio(nil)
+	session.OnUserTranscript("cảm ơn")
+	session.OnAIText("r2", "Không có gì", time.Time{})
+	session.End()
+
+	if assert.Len(t, rec.turns, 2) {
+		assert.Equal(t, "xin chào", rec.turns[0].UserTranscript)
+		assert.Equal(t, "Chào bạn", rec.turns[0].AIText)
+		assert.Equal(t, "r1", rec.turns[0].ResponseID)
+		assert.NotNil(t, rec.turns[0].EndedAt)
+		assert.Equal(t, "cảm ơn", rec.turns[1].UserTranscript)
+		assert.Equal(t, "Không có gì", rec.turns[1].AIText)
+	}
+	assert.Equal(t, 2, session.SavedTurns())
+	assert.Equal(t, models.CloseReasonClientClosed, rec.reason)
+}
+
+func TestSession_NewResponseIDStartsTurn(t *testing.T) {
+	session, rec := newTestSession()
+
+	session.OnAIText("r1", "first", time.Time{})
+	session.OnAIText("r2", "second", time.Time{})
+	session.End()
+
+	if assert.Len(t, rec.turns, 2) {
+		assert.Equal(t, "first", rec.turns[0].AIText)
+		assert.Equal(t, "second", rec.turns[1].AIText)
+	}
+}
+
+func TestSession_AttachesFeedbackToCurrentTurn(t *testing.T) {
+	session, rec := newTestSession()
+	correction := models.TurnFeedback{Original: "tôi là đói", Correction: "tôi đói", Category: models.FeedbackCategoryGrammar}
+
+	session.OnUserTranscript("tôi là đói")
+	session.OnFeedback(correction)
+	session.OnFeedback(models.TurnFeedback{Category: models.FeedbackCategoryGrammar})
+	session.OnAIText("r1", "Bạn muốn ăn gì?", time.Time{})
+	session.OnUserTranscript("phở")
+	session.End()
+
+	if assert.Len(t, rec.turns, 2) {
+		assert.Equal(t, []models.TurnFeedback{correction}, rec.turns[0].Feedback)
+		assert.Empty(t, rec.turns[1].Feedback)
+	}
+}
+
+func TestSession_RecordsAudioPerTurn(t *testing.T) {
+	session, rec := newTestSession()
+
+	session.OnUserAudio([]byte{1, 2})
+	session.OnUserAudio([]byte{3})
+	session.OnAIAudio([]byte{9}, time.Time{})
+	session.OnUserAudio([]byte{4})
+	session.End()
+
+	if assert.Len(t, rec.turns, 2) {
+		assert.Equal(t, []byte{1, 2, 3}, rec.userAudio[0])
+		assert.Equal(t, []byte{9}, rec.aiAudio[0])
+		assert.Equal(t, []byte{4}, rec.userAudio[1])
+		assert.Empty(t, rec.aiAudio[1])
+	}
+}
+
+func TestAppendAudio_TruncatesLongTracks(t *testing.T) {
+	track := appendAudio(make([]byte, maxTrackBytes-1), []byte{1, 2, 3})
+	assert.Len(t, track, maxTrackBytes)
+	assert.Len(t, appendAudio(track, []byte{4}), maxTrackBytes)
+}
+
+func TestSession_SkipsSilentTurnAndKeepsFirstCloseReason(t *testing.T) {
+	session, rec := newTestSession()
+
+	session.OnUserAudio(nil)
+	session.SetCloseReason(models.CloseReasonQuotaExceeded)
+	session.SetCloseReason(models.CloseReasonError)
+	session.End()
+	session.End()
+	session.OnUserTranscript("late")
+
+	assert.Empty(t, rec.turns)
+	assert.Zero(t, session.SavedTurns())
+	assert.Equal(t, models.CloseReasonQuotaExceeded, rec.reason)
+	assert.Equal(t, 1, rec.ended)
+}
+
+func TestSession_PrivateSavesNothing(t *testing.T) {
+	session := newPrivateSession("conversation-1", time.Now)
+
+	session.OnUserAudio([]byte{1, 2})
+	session.OnUserTranscript("xin chào")
+	session.OnAIText("r1", "Chào bạn", time.Time{})
+	session.OnAIAudio([]byte{3, 4}, time.Time{})
+	session.OnUserTranscript("cảm ơn")
+	session.End()
+
+	assert.Equal(t, 0, session.SavedTurns())
+	assert.Equal(t, "conversation-1", session.ConversationID())
+}
@@ -11,6 +11,11 @@ import (
 	"gorm.io/gorm/logger"
 )
 
+// DefaultTimeZone is the time zone calendar days are counted in, such as the days of daily usage quotas,
+// and the days of users' progress unless they set their own time zone.
+// Database sessions use UTC; timestamps are stored with their zone and days are computed in Go.
+const DefaultTimeZone = "Asia/Tokyo"
+
 // NewGormDB creates a new Gorm database connection
 func NewGormDB() (*gorm.DB, error) {
 	// Get database connection details from environment variables
@@ -23,7 +28,7 @@ func NewGormDB() (*gorm.DB, error) {
 
 	// Build DSN (Data Source Name)
 	dsn := fmt.Sprintf(
-		"host=%s user=%s password=%s dbname=%s port=%s sslmode=%s TimeZone=Asia/Tokyo",
+		"host=%s user=%s password=%s dbname=%s port=%s sslmode=%s TimeZone=UTC",
 		host, user, password, dbname, port, sslmode,
 	)
 
@@ -0,0 +1,43 @@
+package entitlement
+
+import (
+	"crypto/rand"
+	"fmt"
+	"strings"
+)
+
+// codeAlphabet leaves out characters that are easily confused (0/O, 1/I).
+// It has 32 characters so a random byte maps onto it without bias.
+const codeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// codeGroups and codeGroupSize give codes like SPRING-ABCD-EFGH-JKLM (60 random bits)
+const (
+	codeGroups    = 3
+	codeGroupSize = 4
+)
+
+// GeneratePromoCode returns a random promo code, prefixed with prefix when given
+func GeneratePromoCode(prefix string) (string, error) {
+	random := make([]byte, codeGroups*codeGroupSize)
+	if _, err := rand.Read(random); err != nil {
+		return "", fmt.Errorf("failed to generate promo code: %w", err)
+	}
+
+	groups := make([]string, 0, codeGroups+1)
+	if prefix = NormalizePromoCode(prefix); prefix != "" {
+		groups = append(groups, prefix)
+	}
+	for i := 0; i < codeGroups; i++ {
+		group := make([]byte, codeGroupSize)
+		for j := range group {
+			group[j] = codeAlphabet[random[i*codeGroupSize+j]&31]
+		}
+		groups = append(groups, string(group))
+	}
+	return strings.Join(groups, "-"), nil
+}
+
+// NormalizePromoCode converts user input into the stored form of a code
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(code), ""))
+}
@@ -0,0 +1,160 @@
+package entitlement
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+)
+
+// pastDueGracePeriod is how long a past due subscription keeps its plan after
+// the paid period ended, giving the provider time to retry the payment
+const pastDueGracePeriod = 7 * 24 * time.Hour
+
+// PlanFromSubscription derives the plan a subscription currently entitles its user to
+func PlanFromSubscription(subscription *models.Subscription, now time.Time) models.UserPlan {
+	switch subscription.Status {
+	case models.SubscriptionTrialing:
+		if subscription.TrialEndsAt == nil || now.Before(*subscription.TrialEndsAt) {
+			return subscription.Plan
+		}
+	case models.SubscriptionActive:
+		return subscription.Plan
+	case models.SubscriptionPastDue:
+		if subscription.CurrentPeriodEnd == nil || now.Before(subscription.CurrentPeriodEnd.Add(pastDueGracePeriod)) {
+			return subscription.Plan
+		}
+	case models.SubscriptionCanceled:
+		// Canceled subscriptions stay usable until the end of the paid period
+		if subscription.CurrentPeriodEnd != nil && now.Before(*subscription.CurrentPeriodEnd) {
+			return subscription.Plan
+		}
+	}
+	return models.PlanFree
+}
+
+// planRank orders plans from least to most capable
+var planRank = map[models.UserPlan]int{
+	models.PlanFree:    0,
+	models.PlanLite:    1,
+	models.PlanPremium: 2,
+}
+
+// higherPlan returns the more capable of two plans
+func higherPlan(a, b models.UserPlan) models.UserPlan {
+	if planRank[b] > planRank[a] {
+		return b
+	}
+	return a
+}
+
+// IsUpgrade reports whether moving from current to plan gains capabilities
+func IsUpgrade(current, plan models.UserPlan) bool {
+	return planRank[plan] > planRank[current]
+}
+
+// applyGrants upgrades base with the grants active at now.
+// It also returns the IDs of the grants that have ended and need to be marked reverted.
+func applyGrants(base models.UserPlan, grants []models.PlanGrant, now time.Time) (models.UserPlan, *models.PlanGrant, []string) {
+	plan := base
+	var best *models.PlanGrant
+	var ended []string
+	for i := range grants {
+		grant := &grants[i]
+		if !now.Before(grant.EndsAt) {
+			ended = append(ended, grant.PlanGrantsID)
+			continue
+		}
+		if higherPlan(plan, grant.Plan) != plan {
+			plan = grant.Plan
+			best = grant
+		}
+	}
+	return plan, best, ended
+}
+
+// decidePlan derives the effective plan of a user from their subscription and promo code grants.
+// Users without a subscription keep the plan set by support: users.plan, or while grants are open
+// the previous_plan of the oldest grant, which support plan changes keep up to date.
+func decidePlan(user *models.User, grants []models.PlanGrant, subscription *models.Subscription, now time.Time) repository.PlanChange {
+	base := user.Plan
+	if len(grants) > 0 {
+		base = grants[0].PreviousPlan
+	}
+	reason := "promo code grant ended"
+	if subscription != nil {
+		base = PlanFromSubscription(subscription, now)
+		reason = fmt.Sprintf("subscription %s is %s", subscription.ProviderSubscriptionID, subscription.Status)
+	}
+
+	plan, grant, ended := applyGrants(base, grants, now)
+	if grant != nil {
+		reason = fmt.Sprintf("promo code grant until %s", grant.EndsAt.Format(time.RFC3339))
+	}
+	return repository.PlanChange{Plan: plan, Reason: reason, EndedGrantIDs: ended}
+}
+
+// Resolver keeps users.plan in sync with the user's entitlements: their subscription
+// and any promo code grants. Users without a subscription keep the plan set by support.
+type Resolver struct {
+	promos repository.PromoRepository
+	now    func() time.Time
+}
+
+// NewResolver creates a new plan resolver
+func NewResolver(promos repository.PromoRepository) *Resolver {
+	return &Resolver{
+		promos: promos,
+		now:    time.Now,
+	}
+}
+
+// Refresh recomputes the effective plan of a user, persists it when it changed
+// (recording the change in the audit log) and returns it
+func (r *Resolver) Refresh(ctx context.Context, userID string) (models.UserPlan, error) {
+	now := r.now()
+	previous, change, err := r.promos.RefreshPlan(ctx, userID, now, func(user *models.User, grants []models.PlanGrant, subscription *models.Subscription) repository.PlanChange {
+		return decidePlan(user, grants, subscription, now)
+	})
+	if err != nil {
+		return "", err
+	}
+	if change.Plan != previous {
+		log.Printf("Effective plan of user %s changed from %s to %s (%s)", userID, previous, change.Plan, change.Reason)
+	}
+	return change.Plan, nil
+}
+
+// RevertEndedGrants refreshes every user whose promo code grant has ended,
+// so their plan reverts even if they never connect again
+func (r *Resolver) RevertEndedGrants(ctx context.Context) error {
+	userIDs, err := r.promos.ListUsersWithEndedGrants(ctx, r.now())
+	if err != nil {
+		return err
+	}
+	for _, userID := range userIDs {
+		if _, err := r.Refresh(ctx, userID); err != nil {
+			return fmt.Errorf("failed to refresh plan of user %s: %w", userID, err)
+		}
+	}
+	return nil
+}
+
+// RunGrantExpiry calls RevertEndedGrants every interval until ctx is canceled
+func (r *Resolver) RunGrantExpiry(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.RevertEndedGrants(ctx); err != nil {
+				log.Printf("Failed to revert ended plan grants: %v", err)
+			}
+		}
+	}
+}
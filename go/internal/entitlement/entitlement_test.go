@@ -0,0 +1,178 @@
+package entitlement
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanFromSubscription(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+	longAgo := now.Add(-30 * 24 * time.Hour)
+
+	tests := []struct {
+		name         string
+		subscription models.Subscription
+		expected     models.UserPlan
+	}{
+		{
+			name:         "trial in progress grants the plan",
+			subscription: models.Subscription{Plan: models.PlanPremium, Status: models.SubscriptionTrialing, TrialEndsAt: &future},
+			expected:     models.PlanPremium,
+		},
+		{
+			name:         "expired trial falls back to free",
+			subscription: models.Subscription{Plan: models.PlanPremium, Status: models.SubscriptionTrialing, TrialEndsAt: &past},
+			expected:     models.PlanFree,
+		},
+		{
+			name:         "active subscription grants the plan",
+			subscription: models.Subscription{Plan: models.PlanLite, Status: models.SubscriptionActive, CurrentPeriodEnd: &past},
+			expected:     models.PlanLite,
+		},
+		{
+			name:         "past due within grace period keeps the plan",
+			subscription: models.Subscription{Plan: models.PlanLite, Status: models.SubscriptionPastDue, CurrentPeriodEnd: &past},
+			expected:     models.PlanLite,
+		},
+		{
+			name:         "past due after grace period falls back to free",
+			subscription: models.Subscription{Plan: models.PlanLite, Status: models.SubscriptionPastDue, CurrentPeriodEnd: &longAgo},
+			expected:     models.PlanFree,
+		},
+		{
+			name:         "canceled keeps the plan until the period ends",
+			subscription: models.Subscription{Plan: models.PlanPremium, Status: models.SubscriptionCanceled, CurrentPeriodEnd: &future},
+			expected:     models.PlanPremium,
+		},
+		{
+			name:         "canceled after the period ends falls back to free",
+			subscription: models.Subscription{Plan: models.PlanPremium, Status: models.SubscriptionCanceled, CurrentPeriodEnd: &past},
+			expected:     models.PlanFree,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, PlanFromSubscription(&tt.subscription, now))
+		})
+	}
+}
+
+func TestApplyGrants(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	grants := []models.PlanGrant{
+		{PlanGrantsID: "ended", Plan: models.PlanPremium, EndsAt: now.Add(-time.Hour)},
+		{PlanGrantsID: "lite", Plan: models.PlanLite, EndsAt: now.Add(24 * time.Hour)},
+	}
+
+	plan, grant, ended := applyGrants(models.PlanFree, grants, now)
+	assert.Equal(t, models.PlanLite, plan)
+	assert.Equal(t, "lite", grant.PlanGrantsID)
+	assert.Equal(t, []string{"ended"}, ended)
+}
+
+func TestApplyGrants_DoesNotDowngrade(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	grants := []models.PlanGrant{
+		{PlanGrantsID: "lite", Plan: models.PlanLite, EndsAt: now.Add(time.Hour)},
+	}
+
+	plan, grant, ended := applyGrants(models.PlanPremium, grants, now)
+	assert.Equal(t, models.PlanPremium, plan)
+	assert.Nil(t, grant)
+	assert.Empty(t, ended)
+}
+
+// fakePlans keeps one user's plan and grants the way the gateways do
+type fakePlans struct {
+	repository.PromoRepository
+	user   models.User
+	grants []models.PlanGrant
+}
+
+func (f *fakePlans) RefreshPlan(_ context.Context, _ string, revertedAt time.Time, decide repository.PlanDecider) (models.UserPlan, repository.PlanChange, error) {
+	var open []models.PlanGrant
+	for _, grant := range f.grants {
+		if grant.RevertedAt == nil {
+			open = append(open, grant)
+		}
+	}
+	previous := f.user.Plan
+	change := decide(&f.user, open, nil)
+	f.user.Plan = change.Plan
+	for i := range f.grants {
+		for _, id := range change.EndedGrantIDs {
+			if f.grants[i].PlanGrantsID == id {
+				f.grants[i].RevertedAt = &revertedAt
+			}
+		}
+	}
+	return previous, change, nil
+}
+
+// updateUserPlan changes the plan like AdminRepository.UpdateUserPlan, including the open grants' previous plan
+func (f *fakePlans) updateUserPlan(plan models.UserPlan) {
+	f.user.Plan = plan
+	for i := range f.grants {
+		if f.grants[i].RevertedAt == nil {
+			f.grants[i].PreviousPlan = plan
+		}
+	}
+}
+
+func TestResolver_AdminChangesPlanDuringOpenGrant(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	plans := &fakePlans{
+		user:   models.User{UsersID: "user", Plan: models.PlanPremium},
+		grants: []models.PlanGrant{{PlanGrantsID: "grant", Plan: models.PlanPremium, PreviousPlan: models.PlanFree, EndsAt: now.Add(24 * time.Hour)}},
+	}
+	resolver := NewResolver(plans)
+	resolver.now = func() time.Time { return now }
+
+	// Support upgrades the user to lite while the premium grant is still running
+	plans.updateUserPlan(models.PlanLite)
+	plan, err := resolver.Refresh(ctx, "user")
+	require.NoError(t, err)
+	assert.Equal(t, models.PlanPremium, plan)
+
+	// Once the grant ends the user keeps the plan support set, not the one before the grant
+	now = now.Add(48 * time.Hour)
+	plan, err = resolver.Refresh(ctx, "user")
+	require.NoError(t, err)
+	assert.Equal(t, models.PlanLite, plan)
+	assert.Equal(t, models.PlanLite, plans.user.Plan)
+	assert.NotNil(t, plans.grants[0].RevertedAt)
+}
+
+func TestDecidePlan_SubscriptionOverridesSupportPlan(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	subscription := &models.Subscription{ProviderSubscriptionID: "sub", Plan: models.PlanLite, Status: models.SubscriptionActive}
+
+	change := decidePlan(&models.User{Plan: models.PlanFree}, nil, subscription, now)
+	assert.Equal(t, models.PlanLite, change.Plan)
+	assert.Equal(t, "subscription sub is ACTIVE", change.Reason)
+	assert.Empty(t, change.EndedGrantIDs)
+}
+
+func TestGeneratePromoCode(t *testing.T) {
+	code, err := GeneratePromoCode("spring ")
+	assert.NoError(t, err)
+	assert.Regexp(t, `^SPRING-[A-HJ-NP-Z2-9]{4}-[A-HJ-NP-Z2-9]{4}-[A-HJ-NP-Z2-9]{4}$`, code)
+
+	other, err := GeneratePromoCode("")
+	assert.NoError(t, err)
+	assert.Len(t, other, 14)
+}
+
+func TestNormalizePromoCode(t *testing.T) {
+	assert.Equal(t, "SPRING-ABCD-EFGH", NormalizePromoCode("  spring-abcd -efgh "))
+}
@@ -0,0 +1,315 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AdminRepository handles support operations on user accounts
+type AdminRepository struct {
+	db *gorm.DB
+}
+
+// NewAdminRepository creates a new admin repository
+func NewAdminRepository(db *gorm.DB) *AdminRepository {
+	return &AdminRepository{db: db}
+}
+
+// ListUsers returns a page of users matching the filter together with the total match count
+func (r *AdminRepository) ListUsers(ctx context.Context, filter repository.UserFilter) ([]models.User, int64, error) {
+	query := r.db.WithContext(ctx).Model(&models.User{})
+	if filter.EmailPrefix != "" {
+		query = query.Where("email LIKE ?", escapeLike(filter.EmailPrefix)+"%")
+	}
+	if filter.Plan != "" {
+		query = query.Where("plan = ?", filter.Plan)
+	}
+	if filter.CreatedAfter != nil {
+		query = query.Where("created_at >= ?", *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		query = query.Where("created_at < ?", *filter.CreatedBefore)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	var users []models.User
+	result := query.Order("created_at DESC, users_id").Limit(filter.Limit).Offset(filter.Offset).Find(&users)
+	if result.Error != nil {
+		return nil, 0, fmt.Errorf("failed to list users: %w", result.Error)
+	}
+	return users, total, nil
+}
+
+// CountActiveSessions counts the unexpired refresh tokens of a user
+func (r *AdminRepository) CountActiveSessions(ctx context.Context, userID string) (int64, error) {
+	var count int64
+	result := r.db.WithContext(ctx).Model(&models.RefreshToken{}).
+		Where("user_id = ? AND expires_at > NOW()", userID).
+		Count(&count)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to count sessions: %w", result.Error)
+	}
+	return count, nil
+}
+
+// UpdateUserPlan changes the plan of a user and makes it the plan their open grants revert to
+func (r *AdminRepository) UpdateUserPlan(ctx context.Context, actorID, userID string, plan models.UserPlan, reason string) (*models.User, error) {
+	var user models.User
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockUser(tx, userID, &user); err != nil {
+			return err
+		}
+		previous := user.Plan
+		if err := tx.Model(&user).Update("plan", plan).Error; err != nil {
+			return fmt.Errorf("failed to update plan: %w", err)
+		}
+		result := tx.Model(&models.PlanGrant{}).
+			Where("user_id = ? AND reverted_at IS NULL", userID).
+			Update("previous_plan", plan)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update plan grants: %w", result.Error)
+		}
+		user.Plan = plan
+		return writeAuditLog(tx, actorID, userID, models.AuditActionPlanChanged, map[string]any{
+			"from":   previous,
+			"to":     plan,
+			"reason": reason,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// SetUserDisabled disables or re-enables a user account.
+// Disabling an account also revokes all of its sessions.
+func (r *AdminRepository) SetUserDisabled(ctx context.Context, actorID, userID string, disabled bool, reason string) (*models.User, error) {
+	var user models.User
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockUser(tx, userID, &user); err != nil {
+			return err
+		}
+
+		var disabledAt *time.Time
+		action := models.AuditActionUserEnabled
+		details := map[string]any{"reason": reason}
+		if disabled {
+			now := time.Now()
+			disabledAt = &now
+			action = models.AuditActionUserDisabled
+
+			result := tx.Where("user_id = ?", userID).Delete(&models.RefreshToken{})
+			if result.Error != nil {
+				return fmt.Errorf("failed to revoke sessions: %w", result.Error)
+			}
+			details["revoked_sessions"] = result.RowsAffected
+		}
+
+		if err := tx.Model(&user).Update("disabled_at", disabledAt).Error; err != nil {
+			return fmt.Errorf("failed to update disabled_at: %w", err)
+		}
+		user.DisabledAt = disabledAt
+		return writeAuditLog(tx, actorID, userID, action, details)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// SetUserRole changes the role of a user
+func (r *AdminRepository) SetUserRole(ctx context.Context, actorID, userID string, role models.UserRole, reason string) (*models.User, error) {
+	var user models.User
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockUser(tx, userID, &user); err != nil {
+			return err
+		}
+		previous := user.Role
+		if err := tx.Model(&user).Update("role", role).Error; err != nil {
+			return fmt.Errorf("failed to update role: %w", err)
+		}
+		user.Role = role
+		return writeAuditLog(tx, actorID, userID, models.AuditActionRoleChanged, map[string]any{
+			"from":   previous,
+			"to":     role,
+			"reason": reason,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// RevokeSessions deletes every refresh token of a user and returns how many were removed
+func (r *AdminRepository) RevokeSessions(ctx context.Context, actorID, userID, reason string) (int64, error) {
+	var revoked int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var user models.User
+		if err := lockUser(tx, userID, &user); err != nil {
+			return err
+		}
+		result := tx.Where("user_id = ?", userID).Delete(&models.RefreshToken{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to revoke sessions: %w", result.Error)
+		}
+		revoked = result.RowsAffected
+		return writeAuditLog(tx, actorID, userID, models.AuditActionForceLogout, map[string]any{
+			"revoked_sessions": revoked,
+			"reason":           reason,
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+	return revoked, nil
+}
+
+// CreatePasswordResetToken stores a password reset token, invalidating any previous unused ones
+func (r *AdminRepository) CreatePasswordResetToken(ctx context.Context, actorID string, token *models.PasswordResetToken, reason string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var user models.User
+		if err := lockUser(tx, token.UserID, &user); err != nil {
+			return err
+		}
+		result := tx.Where("user_id = ? AND used_at IS NULL", token.UserID).Delete(&models.PasswordResetToken{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to invalidate password reset tokens: %w", result.Error)
+		}
+		if err := tx.Create(token).Error; err != nil {
+			return fmt.Errorf("failed to save password reset token: %w", err)
+		}
+		return writeAuditLog(tx, actorID, token.UserID, models.AuditActionPasswordResetTriggered, map[string]any{
+			"expires_at": token.ExpiresAt,
+			"reason":     reason,
+		})
+	})
+}
+
+// ListAuditLogs returns audit log entries, newest first
+func (r *AdminRepository) ListAuditLogs(ctx context.Context, filter repository.AuditLogFilter) ([]models.AuditLog, error) {
+	query := r.db.WithContext(ctx).Model(&models.AuditLog{})
+	if filter.TargetUserID != "" {
+		query = query.Where("target_user_id = ?", filter.TargetUserID)
+	}
+
+	var logs []models.AuditLog
+	result := query.Order("created_at DESC, audit_logs_id").Limit(filter.Limit).Offset(filter.Offset).Find(&logs)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list audit logs: %w", result.Error)
+	}
+	return logs, nil
+}
+
+// PurgeExpired deletes expired refresh tokens and expired or used password reset tokens
+func (r *AdminRepository) PurgeExpired(ctx context.Context) (*repository.PurgeResult, error) {
+	var purged repository.PurgeResult
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("expires_at <= NOW()").Delete(&models.RefreshToken{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to purge refresh tokens: %w", result.Error)
+		}
+		purged.RefreshTokens = result.RowsAffected
+
+		result = tx.Where("expires_at <= NOW() OR used_at IS NOT NULL").Delete(&models.PasswordResetToken{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to purge password reset tokens: %w", result.Error)
+		}
+		purged.PasswordResetTokens = result.RowsAffected
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &purged, nil
+}
+
+// GetUserStats aggregates user and session counts
+func (r *AdminRepository) GetUserStats(ctx context.Context) (*repository.UserStats, error) {
+	db := r.db.WithContext(ctx)
+	stats := repository.UserStats{UsersByPlan: map[models.UserPlan]int64{}}
+
+	var byPlan []struct {
+		Plan  models.UserPlan
+		Count int64
+	}
+	if err := db.Model(&models.User{}).Select("plan, COUNT(*) AS count").Group("plan").Scan(&byPlan).Error; err != nil {
+		return nil, fmt.Errorf("failed to count users by plan: %w", err)
+	}
+	for _, row := range byPlan {
+		stats.UsersByPlan[row.Plan] = row.Count
+		stats.TotalUsers += row.Count
+	}
+
+	counts := []struct {
+		target *int64
+		model  any
+		query  string
+		args   []any
+	}{
+		{&stats.Admins, &models.User{}, "role = ?", []any{models.RoleAdmin}},
+		{&stats.DisabledUsers, &models.User{}, "disabled_at IS NOT NULL", nil},
+		{&stats.NewUsersLastWeek, &models.User{}, "created_at >= ?", []any{time.Now().AddDate(0, 0, -7)}},
+		{&stats.ActiveSessions, &models.RefreshToken{}, "expires_at > NOW()", nil},
+	}
+	for _, c := range counts {
+		if err := db.Model(c.model).Where(c.query, c.args...).Count(c.target).Error; err != nil {
+			return nil, fmt.Errorf("failed to collect user stats: %w", err)
+		}
+	}
+	return &stats, nil
+}
+
+// lockUser loads a user row with FOR UPDATE so concurrent admin actions are serialized
+func lockUser(tx *gorm.DB, userID string, user *models.User) error {
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("users_id = ?", userID).First(user)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return repository.ErrUserNotFound
+		}
+		return fmt.Errorf("failed to get user: %w", result.Error)
+	}
+	return nil
+}
+
+// writeAuditLog appends an entry to the audit trail within the given transaction
+func writeAuditLog(tx *gorm.DB, actorID, targetUserID string, action models.AuditAction, details map[string]any) error {
+	encoded, err := json.Marshal(details)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit details: %w", err)
+	}
+
+	entry := models.AuditLog{
+		Action:  action,
+		Details: string(encoded),
+	}
+	if actorID != "" {
+		entry.ActorUserID = &actorID
+	}
+	if targetUserID != "" {
+		entry.TargetUserID = &targetUserID
+	}
+	if err := tx.Create(&entry).Error; err != nil {
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+	return nil
+}
+
+// escapeLike escapes the LIKE wildcards in s so it is matched literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,106 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"gorm.io/gorm"
+)
+
+// CharacterRepository handles conversation character data operations
+type CharacterRepository struct {
+	db *gorm.DB
+}
+
+// NewCharacterRepository creates a new character repository
+func NewCharacterRepository(db *gorm.DB) *CharacterRepository {
+	return &CharacterRepository{db: db}
+}
+
+// ListCharacters returns the characters in listing order. Retired characters are included only when includeRetired is set.
+func (r *CharacterRepository) ListCharacters(ctx context.Context, includeRetired bool) ([]models.Character, error) {
+	query := r.db.WithContext(ctx).Model(&models.Character{})
+	if !includeRetired {
+		query = query.Where("active")
+	}
+
+	var characters []models.Character
+	if err := query.Order("position, key").Find(&characters).Error; err != nil {
+		return nil, fmt.Errorf("failed to list characters: %w", err)
+	}
+	return characters, nil
+}
+
+// GetCharacterByKey returns the character with the key, including a retired one
+func (r *CharacterRepository) GetCharacterByKey(ctx context.Context, key string) (*models.Character, error) {
+	var character models.Character
+	result := r.db.WithContext(ctx).Where("key = ?", key).First(&character)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrCharacterNotFound
+		}
+		return nil, fmt.Errorf("failed to get character: %w", result.Error)
+	}
+	return &character, nil
+}
+
+// CreateCharacter inserts the character and records the change in the audit log
+func (r *CharacterRepository) CreateCharacter(ctx context.Context, actorID string, character *models.Character, reason string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing int64
+		if err := tx.Model(&models.Character{}).Where("key = ?", character.Key).Count(&existing).Error; err != nil {
+			return fmt.Errorf("failed to check character key: %w", err)
+		}
+		if existing > 0 {
+			return repository.ErrCharacterAlreadyExists
+		}
+		if err := tx.Create(character).Error; err != nil {
+			return fmt.Errorf("failed to create character: %w", err)
+		}
+		return writeAuditLog(tx, actorID, "", models.AuditActionCharacterCreated, map[string]any{
+			"key":    character.Key,
+			"reason": reason,
+		})
+	})
+}
+
+// UpdateCharacter saves every field of the character and records the change in the audit log
+func (r *CharacterRepository) UpdateCharacter(ctx context.Context, actorID string, character *models.Character, reason string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Character{}).
+			Where("characters_id = ?", character.CharactersID).
+			Select("persona", "gender", "age_band", "min_plan", "voices", "display_names", "position", "active", "updated_at").
+			Updates(character)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update character: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return repository.ErrCharacterNotFound
+		}
+		return writeAuditLog(tx, actorID, "", models.AuditActionCharacterUpdated, map[string]any{
+			"key":    character.Key,
+			"active": character.Active,
+			"reason": reason,
+		})
+	})
+}
+
+// DeleteCharacter retires the character so past conversations keep resolving it, and records the change in the audit log
+func (r *CharacterRepository) DeleteCharacter(ctx context.Context, actorID, key, reason string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Character{}).Where("key = ? AND active", key).Update("active", false)
+		if result.Error != nil {
+			return fmt.Errorf("failed to retire character: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return repository.ErrCharacterNotFound
+		}
+		return writeAuditLog(tx, actorID, "", models.AuditActionCharacterDeleted, map[string]any{
+			"key":    key,
+			"reason": reason,
+		})
+	})
+}
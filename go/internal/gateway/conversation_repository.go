@@ -0,0 +1,262 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ConversationRepository handles conversation data operations
+type ConversationRepository struct {
+	db *gorm.DB
+}
+
+// NewConversationRepository creates a new conversation repository
+func NewConversationRepository(db *gorm.DB) *ConversationRepository {
+	return &ConversationRepository{db: db}
+}
+
+// CreateConversation creates a new conversation
+func (r *ConversationRepository) CreateConversation(ctx context.Context, conversation *models.Conversation) error {
+	if err := r.db.WithContext(ctx).Omit("Turns").Create(conversation).Error; err != nil {
+		return fmt.Errorf("failed to create conversation: %w", err)
+	}
+	return nil
+}
+
+// EndConversation records when and why a conversation ended
+func (r *ConversationRepository) EndConversation(ctx context.Context, conversationID string, endedAt time.Time, reason models.CloseReason) error {
+	result := r.db.WithContext(ctx).Model(&models.Conversation{}).
+		Where("conversations_id = ?", conversationID).
+		Updates(map[string]any{"ended_at": endedAt, "close_reason": reason})
+	if result.Error != nil {
+		return fmt.Errorf("failed to end conversation: %w", result.Error)
+	}
+	return nil
+}
+
+// SaveTurn appends a turn with its feedback to the conversation, setting its sequence number to the next one.
+// The conversation row is locked while the number is allocated, so sessions writing to the same conversation,
+// e.g. after a quick reconnect, never reuse a number; a turn that still collides fails instead of replacing one.
+func (r *ConversationRepository) SaveTurn(ctx context.Context, turn *models.ConversationTurn) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var conversation models.Conversation
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("conversations_id").
+			Where("conversations_id = ?", turn.ConversationID).
+			First(&conversation)
+		if result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				return repository.ErrConversationNotFound
+			}
+			return fmt.Errorf("failed to lock conversation: %w", result.Error)
+		}
+
+		var last int
+		err := tx.Model(&models.ConversationTurn{}).
+			Where("conversation_id = ?", turn.ConversationID).
+			Select("COALESCE(MAX(seq), 0)").
+			Scan(&last).Error
+		if err != nil {
+			return fmt.Errorf("failed to get last turn: %w", err)
+		}
+		turn.Seq = last + 1
+		if err := tx.Omit("Feedback").Create(turn).Error; err != nil {
+			return fmt.Errorf("failed to save conversation turn: %w", err)
+		}
+
+		if len(turn.Feedback) == 0 {
+			return nil
+		}
+		for i := range turn.Feedback {
+			turn.Feedback[i].ConversationTurnID = turn.ConversationTurnsID
+			turn.Feedback[i].Position = i
+		}
+		if err := tx.Create(&turn.Feedback).Error; err != nil {
+			return fmt.Errorf("failed to save turn feedback: %w", err)
+		}
+		return nil
+	})
+}
+
+// SetTurnAudio sets the audio keys of a saved turn
+func (r *ConversationRepository) SetTurnAudio(ctx context.Context, turnID, userAudioKey, aiAudioKey string) error {
+	result := r.db.WithContext(ctx).Model(&models.ConversationTurn{}).
+		Where("conversation_turns_id = ?", turnID).
+		Updates(map[string]any{"user_audio_key": userAudioKey, "ai_audio_key": aiAudioKey})
+	if result.Error != nil {
+		return fmt.Errorf("failed to set turn audio: %w", result.Error)
+	}
+	return nil
+}
+
+// ListConversations returns a page of conversations, newest first
+func (r *ConversationRepository) ListConversations(ctx context.Context, filter repository.ConversationFilter) ([]models.Conversation, error) {
+	query := r.db.WithContext(ctx).Model(&models.Conversation{}).Where("user_id = ?", filter.UserID)
+	if filter.Language != "" {
+		query = query.Where("language = ?", filter.Language)
+	}
+	if filter.Character != "" {
+		query = query.Where(`"character" = ?`, filter.Character)
+	}
+	if filter.StartedAfter != nil {
+		query = query.Where("started_at >= ?", *filter.StartedAfter)
+	}
+	if filter.StartedBefore != nil {
+		query = query.Where("started_at < ?", *filter.StartedBefore)
+	}
+	if filter.After != nil {
+		query = query.Where("(started_at, conversations_id) < (?, ?)", filter.After.StartedAt, filter.After.ConversationID)
+	}
+
+	var conversations []models.Conversation
+	result := query.Order("started_at DESC, conversations_id DESC").Limit(filter.Limit).Find(&conversations)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list conversations: %w", result.Error)
+	}
+	return conversations, nil
+}
+
+// GetConversation returns a conversation of the user without its turns
+func (r *ConversationRepository) GetConversation(ctx context.Context, userID, conversationID string) (*models.Conversation, error) {
+	var conversation models.Conversation
+	result := r.db.WithContext(ctx).
+		Where("conversations_id = ? AND user_id = ?", conversationID, userID).
+		First(&conversation)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrConversationNotFound
+		}
+		return nil, fmt.Errorf("failed to get conversation: %w", result.Error)
+	}
+	return &conversation, nil
+}
+
+// ListConversationTurns returns up to limit turns of a conversation after the sequence number afterSeq in order,
+// with their feedback. A limit of zero returns every remaining turn.
+func (r *ConversationRepository) ListConversationTurns(ctx context.Context, conversationID string, afterSeq, limit int) ([]models.ConversationTurn, error) {
+	query := r.db.WithContext(ctx).
+		Preload("Feedback", func(db *gorm.DB) *gorm.DB { return db.Order("position") }).
+		Where("conversation_id = ? AND seq > ?", conversationID, afterSeq).
+		Order("seq")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	var turns []models.ConversationTurn
+	if err := query.Find(&turns).Error; err != nil {
+		return nil, fmt.Errorf("failed to list conversation turns: %w", err)
+	}
+	return turns, nil
+}
+
+// ListRecentConversationTurns returns the last limit turns of a conversation in order
+func (r *ConversationRepository) ListRecentConversationTurns(ctx context.Context, conversationID string, limit int) ([]models.ConversationTurn, error) {
+	var turns []models.ConversationTurn
+	if err := r.db.WithContext(ctx).
+		Where("conversation_id = ?", conversationID).
+		Order("seq DESC").
+		Limit(limit).
+		Find(&turns).Error; err != nil {
+		return nil, fmt.Errorf("failed to list recent conversation turns: %w", err)
+	}
+	slices.Reverse(turns)
+	return turns, nil
+}
+
+// DeleteConversation deletes a conversation of the user. Its turns are removed by the cascade.
+func (r *ConversationRepository) DeleteConversation(ctx context.Context, userID, conversationID string) error {
+	result := r.db.WithContext(ctx).
+		Where("conversations_id = ? AND user_id = ?", conversationID, userID).
+		Delete(&models.Conversation{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete conversation: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrConversationNotFound
+	}
+	return nil
+}
+
+// DeleteAllConversations deletes every conversation of the user and returns how many were deleted
+func (r *ConversationRepository) DeleteAllConversations(ctx context.Context, userID string) (int64, error) {
+	result := r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&models.Conversation{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete conversations: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// GetTurn returns a turn of a conversation owned by the user
+func (r *ConversationRepository) GetTurn(ctx context.Context, userID, conversationID string, seq int) (*models.ConversationTurn, error) {
+	var turn models.ConversationTurn
+	result := r.db.WithContext(ctx).
+		Joins("JOIN conversations ON conversations.conversations_id = conversation_turns.conversation_id").
+		Where("conversation_turns.conversation_id = ? AND conversation_turns.seq = ? AND conversations.user_id = ?", conversationID, seq, userID).
+		First(&turn)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrConversationNotFound
+		}
+		return nil, fmt.Errorf("failed to get conversation turn: %w", result.Error)
+	}
+	return &turn, nil
+}
+
+// searchVector is the tsvector of a turn's search lexemes, matching idx_conversation_turns_search_text
+const searchVector = "array_to_tsvector(string_to_array(conversation_turns.search_text, ' '))"
+
+// SearchTurns returns the user's turns matching the filter with their conversation, best match first
+func (r *ConversationRepository) SearchTurns(ctx context.Context, filter repository.TranscriptSearchFilter) ([]models.ConversationTurn, error) {
+	query := r.db.WithContext(ctx).Joins("Conversation").
+		Where(`"Conversation".user_id = ?`, filter.UserID).
+		Where(searchVector+" @@ ?::tsquery", filter.TSQuery)
+	if filter.Language != "" {
+		query = query.Where(`"Conversation".language = ?`, filter.Language)
+	}
+
+	var turns []models.ConversationTurn
+	result := query.
+		Order(clause.OrderBy{Expression: clause.Expr{
+			SQL:                "ts_rank(" + searchVector + ", ?::tsquery) DESC",
+			Vars:               []any{filter.TSQuery},
+			WithoutParentheses: true,
+		}}).
+		Order(`"Conversation".started_at DESC, conversation_turns.seq`).
+		Limit(filter.Limit).Offset(filter.Offset).
+		Find(&turns)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to search conversation turns: %w", result.Error)
+	}
+	return turns, nil
+}
+
+// ListTurns returns up to limit turns of every user ordered by ID, starting after afterID
+func (r *ConversationRepository) ListTurns(ctx context.Context, afterID string, limit int) ([]models.ConversationTurn, error) {
+	query := r.db.WithContext(ctx).Order("conversation_turns_id").Limit(limit)
+	if afterID != "" {
+		query = query.Where("conversation_turns_id > ?", afterID)
+	}
+	var turns []models.ConversationTurn
+	if err := query.Find(&turns).Error; err != nil {
+		return nil, fmt.Errorf("failed to list conversation turns: %w", err)
+	}
+	return turns, nil
+}
+
+// UpdateSearchText replaces the search lexemes of a turn
+func (r *ConversationRepository) UpdateSearchText(ctx context.Context, turnID, searchText string) error {
+	result := r.db.WithContext(ctx).Model(&models.ConversationTurn{}).
+		Where("conversation_turns_id = ?", turnID).
+		UpdateColumn("search_text", searchText)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update search text: %w", result.Error)
+	}
+	return nil
+}
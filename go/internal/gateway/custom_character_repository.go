@@ -0,0 +1,111 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"gorm.io/gorm"
+)
+
+// CustomCharacterRepository handles user-designed character data operations
+type CustomCharacterRepository struct {
+	db *gorm.DB
+}
+
+// NewCustomCharacterRepository creates a new custom character repository
+func NewCustomCharacterRepository(db *gorm.DB) *CustomCharacterRepository {
+	return &CustomCharacterRepository{db: db}
+}
+
+// ListCustomCharacters returns the user's characters, newest first
+func (r *CustomCharacterRepository) ListCustomCharacters(ctx context.Context, userID string) ([]models.CustomCharacter, error) {
+	var characters []models.CustomCharacter
+	result := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC, custom_characters_id").
+		Find(&characters)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list custom characters: %w", result.Error)
+	}
+	return characters, nil
+}
+
+// GetCustomCharacter returns a character of the user
+func (r *CustomCharacterRepository) GetCustomCharacter(ctx context.Context, userID, customCharacterID string) (*models.CustomCharacter, error) {
+	return r.first(r.db.WithContext(ctx).Where("custom_characters_id = ? AND user_id = ?", customCharacterID, userID))
+}
+
+// GetCustomCharacterByID returns a character of any user
+func (r *CustomCharacterRepository) GetCustomCharacterByID(ctx context.Context, customCharacterID string) (*models.CustomCharacter, error) {
+	return r.first(r.db.WithContext(ctx).Where("custom_characters_id = ?", customCharacterID))
+}
+
+// GetSharedCharacter returns the character currently shared with the token
+func (r *CustomCharacterRepository) GetSharedCharacter(ctx context.Context, shareToken string) (*models.CustomCharacter, error) {
+	return r.first(r.db.WithContext(ctx).Where("share_token = ?", shareToken))
+}
+
+func (r *CustomCharacterRepository) first(query *gorm.DB) (*models.CustomCharacter, error) {
+	var character models.CustomCharacter
+	if err := query.First(&character).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrCustomCharacterNotFound
+		}
+		return nil, fmt.Errorf("failed to get custom character: %w", err)
+	}
+	return &character, nil
+}
+
+// CreateCustomCharacter inserts the character unless its owner already keeps limit characters.
+// The owner's row is locked so concurrent creations cannot exceed the limit.
+func (r *CustomCharacterRepository) CreateCustomCharacter(ctx context.Context, character *models.CustomCharacter, limit int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var user models.User
+		if err := lockUser(tx, character.UserID, &user); err != nil {
+			return err
+		}
+		var count int64
+		if err := tx.Model(&models.CustomCharacter{}).Where("user_id = ?", character.UserID).Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to count custom characters: %w", err)
+		}
+		if count >= int64(limit) {
+			return repository.ErrCustomCharacterLimit
+		}
+		if err := tx.Omit("User").Create(character).Error; err != nil {
+			return fmt.Errorf("failed to create custom character: %w", err)
+		}
+		return nil
+	})
+}
+
+// UpdateCustomCharacter saves the design and share token of a character of its owner
+func (r *CustomCharacterRepository) UpdateCustomCharacter(ctx context.Context, character *models.CustomCharacter) error {
+	result := r.db.WithContext(ctx).Model(&models.CustomCharacter{}).
+		Where("custom_characters_id = ? AND user_id = ?", character.CustomCharactersID, character.UserID).
+		Select("name", "personality", "speaking_style", "formality", "voice", "share_token", "updated_at").
+		Updates(character)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update custom character: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrCustomCharacterNotFound
+	}
+	return nil
+}
+
+// DeleteCustomCharacter deletes a character of the user
+func (r *CustomCharacterRepository) DeleteCustomCharacter(ctx context.Context, userID, customCharacterID string) error {
+	result := r.db.WithContext(ctx).
+		Where("custom_characters_id = ? AND user_id = ?", customCharacterID, userID).
+		Delete(&models.CustomCharacter{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete custom character: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrCustomCharacterNotFound
+	}
+	return nil
+}
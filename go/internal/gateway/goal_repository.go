@@ -0,0 +1,126 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GoalRepository handles daily goal data operations
+type GoalRepository struct {
+	db *gorm.DB
+}
+
+// NewGoalRepository creates a new goal repository
+func NewGoalRepository(db *gorm.DB) *GoalRepository {
+	return &GoalRepository{db: db}
+}
+
+func (r *GoalRepository) GetGoal(ctx context.Context, userID string) (*models.LearningGoal, error) {
+	var goal models.LearningGoal
+	result := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&goal)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrGoalNotFound
+		}
+		return nil, fmt.Errorf("failed to get learning goal: %w", result.Error)
+	}
+	return &goal, nil
+}
+
+// SaveGoal creates or replaces the goal of goal.UserID
+func (r *GoalRepository) SaveGoal(ctx context.Context, goal *models.LearningGoal) error {
+	result := r.db.WithContext(ctx).Omit("User").Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"metric", "target", "reminder_enabled", "reminder_minute", "reminder_channel", "next_reminder_at", "updated_at",
+		}),
+	}).Create(goal)
+	if result.Error != nil {
+		return fmt.Errorf("failed to save learning goal: %w", result.Error)
+	}
+	return nil
+}
+
+func (r *GoalRepository) DeleteGoal(ctx context.Context, userID string) error {
+	result := r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&models.LearningGoal{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete learning goal: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrGoalNotFound
+	}
+	return nil
+}
+
+// ClaimDueReminders returns up to limit goals whose reminder is due at now with their user,
+// postponing them to leaseUntil so other schedulers skip them meanwhile.
+// The goals are returned as they were before the lease, with the time their reminder was due.
+func (r *GoalRepository) ClaimDueReminders(ctx context.Context, now, leaseUntil time.Time, limit int) ([]models.LearningGoal, error) {
+	var goals []models.LearningGoal
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("reminder_enabled AND next_reminder_at <= ?", now).
+			Order("next_reminder_at").
+			Limit(limit).
+			Find(&goals).Error; err != nil {
+			return err
+		}
+		if len(goals) == 0 {
+			return nil
+		}
+		ids := make([]string, 0, len(goals))
+		for _, goal := range goals {
+			ids = append(ids, goal.LearningGoalsID)
+		}
+		return tx.Model(&models.LearningGoal{}).
+			Where("learning_goals_id IN ?", ids).
+			UpdateColumn("next_reminder_at", leaseUntil).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim goal reminders: %w", err)
+	}
+	if len(goals) == 0 {
+		return nil, nil
+	}
+
+	userIDs := make([]string, 0, len(goals))
+	for _, goal := range goals {
+		userIDs = append(userIDs, goal.UserID)
+	}
+	var users []models.User
+	if err := r.db.WithContext(ctx).Where("users_id IN ?", userIDs).Find(&users).Error; err != nil {
+		return nil, fmt.Errorf("failed to load users of goal reminders: %w", err)
+	}
+	byID := make(map[string]*models.User, len(users))
+	for i := range users {
+		byID[users[i].UsersID] = &users[i]
+	}
+	for i := range goals {
+		goals[i].User = byID[goals[i].UserID]
+	}
+	return goals, nil
+}
+
+// RescheduleReminder sets the next reminder of a claimed goal and, when remindedAt is set, when it was last sent.
+// It does nothing when the goal was changed since it was claimed.
+func (r *GoalRepository) RescheduleReminder(ctx context.Context, goalID string, leaseUntil, next time.Time, remindedAt *time.Time) error {
+	updates := map[string]any{"next_reminder_at": next}
+	if remindedAt != nil {
+		updates["last_reminded_at"] = *remindedAt
+	}
+	result := r.db.WithContext(ctx).
+		Model(&models.LearningGoal{}).
+		Where("learning_goals_id = ? AND next_reminder_at = ?", goalID, leaseUntil).
+		UpdateColumns(updates)
+	if result.Error != nil {
+		return fmt.Errorf("failed to reschedule goal reminder: %w", result.Error)
+	}
+	return nil
+}
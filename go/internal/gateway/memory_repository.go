@@ -0,0 +1,75 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"gorm.io/gorm"
+)
+
+// MemoryRepository handles user memory data operations
+type MemoryRepository struct {
+	db *gorm.DB
+}
+
+// NewMemoryRepository creates a new memory repository
+func NewMemoryRepository(db *gorm.DB) *MemoryRepository {
+	return &MemoryRepository{db: db}
+}
+
+// ListMemories returns up to limit memories of the user, most recently updated first.
+// A limit of zero returns every memory.
+func (r *MemoryRepository) ListMemories(ctx context.Context, userID string, limit int) ([]models.UserMemory, error) {
+	query := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("updated_at DESC, user_memories_id DESC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	var memories []models.UserMemory
+	if err := query.Find(&memories).Error; err != nil {
+		return nil, fmt.Errorf("failed to list memories: %w", err)
+	}
+	return memories, nil
+}
+
+func (r *MemoryRepository) CreateMemory(ctx context.Context, memory *models.UserMemory) error {
+	if err := r.db.WithContext(ctx).Create(memory).Error; err != nil {
+		return fmt.Errorf("failed to create memory: %w", err)
+	}
+	return nil
+}
+
+// UpdateMemory replaces the content of a memory of the user
+func (r *MemoryRepository) UpdateMemory(ctx context.Context, userID, memoryID, content string) (*models.UserMemory, error) {
+	result := r.db.WithContext(ctx).
+		Model(&models.UserMemory{}).
+		Where("user_memories_id = ? AND user_id = ?", memoryID, userID).
+		Update("content", content)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to update memory: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, repository.ErrMemoryNotFound
+	}
+	var memory models.UserMemory
+	if err := r.db.WithContext(ctx).Where("user_memories_id = ?", memoryID).First(&memory).Error; err != nil {
+		return nil, fmt.Errorf("failed to get memory: %w", err)
+	}
+	return &memory, nil
+}
+
+func (r *MemoryRepository) DeleteMemory(ctx context.Context, userID, memoryID string) error {
+	result := r.db.WithContext(ctx).
+		Where("user_memories_id = ? AND user_id = ?", memoryID, userID).
+		Delete(&models.UserMemory{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete memory: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrMemoryNotFound
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"gorm.io/gorm"
+)
+
+// MistakeRepository handles aggregating the feedback stored with conversation turns
+type MistakeRepository struct {
+	db *gorm.DB
+}
+
+// NewMistakeRepository creates a new mistake repository
+func NewMistakeRepository(db *gorm.DB) *MistakeRepository {
+	return &MistakeRepository{db: db}
+}
+
+// occurrences scopes a query to the user's feedback with a pattern, joined with its turns and conversations
+func (r *MistakeRepository) occurrences(ctx context.Context, userID string) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Table("turn_feedbacks").
+		Joins("JOIN conversation_turns ON conversation_turns.conversation_turns_id = turn_feedbacks.conversation_turn_id").
+		Joins("JOIN conversations ON conversations.conversations_id = conversation_turns.conversation_id").
+		Where("conversations.user_id = ? AND turn_feedbacks.pattern <> ''", userID)
+}
+
+// ListMistakePatterns groups the user's feedback by language, category and pattern.
+// Active patterns come first, most corrected first.
+func (r *MistakeRepository) ListMistakePatterns(ctx context.Context, filter repository.MistakePatternFilter) ([]repository.MistakePattern, error) {
+	grouped := r.occurrences(ctx, filter.UserID).
+		Select(`conversations.language, turn_feedbacks.category, turn_feedbacks.pattern,
+			COUNT(*) AS count,
+			COUNT(*) FILTER (WHERE conversation_turns.started_at >= ?) AS recent_count,
+			COUNT(DISTINCT conversations.conversations_id) AS conversations,
+			MIN(conversation_turns.started_at) AS first_seen_at,
+			MAX(conversation_turns.started_at) AS last_seen_at`, filter.RecentSince).
+		Group("conversations.language, turn_feedbacks.category, turn_feedbacks.pattern").
+		Having("COUNT(*) >= ?", max(filter.MinCount, 1))
+	if filter.Language != "" {
+		grouped = grouped.Where("conversations.language = ?", filter.Language)
+	}
+	if filter.Category != "" {
+		grouped = grouped.Where("turn_feedbacks.category = ?", filter.Category)
+	}
+	counted := r.db.Table("(?) AS patterns", grouped).
+		Select(`patterns.*, (
+			SELECT COUNT(*) FROM conversations
+			WHERE conversations.user_id = ? AND conversations.language = patterns.language AND conversations.started_at > patterns.last_seen_at
+		) AS conversations_since`, filter.UserID)
+
+	query := r.db.WithContext(ctx).Table("(?) AS mistakes", counted)
+	order := "mistakes.count DESC, mistakes.last_seen_at DESC, mistakes.language, mistakes.category, mistakes.pattern"
+	if filter.ResolvedAfter > 0 {
+		if filter.OnlyActive {
+			query = query.Where("mistakes.conversations_since < ?", filter.ResolvedAfter)
+		}
+		order = fmt.Sprintf("mistakes.conversations_since >= %d, %s", filter.ResolvedAfter, order)
+	}
+	var patterns []repository.MistakePattern
+	err := query.
+		Order(order).
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		Scan(&patterns).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list mistake patterns: %w", err)
+	}
+	return patterns, nil
+}
+
+// ListMistakeExamples returns up to perPattern of the latest corrections of each pattern of the user
+func (r *MistakeRepository) ListMistakeExamples(ctx context.Context, userID string, keys []repository.MistakeKey, perPattern int) ([]repository.MistakeExample, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	tuples := make([][]any, 0, len(keys))
+	for _, key := range keys {
+		tuples = append(tuples, []any{key.Language, string(key.Category), key.Pattern})
+	}
+	ranked := r.occurrences(ctx, userID).
+		Select(`turn_feedbacks.*, conversations.language, conversation_turns.conversation_id, conversation_turns.seq,
+			conversation_turns.started_at AS occurred_at,
+			ROW_NUMBER() OVER (
+				PARTITION BY conversations.language, turn_feedbacks.category, turn_feedbacks.pattern
+				ORDER BY conversation_turns.started_at DESC, turn_feedbacks.position
+			) AS example_rank`).
+		Where("(conversations.language, turn_feedbacks.category, turn_feedbacks.pattern) IN ?", tuples)
+
+	var examples []repository.MistakeExample
+	err := r.db.WithContext(ctx).
+		Table("(?) AS examples", ranked).
+		Where("examples.example_rank <= ?", perPattern).
+		Order("examples.occurred_at DESC, examples.position").
+		Scan(&examples).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list mistake examples: %w", err)
+	}
+	return examples, nil
+}
+
+// ListFeedback returns up to limit feedback items of every user ordered by ID, starting after afterID
+func (r *MistakeRepository) ListFeedback(ctx context.Context, afterID string, limit int) ([]models.TurnFeedback, error) {
+	query := r.db.WithContext(ctx).Order("turn_feedbacks_id").Limit(limit)
+	if afterID != "" {
+		query = query.Where("turn_feedbacks_id > ?", afterID)
+	}
+	var feedback []models.TurnFeedback
+	if err := query.Find(&feedback).Error; err != nil {
+		return nil, fmt.Errorf("failed to list turn feedback: %w", err)
+	}
+	return feedback, nil
+}
+
+// UpdateFeedbackPattern replaces the normalized pattern of a feedback item
+func (r *MistakeRepository) UpdateFeedbackPattern(ctx context.Context, feedbackID, pattern string) error {
+	err := r.db.WithContext(ctx).
+		Model(&models.TurnFeedback{}).
+		Where("turn_feedbacks_id = ?", feedbackID).
+		Update("pattern", pattern).Error
+	if err != nil {
+		return fmt.Errorf("failed to update turn feedback pattern: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"gorm.io/gorm"
+)
+
+// NotificationRepository handles in-app notification data operations
+type NotificationRepository struct {
+	db *gorm.DB
+}
+
+// NewNotificationRepository creates a new notification repository
+func NewNotificationRepository(db *gorm.DB) *NotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+func (r *NotificationRepository) CreateNotification(ctx context.Context, notification *models.Notification) error {
+	if err := r.db.WithContext(ctx).Create(notification).Error; err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+	return nil
+}
+
+// ListNotifications returns up to limit notifications of the user, newest first
+func (r *NotificationRepository) ListNotifications(ctx context.Context, userID string, unreadOnly bool, limit, offset int) ([]models.Notification, error) {
+	query := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC, notifications_id DESC").
+		Limit(limit).
+		Offset(offset)
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+	var notifications []models.Notification
+	if err := query.Find(&notifications).Error; err != nil {
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+	return notifications, nil
+}
+
+func (r *NotificationRepository) CountUnreadNotifications(ctx context.Context, userID string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&models.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+	return count, nil
+}
+
+// MarkNotificationsRead marks the user's unread notifications with the IDs as read at the time at,
+// or all of them when no IDs are given, and returns how many were marked
+func (r *NotificationRepository) MarkNotificationsRead(ctx context.Context, userID string, notificationIDs []string, at time.Time) (int64, error) {
+	query := r.db.WithContext(ctx).
+		Model(&models.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID)
+	if len(notificationIDs) > 0 {
+		query = query.Where("notifications_id IN ?", notificationIDs)
+	}
+	result := query.Update("read_at", at)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to mark notifications read: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,207 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// errAnalysisLeaseLost rolls back an analysis whose session was claimed again
+var errAnalysisLeaseLost = errors.New("analysis lease lost")
+
+// ProficiencyRepository handles the data operations on learners' language levels
+type ProficiencyRepository struct {
+	db *gorm.DB
+}
+
+// NewProficiencyRepository creates a new proficiency repository
+func NewProficiencyRepository(db *gorm.DB) *ProficiencyRepository {
+	return &ProficiencyRepository{db: db}
+}
+
+// ListLanguageProfiles returns the user's profiles ordered by language
+func (r *ProficiencyRepository) ListLanguageProfiles(ctx context.Context, userID string) ([]models.UserLanguageProfile, error) {
+	var profiles []models.UserLanguageProfile
+	result := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("language").Find(&profiles)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list language profiles: %w", result.Error)
+	}
+	return profiles, nil
+}
+
+func (r *ProficiencyRepository) GetLanguageProfile(ctx context.Context, userID, language string) (*models.UserLanguageProfile, error) {
+	var profile models.UserLanguageProfile
+	result := r.db.WithContext(ctx).Where("user_id = ? AND language = ?", userID, language).First(&profile)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrLanguageProfileNotFound
+		}
+		return nil, fmt.Errorf("failed to get language profile: %w", result.Error)
+	}
+	return &profile, nil
+}
+
+// SavePlacement creates or updates the profile of profile.UserID in profile.Language with a placement result,
+// recording a level change when the placement moves the level
+func (r *ProficiencyRepository) SavePlacement(ctx context.Context, profile *models.UserLanguageProfile) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var previous models.UserLanguageProfile
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ? AND language = ?", profile.UserID, profile.Language).
+			Limit(1).
+			Find(&previous).Error; err != nil {
+			return err
+		}
+		if err := tx.Omit("User").Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "language"}},
+			DoUpdates: clause.AssignmentColumns([]string{"level", "placement_score", "placed_at", "estimate", "updated_at"}),
+		}).Create(profile).Error; err != nil {
+			return err
+		}
+		if previous.Level == profile.Level {
+			return nil
+		}
+		change := &models.LevelChange{
+			UserID:         profile.UserID,
+			Language:       profile.Language,
+			Source:         models.LevelChangeSourcePlacement,
+			PreviousLevel:  previous.Level,
+			Level:          profile.Level,
+			Estimate:       profile.Estimate,
+			PlacementScore: profile.PlacementScore,
+		}
+		if profile.PlacedAt != nil {
+			change.CreatedAt = *profile.PlacedAt
+		}
+		return tx.Omit("User", "PracticeSession").Create(change).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save placement: %w", err)
+	}
+	return nil
+}
+
+// ListLevelChanges returns up to limit level changes of the user, newest first.
+// An empty language returns the changes in every language.
+func (r *ProficiencyRepository) ListLevelChanges(ctx context.Context, userID, language string, limit, offset int) ([]models.LevelChange, error) {
+	query := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC, level_changes_id DESC").
+		Limit(limit).
+		Offset(offset)
+	if language != "" {
+		query = query.Where("language = ?", language)
+	}
+	var changes []models.LevelChange
+	if err := query.Find(&changes).Error; err != nil {
+		return nil, fmt.Errorf("failed to list level changes: %w", err)
+	}
+	return changes, nil
+}
+
+// ClaimDueAnalyses returns up to limit practice sessions whose analysis is due at now,
+// postponing them to leaseUntil so other analyzers skip them meanwhile
+func (r *ProficiencyRepository) ClaimDueAnalyses(ctx context.Context, now, leaseUntil time.Time, limit int) ([]models.PracticeSession, error) {
+	var sessions []models.PracticeSession
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("analysis_due_at <= ?", now).
+			Order("analysis_due_at").
+			Limit(limit).
+			Find(&sessions).Error; err != nil {
+			return err
+		}
+		if len(sessions) == 0 {
+			return nil
+		}
+		ids := make([]string, 0, len(sessions))
+		for _, session := range sessions {
+			ids = append(ids, session.PracticeSessionsID)
+		}
+		return tx.Model(&models.PracticeSession{}).
+			Where("practice_sessions_id IN ?", ids).
+			UpdateColumn("analysis_due_at", leaseUntil).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim practice session analyses: %w", err)
+	}
+	return sessions, nil
+}
+
+// ListSessionTurns returns the turns of the practice session's conversation started during the session
+// with their feedback, in order
+func (r *ProficiencyRepository) ListSessionTurns(ctx context.Context, session *models.PracticeSession) ([]models.ConversationTurn, error) {
+	if session.ConversationID == nil {
+		return nil, nil
+	}
+	var turns []models.ConversationTurn
+	err := r.db.WithContext(ctx).
+		Preload("Feedback").
+		Where("conversation_id = ? AND started_at BETWEEN ? AND ?", *session.ConversationID, session.StartedAt, session.EndedAt).
+		Order("seq").
+		Find(&turns).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list session turns: %w", err)
+	}
+	return turns, nil
+}
+
+// CompleteAnalysis ends the analysis of a claimed session. When profile is set, it is saved with the
+// session's assessment and change, if any, is recorded. It does nothing when the session was claimed
+// again since leaseUntil, and returns ErrLanguageProfileChanged when the profile was saved since it was read.
+func (r *ProficiencyRepository) CompleteAnalysis(ctx context.Context, sessionID string, leaseUntil time.Time, profile *models.UserLanguageProfile, change *models.LevelChange) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.PracticeSession{}).
+			Where("practice_sessions_id = ? AND analysis_due_at = ?", sessionID, leaseUntil).
+			UpdateColumn("analysis_due_at", nil)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errAnalysisLeaseLost
+		}
+		if profile == nil {
+			return nil
+		}
+
+		var current models.UserLanguageProfile
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ? AND language = ?", profile.UserID, profile.Language).
+			Limit(1).
+			Find(&current).Error; err != nil {
+			return err
+		}
+		if current.UserLanguageProfilesID != profile.UserLanguageProfilesID || !current.UpdatedAt.Equal(profile.UpdatedAt) {
+			return repository.ErrLanguageProfileChanged
+		}
+		if profile.UserLanguageProfilesID == "" {
+			if err := tx.Omit("User").Create(profile).Error; err != nil {
+				return err
+			}
+		} else if err := tx.Model(profile).
+			Select("level", "estimate", "words_per_utterance", "lexical_diversity", "error_rate", "translation_rate", "assessed_sessions", "assessed_at", "updated_at").
+			Updates(profile).Error; err != nil {
+			return err
+		}
+		if change == nil {
+			return nil
+		}
+		change.PracticeSessionID = &sessionID
+		return tx.Omit("User", "PracticeSession").Create(change).Error
+	})
+	switch {
+	case errors.Is(err, errAnalysisLeaseLost):
+		return nil
+	case errors.Is(err, repository.ErrLanguageProfileChanged):
+		return err
+	case err != nil:
+		return fmt.Errorf("failed to complete practice session analysis: %w", err)
+	}
+	return nil
+}
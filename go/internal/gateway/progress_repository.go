@@ -0,0 +1,42 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"gorm.io/gorm"
+)
+
+// ProgressRepository handles practice session data operations
+type ProgressRepository struct {
+	db *gorm.DB
+}
+
+// NewProgressRepository creates a new progress repository
+func NewProgressRepository(db *gorm.DB) *ProgressRepository {
+	return &ProgressRepository{db: db}
+}
+
+func (r *ProgressRepository) CreatePracticeSession(ctx context.Context, session *models.PracticeSession) error {
+	if err := r.db.WithContext(ctx).Omit("Conversation").Create(session).Error; err != nil {
+		return fmt.Errorf("failed to create practice session: %w", err)
+	}
+	return nil
+}
+
+// ListPracticeSessions returns the practice sessions of the user started at or after since, oldest first.
+// A zero since returns every session.
+func (r *ProgressRepository) ListPracticeSessions(ctx context.Context, userID string, since time.Time) ([]models.PracticeSession, error) {
+	query := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("started_at")
+	if !since.IsZero() {
+		query = query.Where("started_at >= ?", since)
+	}
+	var sessions []models.PracticeSession
+	err := query.Find(&sessions).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list practice sessions: %w", err)
+	}
+	return sessions, nil
+}
@@ -0,0 +1,194 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PromoRepository handles promo code and plan grant data operations
+type PromoRepository struct {
+	db *gorm.DB
+}
+
+// NewPromoRepository creates a new promo repository
+func NewPromoRepository(db *gorm.DB) *PromoRepository {
+	return &PromoRepository{db: db}
+}
+
+// CreatePromoCodes inserts codes in a single transaction and records the mint in the audit log
+func (r *PromoRepository) CreatePromoCodes(ctx context.Context, actorID string, codes []models.PromoCode, reason string) error {
+	if len(codes) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&codes).Error; err != nil {
+			return fmt.Errorf("failed to create promo codes: %w", err)
+		}
+		return writeAuditLog(tx, actorID, "", models.AuditActionPromoCodesMinted, map[string]any{
+			"count":         len(codes),
+			"plan":          codes[0].Plan,
+			"duration_days": codes[0].DurationDays,
+			"campaign":      codes[0].Campaign,
+			"reason":        reason,
+		})
+	})
+}
+
+// ListPromoCodes returns a page of promo codes, newest first
+func (r *PromoRepository) ListPromoCodes(ctx context.Context, filter repository.PromoCodeFilter) ([]models.PromoCode, error) {
+	query := r.db.WithContext(ctx).Model(&models.PromoCode{})
+	if filter.Campaign != "" {
+		query = query.Where("campaign = ?", filter.Campaign)
+	}
+
+	var codes []models.PromoCode
+	result := query.Order("created_at DESC, promo_codes_id").Limit(filter.Limit).Offset(filter.Offset).Find(&codes)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list promo codes: %w", result.Error)
+	}
+	return codes, nil
+}
+
+// GetPromoCode retrieves a promo code by its code
+func (r *PromoRepository) GetPromoCode(ctx context.Context, code string) (*models.PromoCode, error) {
+	var promo models.PromoCode
+	result := r.db.WithContext(ctx).Where("code = ?", code).First(&promo)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrPromoCodeNotFound
+		}
+		return nil, fmt.Errorf("failed to get promo code: %w", result.Error)
+	}
+	return &promo, nil
+}
+
+// RedeemCode validates the code and creates a grant starting at now for the user.
+// The code row is locked so the redemption limit holds under concurrent redemptions.
+func (r *PromoRepository) RedeemCode(ctx context.Context, userID, code string, now time.Time) (*models.PlanGrant, error) {
+	var grant models.PlanGrant
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var promo models.PromoCode
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("code = ?", code).First(&promo)
+		if result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				return repository.ErrPromoCodeNotFound
+			}
+			return fmt.Errorf("failed to get promo code: %w", result.Error)
+		}
+		if promo.IsExpired(now) {
+			return repository.ErrPromoCodeExpired
+		}
+		if promo.IsExhausted() {
+			return repository.ErrPromoCodeExhausted
+		}
+
+		var redeemed int64
+		if err := tx.Model(&models.PlanGrant{}).Where("user_id = ? AND promo_code_id = ?", userID, promo.PromoCodesID).Count(&redeemed).Error; err != nil {
+			return fmt.Errorf("failed to check redemption: %w", err)
+		}
+		if redeemed > 0 {
+			return repository.ErrPromoCodeAlreadyRedeemed
+		}
+
+		var user models.User
+		if err := lockUser(tx, userID, &user); err != nil {
+			return err
+		}
+
+		grant = models.PlanGrant{
+			UserID:       userID,
+			PromoCodeID:  promo.PromoCodesID,
+			Plan:         promo.Plan,
+			PreviousPlan: user.Plan,
+			StartsAt:     now,
+			EndsAt:       now.AddDate(0, 0, promo.DurationDays),
+		}
+		if err := tx.Create(&grant).Error; err != nil {
+			return fmt.Errorf("failed to create plan grant: %w", err)
+		}
+		if err := tx.Model(&promo).Update("redemption_count", gorm.Expr("redemption_count + 1")).Error; err != nil {
+			return fmt.Errorf("failed to update promo code: %w", err)
+		}
+		return writeAuditLog(tx, userID, userID, models.AuditActionPromoCodeRedeemed, map[string]any{
+			"code":    promo.Code,
+			"plan":    promo.Plan,
+			"ends_at": grant.EndsAt,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &grant, nil
+}
+
+// RefreshPlan recomputes the effective plan of a user with decide while holding the user's row lock,
+// so it cannot interleave with support plan changes or promo code redemptions
+func (r *PromoRepository) RefreshPlan(ctx context.Context, userID string, revertedAt time.Time, decide repository.PlanDecider) (models.UserPlan, repository.PlanChange, error) {
+	var previous models.UserPlan
+	var change repository.PlanChange
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var user models.User
+		if err := lockUser(tx, userID, &user); err != nil {
+			return err
+		}
+		previous = user.Plan
+
+		var grants []models.PlanGrant
+		result := tx.Where("user_id = ? AND reverted_at IS NULL", userID).Order("starts_at, plan_grants_id").Find(&grants)
+		if result.Error != nil {
+			return fmt.Errorf("failed to list plan grants: %w", result.Error)
+		}
+		subscription, err := currentSubscription(tx, userID)
+		switch {
+		case errors.Is(err, repository.ErrSubscriptionNotFound):
+			subscription = nil
+		case err != nil:
+			return err
+		}
+
+		change = decide(&user, grants, subscription)
+		if change.Plan != user.Plan {
+			if err := tx.Model(&user).Update("plan", change.Plan).Error; err != nil {
+				return fmt.Errorf("failed to update plan: %w", err)
+			}
+			if err := writeAuditLog(tx, "", userID, models.AuditActionPlanChanged, map[string]any{
+				"from":   previous,
+				"to":     change.Plan,
+				"reason": change.Reason,
+			}); err != nil {
+				return err
+			}
+		}
+		if len(change.EndedGrantIDs) > 0 {
+			result := tx.Model(&models.PlanGrant{}).Where("plan_grants_id IN ?", change.EndedGrantIDs).Update("reverted_at", revertedAt)
+			if result.Error != nil {
+				return fmt.Errorf("failed to mark plan grants reverted: %w", result.Error)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return "", repository.PlanChange{}, err
+	}
+	return previous, change, nil
+}
+
+// ListUsersWithEndedGrants returns the users having grants that ended before now but were not reverted yet
+func (r *PromoRepository) ListUsersWithEndedGrants(ctx context.Context, now time.Time) ([]string, error) {
+	var userIDs []string
+	result := r.db.WithContext(ctx).Model(&models.PlanGrant{}).
+		Where("reverted_at IS NULL AND ends_at <= ?", now).
+		Distinct().
+		Pluck("user_id", &userIDs)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list ended plan grants: %w", result.Error)
+	}
+	return userIDs, nil
+}
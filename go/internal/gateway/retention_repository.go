@@ -0,0 +1,131 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"gorm.io/gorm"
+)
+
+// RetentionRepository handles finding and deleting data past its retention
+type RetentionRepository struct {
+	db *gorm.DB
+}
+
+// NewRetentionRepository creates a new retention repository
+func NewRetentionRepository(db *gorm.DB) *RetentionRepository {
+	return &RetentionRepository{db: db}
+}
+
+// expired scopes a query joined with conversations to rows whose ageColumn is older than
+// the retention of the conversation's owner, given by the plan and settingsColumn of user_settings
+func expired(query *gorm.DB, criteria repository.RetentionCriteria, ageColumn, settingsColumn string) *gorm.DB {
+	values := make([]string, 0, len(criteria.PlanDays))
+	args := make([]any, 0, 2*len(criteria.PlanDays))
+	for plan, days := range criteria.PlanDays {
+		values = append(values, "(?::varchar, ?::int)")
+		args = append(args, string(plan), days)
+	}
+	if len(values) == 0 {
+		values = append(values, "(NULL::varchar, NULL::int)")
+	}
+	return query.
+		Joins("JOIN users ON users.users_id = conversations.user_id").
+		Joins("LEFT JOIN user_settings ON user_settings.user_id = conversations.user_id").
+		Joins("LEFT JOIN (VALUES "+strings.Join(values, ", ")+") AS retention(plan, days) ON retention.plan = users.plan", args...).
+		Where(ageColumn+" < ?::timestamptz - make_interval(days => LEAST(NULLIF(COALESCE(retention.days, ?), 0), NULLIF(user_settings."+settingsColumn+", 0)))",
+			criteria.Now, criteria.DefaultDays)
+}
+
+func (r *RetentionRepository) expiredAudioTurns(ctx context.Context, criteria repository.RetentionCriteria) *gorm.DB {
+	query := r.db.WithContext(ctx).
+		Model(&models.ConversationTurn{}).
+		Joins("JOIN conversations ON conversations.conversations_id = conversation_turns.conversation_id").
+		Where("(conversation_turns.user_audio_key <> '' OR conversation_turns.ai_audio_key <> '')")
+	return expired(query, criteria, "conversation_turns.started_at", "audio_retention_days")
+}
+
+// ListExpiredAudioTurns returns up to limit turns whose audio is past the audio retention, oldest first
+func (r *RetentionRepository) ListExpiredAudioTurns(ctx context.Context, criteria repository.RetentionCriteria, limit int) ([]models.ConversationTurn, error) {
+	var turns []models.ConversationTurn
+	if err := r.expiredAudioTurns(ctx, criteria).
+		Select("conversation_turns.*").
+		Order("conversation_turns.started_at").
+		Limit(limit).
+		Find(&turns).Error; err != nil {
+		return nil, fmt.Errorf("failed to list expired turn audio: %w", err)
+	}
+	return turns, nil
+}
+
+func (r *RetentionRepository) CountExpiredAudioTurns(ctx context.Context, criteria repository.RetentionCriteria) (int64, error) {
+	var count int64
+	if err := r.expiredAudioTurns(ctx, criteria).Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count expired turn audio: %w", err)
+	}
+	return count, nil
+}
+
+// ClearTurnAudio forgets the audio of turns once it has been deleted from the blob store
+func (r *RetentionRepository) ClearTurnAudio(ctx context.Context, turnIDs []string) error {
+	if len(turnIDs) == 0 {
+		return nil
+	}
+	if err := r.db.WithContext(ctx).
+		Model(&models.ConversationTurn{}).
+		Where("conversation_turns_id IN ?", turnIDs).
+		Updates(map[string]any{"user_audio_key": "", "ai_audio_key": ""}).Error; err != nil {
+		return fmt.Errorf("failed to clear turn audio: %w", err)
+	}
+	return nil
+}
+
+// conversationActivity is when a conversation was last active: its end, or its last turn when it was
+// resumed since or abandoned without an end time, e.g. because the server stopped during the session
+const conversationActivity = `GREATEST(conversations.started_at, conversations.ended_at, (
+	SELECT MAX(COALESCE(conversation_turns.ended_at, conversation_turns.started_at))
+	FROM conversation_turns
+	WHERE conversation_turns.conversation_id = conversations.conversations_id))`
+
+func (r *RetentionRepository) expiredConversations(ctx context.Context, criteria repository.RetentionCriteria) *gorm.DB {
+	query := r.db.WithContext(ctx).Model(&models.Conversation{})
+	return expired(query, criteria, conversationActivity, "transcript_retention_days")
+}
+
+// ListExpiredConversations returns up to limit conversations last active before the transcript retention,
+// least recently active first. A conversation in progress saves its turns, so it never counts as expired.
+func (r *RetentionRepository) ListExpiredConversations(ctx context.Context, criteria repository.RetentionCriteria, limit int) ([]models.Conversation, error) {
+	var conversations []models.Conversation
+	if err := r.expiredConversations(ctx, criteria).
+		Select("conversations.*").
+		Order(conversationActivity).
+		Limit(limit).
+		Find(&conversations).Error; err != nil {
+		return nil, fmt.Errorf("failed to list expired conversations: %w", err)
+	}
+	return conversations, nil
+}
+
+func (r *RetentionRepository) CountExpiredConversations(ctx context.Context, criteria repository.RetentionCriteria) (int64, error) {
+	var count int64
+	if err := r.expiredConversations(ctx, criteria).Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count expired conversations: %w", err)
+	}
+	return count, nil
+}
+
+// DeleteConversations deletes conversations; their turns and summaries are removed by the cascade
+func (r *RetentionRepository) DeleteConversations(ctx context.Context, conversationIDs []string) error {
+	if len(conversationIDs) == 0 {
+		return nil
+	}
+	if err := r.db.WithContext(ctx).
+		Where("conversations_id IN ?", conversationIDs).
+		Delete(&models.Conversation{}).Error; err != nil {
+		return fmt.Errorf("failed to delete expired conversations: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"gorm.io/gorm"
+)
+
+// ScenarioRepository handles role-play scenario data operations
+type ScenarioRepository struct {
+	db *gorm.DB
+}
+
+// NewScenarioRepository creates a new scenario repository
+func NewScenarioRepository(db *gorm.DB) *ScenarioRepository {
+	return &ScenarioRepository{db: db}
+}
+
+// ListScenarios returns the active scenarios offered in the language in catalog order,
+// each with only its localization in that language. An empty difficulty lists every difficulty.
+func (r *ScenarioRepository) ListScenarios(ctx context.Context, language string, difficulty models.ScenarioDifficulty) ([]models.Scenario, error) {
+	query := r.db.WithContext(ctx).
+		Preload("Localizations", "language = ?", language).
+		Where("active AND EXISTS (SELECT 1 FROM scenario_localizations WHERE scenario_localizations.scenario_id = scenarios.scenarios_id AND scenario_localizations.language = ?)", language).
+		Order("position, slug")
+	if difficulty != "" {
+		query = query.Where("difficulty = ?", difficulty)
+	}
+	var scenarios []models.Scenario
+	if err := query.Find(&scenarios).Error; err != nil {
+		return nil, fmt.Errorf("failed to list scenarios: %w", err)
+	}
+	return scenarios, nil
+}
+
+// GetScenario returns an active scenario with only its localization in the language
+func (r *ScenarioRepository) GetScenario(ctx context.Context, scenarioID, language string) (*models.Scenario, error) {
+	var scenario models.Scenario
+	result := r.db.WithContext(ctx).
+		Preload("Localizations", "language = ?", language).
+		Where("scenarios_id = ? AND active", scenarioID).
+		First(&scenario)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrScenarioNotFound
+		}
+		return nil, fmt.Errorf("failed to get scenario: %w", result.Error)
+	}
+	if len(scenario.Localizations) == 0 {
+		return nil, repository.ErrScenarioNotFound
+	}
+	return &scenario, nil
+}
+
+// ImportScenarios creates or updates the scenarios by slug, replacing their localizations,
+// and retires the active scenarios missing from the list
+func (r *ScenarioRepository) ImportScenarios(ctx context.Context, scenarios []*models.Scenario) (repository.ScenarioImport, error) {
+	var counts repository.ScenarioImport
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		slugs := make([]string, 0, len(scenarios))
+		for _, scenario := range scenarios {
+			slugs = append(slugs, scenario.Slug)
+
+			var existing models.Scenario
+			result := tx.Where("slug = ?", scenario.Slug).Limit(1).Find(&existing)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				if err := tx.Create(scenario).Error; err != nil {
+					return err
+				}
+				counts.Created++
+				continue
+			}
+
+			scenario.ScenariosID = existing.ScenariosID
+			if err := tx.Model(&existing).Updates(map[string]any{
+				"difficulty": scenario.Difficulty,
+				"position":   scenario.Position,
+				"active":     scenario.Active,
+			}).Error; err != nil {
+				return err
+			}
+			// Completions keep pointing at the scenario, so only its texts are replaced
+			if err := tx.Where("scenario_id = ?", existing.ScenariosID).Delete(&models.ScenarioLocalization{}).Error; err != nil {
+				return err
+			}
+			for i := range scenario.Localizations {
+				scenario.Localizations[i].ScenarioID = existing.ScenariosID
+			}
+			if len(scenario.Localizations) > 0 {
+				if err := tx.Create(&scenario.Localizations).Error; err != nil {
+					return err
+				}
+			}
+			counts.Updated++
+		}
+
+		retire := tx.Model(&models.Scenario{}).Where("active")
+		if len(slugs) > 0 {
+			retire = retire.Where("slug NOT IN ?", slugs)
+		}
+		result := retire.Update("active", false)
+		if result.Error != nil {
+			return result.Error
+		}
+		counts.Retired = int(result.RowsAffected)
+		return nil
+	})
+	if err != nil {
+		return repository.ScenarioImport{}, fmt.Errorf("failed to import scenarios: %w", err)
+	}
+	return counts, nil
+}
+
+func (r *ScenarioRepository) CreateScenarioCompletion(ctx context.Context, completion *models.ScenarioCompletion) error {
+	if err := r.db.WithContext(ctx).Omit("Scenario", "Conversation").Create(completion).Error; err != nil {
+		return fmt.Errorf("failed to create scenario completion: %w", err)
+	}
+	return nil
+}
+
+// ListScenarioStats returns the user's statistics of every scenario they played
+func (r *ScenarioRepository) ListScenarioStats(ctx context.Context, userID string) ([]repository.ScenarioStats, error) {
+	var stats []repository.ScenarioStats
+	err := r.db.WithContext(ctx).
+		Model(&models.ScenarioCompletion{}).
+		Select(`scenario_id,
+			COUNT(*) AS sessions,
+			COUNT(*) FILTER (WHERE goals_total > 0 AND goals_achieved >= goals_total) AS completions,
+			MAX(goals_achieved) AS best_goals_achieved,
+			MAX(completed_at) AS last_played_at`).
+		Where("user_id = ?", userID).
+		Group("scenario_id").
+		Scan(&stats).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scenario stats: %w", err)
+	}
+	return stats, nil
+}
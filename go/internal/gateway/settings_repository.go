@@ -0,0 +1,46 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SettingsRepository handles user settings data operations
+type SettingsRepository struct {
+	db *gorm.DB
+}
+
+// NewSettingsRepository creates a new settings repository
+func NewSettingsRepository(db *gorm.DB) *SettingsRepository {
+	return &SettingsRepository{db: db}
+}
+
+// GetSettings returns the settings of a user, or the defaults if the user never saved any
+func (r *SettingsRepository) GetSettings(ctx context.Context, userID string) (*models.UserSettings, error) {
+	var settings models.UserSettings
+	result := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&settings)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return models.DefaultUserSettings(userID), nil
+		}
+		return nil, fmt.Errorf("failed to get user settings: %w", result.Error)
+	}
+	return &settings, nil
+}
+
+// SaveSettings creates or replaces the settings of settings.UserID
+func (r *SettingsRepository) SaveSettings(ctx context.Context, settings *models.UserSettings) error {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"audio_opt_out", "audio_retention_days", "transcript_retention_days", "privacy_mode", "time_zone", "updated_at"}),
+	}).Create(settings)
+	if result.Error != nil {
+		return fmt.Errorf("failed to save user settings: %w", result.Error)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SubscriptionRepository handles subscription and invoice data operations
+type SubscriptionRepository struct {
+	db *gorm.DB
+}
+
+// NewSubscriptionRepository creates a new subscription repository
+func NewSubscriptionRepository(db *gorm.DB) *SubscriptionRepository {
+	return &SubscriptionRepository{db: db}
+}
+
+// GetSubscriptionByProviderID retrieves a subscription by the provider's subscription ID
+func (r *SubscriptionRepository) GetSubscriptionByProviderID(ctx context.Context, providerSubscriptionID string) (*models.Subscription, error) {
+	var subscription models.Subscription
+	result := r.db.WithContext(ctx).Where("provider_subscription_id = ?", providerSubscriptionID).First(&subscription)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrSubscriptionNotFound
+		}
+		return nil, fmt.Errorf("failed to get subscription: %w", result.Error)
+	}
+	return &subscription, nil
+}
+
+// GetCurrentSubscription retrieves the subscription that determines a user's plan
+func (r *SubscriptionRepository) GetCurrentSubscription(ctx context.Context, userID string) (*models.Subscription, error) {
+	return currentSubscription(r.db.WithContext(ctx), userID)
+}
+
+// currentSubscription prefers subscriptions that are not canceled, then the most recently updated
+func currentSubscription(db *gorm.DB, userID string) (*models.Subscription, error) {
+	var subscription models.Subscription
+	result := db.
+		Where("user_id = ?", userID).
+		Order(clause.Expr{SQL: "CASE WHEN status = ? THEN 1 ELSE 0 END, updated_at DESC", Vars: []any{models.SubscriptionCanceled}}).
+		First(&subscription)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrSubscriptionNotFound
+		}
+		return nil, fmt.Errorf("failed to get subscription: %w", result.Error)
+	}
+	return &subscription, nil
+}
+
+// SaveSubscription creates or updates a subscription
+func (r *SubscriptionRepository) SaveSubscription(ctx context.Context, subscription *models.Subscription) error {
+	db := r.db.WithContext(ctx)
+	var result *gorm.DB
+	if subscription.SubscriptionsID == "" {
+		result = db.Create(subscription)
+	} else {
+		result = db.Save(subscription)
+	}
+	if result.Error != nil {
+		return fmt.Errorf("failed to save subscription: %w", result.Error)
+	}
+	return nil
+}
+
+// SaveInvoice creates an invoice or updates the existing one with the same provider ID
+func (r *SubscriptionRepository) SaveInvoice(ctx context.Context, invoice *models.Invoice) error {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "provider_invoice_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"amount_due", "currency", "status", "period_start", "period_end", "paid_at", "updated_at",
+		}),
+	}).Create(invoice)
+	if result.Error != nil {
+		return fmt.Errorf("failed to save invoice: %w", result.Error)
+	}
+	return nil
+}
+
+// HasProcessedEvent reports whether a webhook event has already been applied
+func (r *SubscriptionRepository) HasProcessedEvent(ctx context.Context, providerEventID string) (bool, error) {
+	var count int64
+	result := r.db.WithContext(ctx).Model(&models.BillingEvent{}).Where("provider_event_id = ?", providerEventID).Count(&count)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to check billing event: %w", result.Error)
+	}
+	return count > 0, nil
+}
+
+// RecordEvent marks a webhook event as applied. Recording the same event twice is not an error.
+func (r *SubscriptionRepository) RecordEvent(ctx context.Context, event *models.BillingEvent) error {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(event)
+	if result.Error != nil {
+		return fmt.Errorf("failed to record billing event: %w", result.Error)
+	}
+	return nil
+}
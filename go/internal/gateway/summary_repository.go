@@ -0,0 +1,158 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SummaryRepository handles conversation summary data operations
+type SummaryRepository struct {
+	db *gorm.DB
+}
+
+// NewSummaryRepository creates a new summary repository
+func NewSummaryRepository(db *gorm.DB) *SummaryRepository {
+	return &SummaryRepository{db: db}
+}
+
+// RequestSummary schedules a summary of the conversation at the time at.
+// Requesting again replaces the earlier result and resets its attempts.
+func (r *SummaryRepository) RequestSummary(ctx context.Context, conversationID string, at time.Time) error {
+	summary := &models.ConversationSummary{
+		ConversationID: conversationID,
+		Status:         models.SummaryStatusPending,
+		RequestedAt:    at,
+		NextAttemptAt:  at,
+		Content:        "{}",
+	}
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "conversation_id"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"status":          models.SummaryStatusPending,
+			"attempts":        0,
+			"requested_at":    at,
+			"next_attempt_at": at,
+			"last_error":      "",
+			"updated_at":      at,
+		}),
+	}).Create(summary)
+	if result.Error != nil {
+		return fmt.Errorf("failed to request conversation summary: %w", result.Error)
+	}
+	return nil
+}
+
+// GetSummary returns the summary of a conversation owned by the user
+func (r *SummaryRepository) GetSummary(ctx context.Context, userID, conversationID string) (*models.ConversationSummary, error) {
+	var summary models.ConversationSummary
+	result := r.db.WithContext(ctx).
+		Joins("JOIN conversations ON conversations.conversations_id = conversation_summaries.conversation_id").
+		Where("conversation_summaries.conversation_id = ? AND conversations.user_id = ?", conversationID, userID).
+		First(&summary)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrSummaryNotFound
+		}
+		return nil, fmt.Errorf("failed to get conversation summary: %w", result.Error)
+	}
+	return &summary, nil
+}
+
+// ClaimDueSummaries returns up to limit pending summaries due at now with their conversation,
+// counting an attempt and postponing them to leaseUntil so other workers skip them meanwhile
+func (r *SummaryRepository) ClaimDueSummaries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]models.ConversationSummary, error) {
+	var ids []string
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.ConversationSummary{}).
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", models.SummaryStatusPending, now).
+			Order("next_attempt_at").
+			Limit(limit).
+			Pluck("conversation_summaries_id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		return tx.Model(&models.ConversationSummary{}).
+			Where("conversation_summaries_id IN ?", ids).
+			Updates(map[string]any{
+				"attempts":        gorm.Expr("attempts + 1"),
+				"next_attempt_at": leaseUntil,
+			}).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim conversation summaries: %w", err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var summaries []models.ConversationSummary
+	if err := r.db.WithContext(ctx).
+		Preload("Conversation").
+		Where("conversation_summaries_id IN ?", ids).
+		Order("next_attempt_at").
+		Find(&summaries).Error; err != nil {
+		return nil, fmt.Errorf("failed to load claimed conversation summaries: %w", err)
+	}
+	return summaries, nil
+}
+
+// CompleteSummary stores the result of a claimed summary.
+// It does nothing when the summary was requested again since it was claimed.
+func (r *SummaryRepository) CompleteSummary(ctx context.Context, summary *models.ConversationSummary, content string) error {
+	result := r.db.WithContext(ctx).
+		Model(&models.ConversationSummary{}).
+		Where("conversation_summaries_id = ? AND requested_at = ?", summary.ConversationSummariesID, summary.RequestedAt).
+		Updates(map[string]any{
+			"status":     models.SummaryStatusReady,
+			"content":    content,
+			"last_error": "",
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to complete conversation summary: %w", result.Error)
+	}
+	return nil
+}
+
+// FailSummary records a failed attempt of a claimed summary. A nil retryAt gives up on it.
+func (r *SummaryRepository) FailSummary(ctx context.Context, summary *models.ConversationSummary, lastError string, retryAt *time.Time) error {
+	updates := map[string]any{"last_error": lastError}
+	if retryAt != nil {
+		updates["next_attempt_at"] = *retryAt
+	} else {
+		updates["status"] = models.SummaryStatusFailed
+	}
+	result := r.db.WithContext(ctx).
+		Model(&models.ConversationSummary{}).
+		Where("conversation_summaries_id = ? AND requested_at = ?", summary.ConversationSummariesID, summary.RequestedAt).
+		Updates(updates)
+	if result.Error != nil {
+		return fmt.Errorf("failed to record conversation summary failure: %w", result.Error)
+	}
+	return nil
+}
+
+// RetryFailedSummaries schedules every failed summary again at the time at and returns how many
+func (r *SummaryRepository) RetryFailedSummaries(ctx context.Context, at time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Model(&models.ConversationSummary{}).
+		Where("status = ?", models.SummaryStatusFailed).
+		Updates(map[string]any{
+			"status":          models.SummaryStatusPending,
+			"attempts":        0,
+			"next_attempt_at": at,
+		})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to retry conversation summaries: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
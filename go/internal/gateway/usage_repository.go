@@ -0,0 +1,67 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UsageRepository handles usage metering data operations
+type UsageRepository struct {
+	db *gorm.DB
+}
+
+// NewUsageRepository creates a new usage repository
+func NewUsageRepository(db *gorm.DB) *UsageRepository {
+	return &UsageRepository{db: db}
+}
+
+// AddUsage increments the user's counters for day in a single upsert, so concurrent sessions do not lose updates
+func (r *UsageRepository) AddUsage(ctx context.Context, userID string, day time.Time, usage repository.UsageTotals) error {
+	row := &models.DailyUsage{
+		UserID:      userID,
+		UsageDate:   day,
+		UserAudioMs: usage.UserAudio.Milliseconds(),
+		AIAudioMs:   usage.AIAudio.Milliseconds(),
+		Sessions:    usage.Sessions,
+	}
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}, {Name: "usage_date"}},
+		DoUpdates: clause.Set{
+			{Column: clause.Column{Name: "user_audio_ms"}, Value: gorm.Expr("daily_usages.user_audio_ms + EXCLUDED.user_audio_ms")},
+			{Column: clause.Column{Name: "ai_audio_ms"}, Value: gorm.Expr("daily_usages.ai_audio_ms + EXCLUDED.ai_audio_ms")},
+			{Column: clause.Column{Name: "sessions"}, Value: gorm.Expr("daily_usages.sessions + EXCLUDED.sessions")},
+			{Column: clause.Column{Name: "updated_at"}, Value: gorm.Expr("EXCLUDED.updated_at")},
+		},
+	}).Create(row)
+	if result.Error != nil {
+		return fmt.Errorf("failed to add usage: %w", result.Error)
+	}
+	return nil
+}
+
+// GetUsageTotals sums the usage of the days in [from, to)
+func (r *UsageRepository) GetUsageTotals(ctx context.Context, userID string, from, to time.Time) (repository.UsageTotals, error) {
+	var totals struct {
+		UserAudioMs int64
+		AIAudioMs   int64 `gorm:"column:ai_audio_ms"`
+		Sessions    int64
+	}
+	result := r.db.WithContext(ctx).Model(&models.DailyUsage{}).
+		Select("COALESCE(SUM(user_audio_ms), 0) AS user_audio_ms, COALESCE(SUM(ai_audio_ms), 0) AS ai_audio_ms, COALESCE(SUM(sessions), 0) AS sessions").
+		Where("user_id = ? AND usage_date >= ? AND usage_date < ?", userID, from.Format(time.DateOnly), to.Format(time.DateOnly)).
+		Scan(&totals)
+	if result.Error != nil {
+		return repository.UsageTotals{}, fmt.Errorf("failed to get usage totals: %w", result.Error)
+	}
+	return repository.UsageTotals{
+		UserAudio: time.Duration(totals.UserAudioMs) * time.Millisecond,
+		AIAudio:   time.Duration(totals.AIAudioMs) * time.Millisecond,
+		Sessions:  totals.Sessions,
+	}, nil
+}
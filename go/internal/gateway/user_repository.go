@@ -9,6 +9,7 @@ import (
 	"github.com/hiroky1983/talk/go/internal/repository"
 	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // UserRepository handles user data operations
@@ -127,3 +128,42 @@ func (r *UserRepository) DeleteExpiredRefreshTokens(ctx context.Context) error {
 	}
 	return nil
 }
+
+// ResetPassword redeems an unused, unexpired reset token, sets the password and revokes every session of its user
+func (r *UserRepository) ResetPassword(ctx context.Context, tokenHash, password string) error {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var token models.PasswordResetToken
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("token_hash = ? AND used_at IS NULL AND expires_at > NOW()", tokenHash).
+			First(&token)
+		if result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				return repository.ErrInvalidResetToken
+			}
+			return fmt.Errorf("failed to get reset token: %w", result.Error)
+		}
+
+		var user models.User
+		if err := lockUser(tx, token.UserID, &user); err != nil {
+			return err
+		}
+		if err := tx.Model(&user).Update("password_hash", string(hashedPassword)).Error; err != nil {
+			return fmt.Errorf("failed to update password: %w", err)
+		}
+		if err := tx.Model(&token).Update("used_at", gorm.Expr("NOW()")).Error; err != nil {
+			return fmt.Errorf("failed to mark reset token used: %w", err)
+		}
+		revoked := tx.Where("user_id = ?", token.UserID).Delete(&models.RefreshToken{})
+		if revoked.Error != nil {
+			return fmt.Errorf("failed to revoke sessions: %w", revoked.Error)
+		}
+		return writeAuditLog(tx, token.UserID, token.UserID, models.AuditActionPasswordResetCompleted, map[string]any{
+			"revoked_sessions": revoked.RowsAffected,
+		})
+	})
+}
@@ -0,0 +1,121 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"gorm.io/gorm"
+)
+
+// VocabularyRepository handles vocabulary card data operations
+type VocabularyRepository struct {
+	db *gorm.DB
+}
+
+// NewVocabularyRepository creates a new vocabulary repository
+func NewVocabularyRepository(db *gorm.DB) *VocabularyRepository {
+	return &VocabularyRepository{db: db}
+}
+
+// ListCards returns up to limit cards of the user after skipping offset, newest first.
+// A limit of zero returns every card.
+func (r *VocabularyRepository) ListCards(ctx context.Context, userID string, limit, offset int) ([]models.VocabularyCard, error) {
+	query := r.db.WithContext(ctx).
+		Preload("SourceTurn").
+		Where("user_id = ?", userID).
+		Order("created_at DESC, vocabulary_cards_id DESC").
+		Offset(offset)
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	var cards []models.VocabularyCard
+	if err := query.Find(&cards).Error; err != nil {
+		return nil, fmt.Errorf("failed to list vocabulary cards: %w", err)
+	}
+	return cards, nil
+}
+
+// ListDueCards returns up to limit cards of the user due at now, most overdue first
+func (r *VocabularyRepository) ListDueCards(ctx context.Context, userID string, now time.Time, limit int) ([]models.VocabularyCard, error) {
+	var cards []models.VocabularyCard
+	err := r.db.WithContext(ctx).
+		Preload("SourceTurn").
+		Where("user_id = ? AND due_at <= ?", userID, now).
+		Order("due_at, vocabulary_cards_id").
+		Limit(limit).
+		Find(&cards).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due vocabulary cards: %w", err)
+	}
+	return cards, nil
+}
+
+func (r *VocabularyRepository) CountDueCards(ctx context.Context, userID string, now time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&models.VocabularyCard{}).
+		Where("user_id = ? AND due_at <= ?", userID, now).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to count due vocabulary cards: %w", err)
+	}
+	return count, nil
+}
+
+func (r *VocabularyRepository) GetCard(ctx context.Context, userID, cardID string) (*models.VocabularyCard, error) {
+	var card models.VocabularyCard
+	result := r.db.WithContext(ctx).
+		Preload("SourceTurn").
+		Where("vocabulary_cards_id = ? AND user_id = ?", cardID, userID).
+		First(&card)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, repository.ErrCardNotFound
+		}
+		return nil, fmt.Errorf("failed to get vocabulary card: %w", result.Error)
+	}
+	return &card, nil
+}
+
+func (r *VocabularyRepository) CreateCards(ctx context.Context, cards []models.VocabularyCard) error {
+	if len(cards) == 0 {
+		return nil
+	}
+	if err := r.db.WithContext(ctx).Omit("SourceTurn").CreateInBatches(cards, 500).Error; err != nil {
+		return fmt.Errorf("failed to create vocabulary cards: %w", err)
+	}
+	return nil
+}
+
+// UpdateCard saves the content and schedule of a card of the user
+func (r *VocabularyRepository) UpdateCard(ctx context.Context, card *models.VocabularyCard) error {
+	result := r.db.WithContext(ctx).
+		Model(card).
+		Where("user_id = ?", card.UserID).
+		Select("term", "reading", "translation", "example", "ease_factor", "interval_days", "repetitions", "due_at", "last_reviewed_at", "updated_at").
+		Updates(card)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update vocabulary card: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrCardNotFound
+	}
+	return nil
+}
+
+func (r *VocabularyRepository) DeleteCard(ctx context.Context, userID, cardID string) error {
+	result := r.db.WithContext(ctx).
+		Where("vocabulary_cards_id = ? AND user_id = ?", cardID, userID).
+		Delete(&models.VocabularyCard{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete vocabulary card: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrCardNotFound
+	}
+	return nil
+}
@@ -0,0 +1,404 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"connectrpc.com/connect"
+	app "github.com/hiroky1983/talk/go/gen/app"
+	"github.com/hiroky1983/talk/go/internal/auth"
+	"github.com/hiroky1983/talk/go/internal/entitlement"
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/notify"
+	"github.com/hiroky1983/talk/go/internal/repository"
+)
+
+const (
+	// passwordResetTTL is how long a reset token issued by support stays valid
+	passwordResetTTL = 24 * time.Hour
+	// recentAuditLogLimit is the number of audit entries included in GetUserDetail
+	recentAuditLogLimit = 20
+	// maxMintCount is the largest batch of promo codes MintPromoCodes creates at once
+	maxMintCount = 1000
+)
+
+type AdminHandler struct {
+	users      repository.UserRepository
+	admin      repository.AdminRepository
+	promos     repository.PromoRepository
+	characters repository.CharacterRepository
+	email      notify.Notifier // Delivers password reset tokens to the users
+}
+
+func NewAdminHandler(users repository.UserRepository, admin repository.AdminRepository, promos repository.PromoRepository, characters repository.CharacterRepository, email notify.Notifier) *AdminHandler {
+	return &AdminHandler{
+		users:      users,
+		admin:      admin,
+		promos:     promos,
+		characters: characters,
+		email:      email,
+	}
+}
+
+func (h *AdminHandler) ListUsers(ctx context.Context, req *connect.Request[app.ListUsersRequest]) (*connect.Response[app.ListUsersResponse], error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	limit, offset, err := parsePage(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	users, total, err := h.admin.ListUsers(ctx, repository.UserFilter{
+		EmailPrefix:   req.Msg.EmailPrefix,
+		Plan:          fromAppPlan(req.Msg.Plan),
+		CreatedAfter:  fromTimestamp(req.Msg.CreatedAfter),
+		CreatedBefore: fromTimestamp(req.Msg.CreatedBefore),
+		Limit:         limit,
+		Offset:        offset,
+	})
+	if err != nil {
+		return nil, toConnectError("ListUsers", err)
+	}
+
+	resp := &app.ListUsersResponse{
+		NextPageToken: nextPageToken(limit, offset, len(users)),
+		TotalCount:    total,
+	}
+	for i := range users {
+		resp.Users = append(resp.Users, toAdminUser(&users[i]))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *AdminHandler) GetUserDetail(ctx context.Context, req *connect.Request[app.GetUserDetailRequest]) (*connect.Response[app.GetUserDetailResponse], error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	user, err := h.users.GetUserByID(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, toConnectError("GetUserDetail", err)
+	}
+	sessions, err := h.admin.CountActiveSessions(ctx, user.UsersID)
+	if err != nil {
+		return nil, toConnectError("GetUserDetail", err)
+	}
+	logs, err := h.admin.ListAuditLogs(ctx, repository.AuditLogFilter{
+		TargetUserID: user.UsersID,
+		Limit:        recentAuditLogLimit,
+	})
+	if err != nil {
+		return nil, toConnectError("GetUserDetail", err)
+	}
+
+	resp := &app.GetUserDetailResponse{
+		User:           toAdminUser(user),
+		ActiveSessions: sessions,
+	}
+	for i := range logs {
+		resp.RecentAuditLogs = append(resp.RecentAuditLogs, toAuditLogEntry(&logs[i]))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *AdminHandler) UpdateUserPlan(ctx context.Context, req *connect.Request[app.UpdateUserPlanRequest]) (*connect.Response[app.AdminUser], error) {
+	actor, err := h.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := fromAppPlan(req.Msg.Plan)
+	if plan == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("plan is required"))
+	}
+
+	user, err := h.admin.UpdateUserPlan(ctx, actor.UsersID, req.Msg.UserId, plan, req.Msg.Reason)
+	if err != nil {
+		return nil, toConnectError("UpdateUserPlan", err)
+	}
+	log.Printf("Admin %s changed plan of user %s to %s", actor.UsersID, user.UsersID, plan)
+	return connect.NewResponse(toAdminUser(user)), nil
+}
+
+func (h *AdminHandler) SetUserDisabled(ctx context.Context, req *connect.Request[app.SetUserDisabledRequest]) (*connect.Response[app.AdminUser], error) {
+	actor, err := h.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.Disabled && req.Msg.UserId == actor.UsersID {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("admins cannot disable their own account"))
+	}
+
+	user, err := h.admin.SetUserDisabled(ctx, actor.UsersID, req.Msg.UserId, req.Msg.Disabled, req.Msg.Reason)
+	if err != nil {
+		return nil, toConnectError("SetUserDisabled", err)
+	}
+	log.Printf("Admin %s set disabled=%t for user %s", actor.UsersID, req.Msg.Disabled, user.UsersID)
+	return connect.NewResponse(toAdminUser(user)), nil
+}
+
+func (h *AdminHandler) ForceLogout(ctx context.Context, req *connect.Request[app.ForceLogoutRequest]) (*connect.Response[app.ForceLogoutResponse], error) {
+	actor, err := h.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := h.admin.RevokeSessions(ctx, actor.UsersID, req.Msg.UserId, req.Msg.Reason)
+	if err != nil {
+		return nil, toConnectError("ForceLogout", err)
+	}
+	log.Printf("Admin %s revoked %d sessions of user %s", actor.UsersID, revoked, req.Msg.UserId)
+	return connect.NewResponse(&app.ForceLogoutResponse{RevokedSessions: revoked}), nil
+}
+
+func (h *AdminHandler) TriggerPasswordReset(ctx context.Context, req *connect.Request[app.TriggerPasswordResetRequest]) (*connect.Response[app.TriggerPasswordResetResponse], error) {
+	actor, err := h.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := h.users.GetUserByID(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, toConnectError("TriggerPasswordReset", err)
+	}
+	token, hash, err := auth.GenerateResetToken()
+	if err != nil {
+		return nil, toConnectError("TriggerPasswordReset", err)
+	}
+	reset := &models.PasswordResetToken{
+		UserID:    req.Msg.UserId,
+		TokenHash: hash,
+		ExpiresAt: time.Now().Add(passwordResetTTL),
+	}
+	if err := h.admin.CreatePasswordResetToken(ctx, actor.UsersID, reset, req.Msg.Reason); err != nil {
+		return nil, toConnectError("TriggerPasswordReset", err)
+	}
+	// The token only goes to the user; triggering again replaces it if the email is lost
+	if err := h.email.Notify(ctx, user, passwordResetMessage(token, reset.ExpiresAt)); err != nil {
+		return nil, toConnectError("TriggerPasswordReset", err)
+	}
+	log.Printf("Admin %s triggered a password reset for user %s", actor.UsersID, req.Msg.UserId)
+	return connect.NewResponse(&app.TriggerPasswordResetResponse{
+		ExpiresAt: toTimestamp(&reset.ExpiresAt),
+	}), nil
+}
+
+// passwordResetMessage is the email carrying a reset token to the user
+func passwordResetMessage(token string, expiresAt time.Time) notify.Message {
+	return notify.Message{
+		Kind:  notify.KindPasswordReset,
+		Title: "Reset your password",
+		Body: fmt.Sprintf("Support started a password reset for your account. Enter this code in the app to choose a new password:\n\n%s\n\nThe code expires at %s. If you did not ask for a reset, contact support.",
+			token, expiresAt.UTC().Format(time.RFC1123)),
+	}
+}
+
+func (h *AdminHandler) ListAuditLogs(ctx context.Context, req *connect.Request[app.ListAuditLogsRequest]) (*connect.Response[app.ListAuditLogsResponse], error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	limit, offset, err := parsePage(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	logs, err := h.admin.ListAuditLogs(ctx, repository.AuditLogFilter{
+		TargetUserID: req.Msg.TargetUserId,
+		Limit:        limit,
+		Offset:       offset,
+	})
+	if err != nil {
+		return nil, toConnectError("ListAuditLogs", err)
+	}
+
+	resp := &app.ListAuditLogsResponse{
+		NextPageToken: nextPageToken(limit, offset, len(logs)),
+	}
+	for i := range logs {
+		resp.Entries = append(resp.Entries, toAuditLogEntry(&logs[i]))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *AdminHandler) MintPromoCodes(ctx context.Context, req *connect.Request[app.MintPromoCodesRequest]) (*connect.Response[app.MintPromoCodesResponse], error) {
+	actor, err := h.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := fromAppPlan(req.Msg.Plan)
+	switch {
+	case plan == "" || plan == models.PlanFree:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("plan must be a paid plan"))
+	case req.Msg.DurationDays <= 0:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("duration_days must be positive"))
+	case req.Msg.Count <= 0 || req.Msg.Count > maxMintCount:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("count must be between 1 and %d", maxMintCount))
+	case req.Msg.MaxRedemptions < 0:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("max_redemptions must not be negative"))
+	}
+
+	codes := make([]models.PromoCode, req.Msg.Count)
+	for i := range codes {
+		code, err := entitlement.GeneratePromoCode(req.Msg.Prefix)
+		if err != nil {
+			return nil, toConnectError("MintPromoCodes", err)
+		}
+		codes[i] = models.PromoCode{
+			Code:            code,
+			Plan:            plan,
+			DurationDays:    int(req.Msg.DurationDays),
+			MaxRedemptions:  int(req.Msg.MaxRedemptions),
+			ExpiresAt:       fromTimestamp(req.Msg.ExpiresAt),
+			Campaign:        req.Msg.Campaign,
+			CreatedByUserID: &actor.UsersID,
+		}
+	}
+	if err := h.promos.CreatePromoCodes(ctx, actor.UsersID, codes, req.Msg.Reason); err != nil {
+		return nil, toConnectError("MintPromoCodes", err)
+	}
+	log.Printf("Admin %s minted %d promo codes for %s (%d days)", actor.UsersID, len(codes), plan, req.Msg.DurationDays)
+
+	resp := &app.MintPromoCodesResponse{}
+	for i := range codes {
+		resp.Codes = append(resp.Codes, toAppPromoCode(&codes[i]))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *AdminHandler) ListPromoCodes(ctx context.Context, req *connect.Request[app.ListPromoCodesRequest]) (*connect.Response[app.ListPromoCodesResponse], error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	limit, offset, err := parsePage(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	codes, err := h.promos.ListPromoCodes(ctx, repository.PromoCodeFilter{
+		Campaign: req.Msg.Campaign,
+		Limit:    limit,
+		Offset:   offset,
+	})
+	if err != nil {
+		return nil, toConnectError("ListPromoCodes", err)
+	}
+
+	resp := &app.ListPromoCodesResponse{
+		NextPageToken: nextPageToken(limit, offset, len(codes)),
+	}
+	for i := range codes {
+		resp.Codes = append(resp.Codes, toAppPromoCode(&codes[i]))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *AdminHandler) ListAdminCharacters(ctx context.Context, req *connect.Request[app.ListAdminCharactersRequest]) (*connect.Response[app.ListAdminCharactersResponse], error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	characters, err := h.characters.ListCharacters(ctx, req.Msg.IncludeRetired)
+	if err != nil {
+		return nil, toConnectError("ListAdminCharacters", err)
+	}
+	resp := &app.ListAdminCharactersResponse{}
+	for i := range characters {
+		c, err := toAdminCharacter(&characters[i])
+		if err != nil {
+			return nil, toConnectError("ListAdminCharacters", err)
+		}
+		resp.Characters = append(resp.Characters, c)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *AdminHandler) CreateCharacter(ctx context.Context, req *connect.Request[app.CreateCharacterRequest]) (*connect.Response[app.AdminCharacter], error) {
+	actor, err := h.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	definition := fromAdminCharacter(req.Msg.Character)
+	if err := definition.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	var created models.Character
+	if err := definition.Apply(&created); err != nil {
+		return nil, toConnectError("CreateCharacter", err)
+	}
+	if err := h.characters.CreateCharacter(ctx, actor.UsersID, &created, req.Msg.Reason); err != nil {
+		return nil, toConnectError("CreateCharacter", err)
+	}
+	log.Printf("Admin %s created character %s", actor.UsersID, created.Key)
+
+	resp, err := toAdminCharacter(&created)
+	if err != nil {
+		return nil, toConnectError("CreateCharacter", err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *AdminHandler) UpdateCharacter(ctx context.Context, req *connect.Request[app.UpdateCharacterRequest]) (*connect.Response[app.AdminCharacter], error) {
+	actor, err := h.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	definition := fromAdminCharacter(req.Msg.Character)
+	if err := definition.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	updated, err := h.characters.GetCharacterByKey(ctx, definition.Key)
+	if err != nil {
+		return nil, toConnectError("UpdateCharacter", err)
+	}
+	if err := definition.Apply(updated); err != nil {
+		return nil, toConnectError("UpdateCharacter", err)
+	}
+	if err := h.characters.UpdateCharacter(ctx, actor.UsersID, updated, req.Msg.Reason); err != nil {
+		return nil, toConnectError("UpdateCharacter", err)
+	}
+	log.Printf("Admin %s updated character %s", actor.UsersID, updated.Key)
+
+	resp, err := toAdminCharacter(updated)
+	if err != nil {
+		return nil, toConnectError("UpdateCharacter", err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// DeleteCharacter retires a character. New conversations cannot pick it, while stored ones keep their character key.
+func (h *AdminHandler) DeleteCharacter(ctx context.Context, req *connect.Request[app.DeleteCharacterRequest]) (*connect.Response[app.DeleteCharacterResponse], error) {
+	actor, err := h.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.Key == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("key is required"))
+	}
+	if err := h.characters.DeleteCharacter(ctx, actor.UsersID, req.Msg.Key, req.Msg.Reason); err != nil {
+		return nil, toConnectError("DeleteCharacter", err)
+	}
+	log.Printf("Admin %s retired character %s", actor.UsersID, req.Msg.Key)
+	return connect.NewResponse(&app.DeleteCharacterResponse{}), nil
+}
+
+// requireAdmin loads the calling user and rejects the request unless they are an enabled admin.
+// The role is read from the database so a demotion takes effect immediately.
+func (h *AdminHandler) requireAdmin(ctx context.Context) (*models.User, error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsAdmin() {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("admin role required"))
+	}
+	return user, nil
+}
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	app "github.com/hiroky1983/talk/go/gen/app"
+	"github.com/hiroky1983/talk/go/internal/character"
+	"github.com/hiroky1983/talk/go/internal/entitlement"
+	"github.com/hiroky1983/talk/go/internal/repository"
+)
+
+type CharacterHandler struct {
+	users      repository.UserRepository
+	characters repository.CharacterRepository
+	plans      *entitlement.Resolver
+}
+
+func NewCharacterHandler(users repository.UserRepository, characters repository.CharacterRepository, plans *entitlement.Resolver) *CharacterHandler {
+	return &CharacterHandler{
+		users:      users,
+		characters: characters,
+		plans:      plans,
+	}
+}
+
+// ListCharacters returns the active characters, marking those the user's plan does not include as unavailable
+func (h *CharacterHandler) ListCharacters(ctx context.Context, req *connect.Request[app.ListCharactersRequest]) (*connect.Response[app.ListCharactersResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := h.plans.Refresh(ctx, user.UsersID)
+	if err != nil {
+		return nil, toConnectError("ListCharacters", err)
+	}
+
+	characters, err := h.characters.ListCharacters(ctx, false)
+	if err != nil {
+		return nil, toConnectError("ListCharacters", err)
+	}
+	res := &app.ListCharactersResponse{Characters: make([]*app.Character, 0, len(characters))}
+	for i := range characters {
+		if req.Msg.Language != "" {
+			voice, err := character.Voice(&characters[i], req.Msg.Language)
+			if err != nil {
+				return nil, toConnectError("ListCharacters", err)
+			}
+			if voice == "" {
+				continue
+			}
+		}
+		c, err := toAppCharacter(&characters[i], req.Msg.Locale, plan)
+		if err != nil {
+			return nil, toConnectError("ListCharacters", err)
+		}
+		res.Characters = append(res.Characters, c)
+	}
+	return connect.NewResponse(res), nil
+}
@@ -0,0 +1,194 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	app "github.com/hiroky1983/talk/go/gen/app"
+	"github.com/hiroky1983/talk/go/internal/conversation"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"github.com/hiroky1983/talk/go/internal/search"
+	"github.com/hiroky1983/talk/go/internal/storage"
+)
+
+type ConversationHandler struct {
+	users         repository.UserRepository
+	conversations repository.ConversationRepository
+	summaries     repository.SummaryRepository
+	blobs         storage.BlobStore
+}
+
+func NewConversationHandler(users repository.UserRepository, conversations repository.ConversationRepository, summaries repository.SummaryRepository, blobs storage.BlobStore) *ConversationHandler {
+	return &ConversationHandler{
+		users:         users,
+		conversations: conversations,
+		summaries:     summaries,
+		blobs:         blobs,
+	}
+}
+
+func (h *ConversationHandler) ListConversations(ctx context.Context, req *connect.Request[app.ListConversationsRequest]) (*connect.Response[app.ListConversationsResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	limit, _, err := parsePage(req.Msg.PageSize, "")
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := parseConversationCursor(req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	conversations, err := h.conversations.ListConversations(ctx, repository.ConversationFilter{
+		UserID:        user.UsersID,
+		Language:      req.Msg.Language,
+		Character:     req.Msg.Character,
+		StartedAfter:  fromTimestamp(req.Msg.StartedAfter),
+		StartedBefore: fromTimestamp(req.Msg.StartedBefore),
+		After:         cursor,
+		Limit:         limit,
+	})
+	if err != nil {
+		return nil, toConnectError("ListConversations", err)
+	}
+
+	resp := &app.ListConversationsResponse{
+		NextPageToken: nextConversationPageToken(limit, conversations),
+	}
+	for i := range conversations {
+		resp.Conversations = append(resp.Conversations, toAppConversation(&conversations[i]))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *ConversationHandler) GetConversation(ctx context.Context, req *connect.Request[app.GetConversationRequest]) (*connect.Response[app.GetConversationResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateConversationID(req.Msg.ConversationId); err != nil {
+		return nil, err
+	}
+
+	conversation, err := h.conversations.GetConversation(ctx, user.UsersID, req.Msg.ConversationId)
+	if err != nil {
+		return nil, toConnectError("GetConversation", err)
+	}
+	turns, err := h.conversations.ListConversationTurns(ctx, conversation.ConversationsID, 0, 0)
+	if err != nil {
+		return nil, toConnectError("GetConversation", err)
+	}
+
+	resp := &app.GetConversationResponse{
+		Conversation: toAppConversation(conversation),
+	}
+	for i := range turns {
+		resp.Turns = append(resp.Turns, toAppConversationTurn(&turns[i]))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *ConversationHandler) GetConversationSummary(ctx context.Context, req *connect.Request[app.GetConversationSummaryRequest]) (*connect.Response[app.GetConversationSummaryResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateConversationID(req.Msg.ConversationId); err != nil {
+		return nil, err
+	}
+
+	summary, err := h.summaries.GetSummary(ctx, user.UsersID, req.Msg.ConversationId)
+	if err != nil {
+		return nil, toConnectError("GetConversationSummary", err)
+	}
+	appSummary, err := toAppConversationSummary(summary)
+	if err != nil {
+		return nil, toConnectError("GetConversationSummary", err)
+	}
+	return connect.NewResponse(&app.GetConversationSummaryResponse{Summary: appSummary}), nil
+}
+
+func (h *ConversationHandler) SearchTranscripts(ctx context.Context, req *connect.Request[app.SearchTranscriptsRequest]) (*connect.Response[app.SearchTranscriptsResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	query := search.ParseQuery(req.Msg.Query)
+	if query.Empty() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("query must contain a word"))
+	}
+	limit, offset, err := parsePage(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	turns, err := h.conversations.SearchTurns(ctx, repository.TranscriptSearchFilter{
+		UserID:   user.UsersID,
+		TSQuery:  query.TSQuery(),
+		Language: req.Msg.Language,
+		Limit:    limit,
+		Offset:   offset,
+	})
+	if err != nil {
+		return nil, toConnectError("SearchTranscripts", err)
+	}
+
+	resp := &app.SearchTranscriptsResponse{
+		NextPageToken: nextPageToken(limit, offset, len(turns)),
+	}
+	for i := range turns {
+		resp.Matches = append(resp.Matches, toTranscriptMatch(query, &turns[i]))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *ConversationHandler) DeleteConversation(ctx context.Context, req *connect.Request[app.DeleteConversationRequest]) (*connect.Response[app.DeleteConversationResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateConversationID(req.Msg.ConversationId); err != nil {
+		return nil, err
+	}
+
+	if err := h.conversations.DeleteConversation(ctx, user.UsersID, req.Msg.ConversationId); err != nil {
+		return nil, toConnectError("DeleteConversation", err)
+	}
+	h.deleteAudio(ctx, conversation.AudioPrefix(user.UsersID, req.Msg.ConversationId))
+	return connect.NewResponse(&app.DeleteConversationResponse{}), nil
+}
+
+func (h *ConversationHandler) DeleteAllConversations(ctx context.Context, req *connect.Request[app.DeleteAllConversationsRequest]) (*connect.Response[app.DeleteAllConversationsResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := h.conversations.DeleteAllConversations(ctx, user.UsersID)
+	if err != nil {
+		return nil, toConnectError("DeleteAllConversations", err)
+	}
+	h.deleteAudio(ctx, conversation.UserAudioPrefix(user.UsersID))
+	return connect.NewResponse(&app.DeleteAllConversationsResponse{DeletedCount: deleted}), nil
+}
+
+// deleteAudio removes recorded audio of deleted conversations.
+// The rows are already gone, so a failure only leaves unreachable blobs behind and is logged.
+func (h *ConversationHandler) deleteAudio(ctx context.Context, prefix string) {
+	if err := h.blobs.DeletePrefix(ctx, prefix); err != nil {
+		log.Printf("Failed to delete conversation audio %s: %v", prefix, err)
+	}
+}
+
+// validateConversationID rejects IDs that cannot identify a conversation
+func validateConversationID(conversationID string) error {
+	if _, err := uuid.Parse(conversationID); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("invalid conversation_id"))
+	}
+	return nil
+}
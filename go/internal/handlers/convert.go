@@ -0,0 +1,542 @@
+package handlers
+
+import (
+	"fmt"
+	"time"
+
+	app "github.com/hiroky1983/talk/go/gen/app"
+	"github.com/hiroky1983/talk/go/internal/character"
+	"github.com/hiroky1983/talk/go/internal/mistake"
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/proficiency"
+	"github.com/hiroky1983/talk/go/internal/progress"
+	"github.com/hiroky1983/talk/go/internal/reminder"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"github.com/hiroky1983/talk/go/internal/retention"
+	"github.com/hiroky1983/talk/go/internal/search"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// models.UserPlan, models.UserRole, models.CloseReason, models.SummaryStatus, models.GoalMetric,
+// models.NotificationChannel, models.ScenarioDifficulty, models.CharacterGender, models.CharacterAgeBand,
+// models.CharacterFormality, models.CEFRLevel, models.LevelChangeSource and proficiency.Signal values
+// share their names with the proto enums
+
+func toAppPlan(plan models.UserPlan) app.Plan {
+	return app.Plan(app.Plan_value[string(plan)])
+}
+
+func fromAppPlan(plan app.Plan) models.UserPlan {
+	if plan == app.Plan_PLAN_UNSPECIFIED {
+		return ""
+	}
+	return models.UserPlan(plan.String())
+}
+
+func toAppRole(role models.UserRole) app.Role {
+	return app.Role(app.Role_value[string(role)])
+}
+
+func toTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func fromTimestamp(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+func toAdminUser(user *models.User) *app.AdminUser {
+	return &app.AdminUser{
+		UserId:     user.UsersID,
+		UserName:   user.Username,
+		Email:      user.Email,
+		Plan:       toAppPlan(user.Plan),
+		Role:       toAppRole(user.Role),
+		Disabled:   user.IsDisabled(),
+		DisabledAt: toTimestamp(user.DisabledAt),
+		CreatedAt:  toTimestamp(&user.CreatedAt),
+		UpdatedAt:  toTimestamp(&user.UpdatedAt),
+	}
+}
+
+func toAuditLogEntry(entry *models.AuditLog) *app.AuditLogEntry {
+	result := &app.AuditLogEntry{
+		AuditLogId: entry.AuditLogsID,
+		Action:     string(entry.Action),
+		Details:    entry.Details,
+		CreatedAt:  toTimestamp(&entry.CreatedAt),
+	}
+	if entry.ActorUserID != nil {
+		result.ActorUserId = *entry.ActorUserID
+	}
+	if entry.TargetUserID != nil {
+		result.TargetUserId = *entry.TargetUserID
+	}
+	return result
+}
+
+func toAppPromoCode(code *models.PromoCode) *app.PromoCode {
+	return &app.PromoCode{
+		PromoCodeId:     code.PromoCodesID,
+		Code:            code.Code,
+		Plan:            toAppPlan(code.Plan),
+		DurationDays:    int32(code.DurationDays),
+		MaxRedemptions:  int32(code.MaxRedemptions),
+		RedemptionCount: int32(code.RedemptionCount),
+		ExpiresAt:       toTimestamp(code.ExpiresAt),
+		Campaign:        code.Campaign,
+		CreatedAt:       toTimestamp(&code.CreatedAt),
+	}
+}
+
+func toAppConversation(conversation *models.Conversation) *app.Conversation {
+	res := &app.Conversation{
+		ConversationId: conversation.ConversationsID,
+		Language:       conversation.Language,
+		Character:      conversation.Character,
+		Plan:           toAppPlan(conversation.Plan),
+		StartedAt:      toTimestamp(&conversation.StartedAt),
+		EndedAt:        toTimestamp(conversation.EndedAt),
+		CloseReason:    app.CloseReason(app.CloseReason_value[string(conversation.CloseReason)]),
+	}
+	if conversation.ScenarioID != nil {
+		res.ScenarioId = *conversation.ScenarioID
+	}
+	return res
+}
+
+func toAppConversationTurn(turn *models.ConversationTurn) *app.ConversationTurn {
+	res := &app.ConversationTurn{
+		TurnId:         turn.ConversationTurnsID,
+		Seq:            int32(turn.Seq),
+		UserTranscript: turn.UserTranscript,
+		AiText:         turn.AIText,
+		StartedAt:      toTimestamp(&turn.StartedAt),
+		EndedAt:        toTimestamp(turn.EndedAt),
+	}
+	for _, f := range turn.Feedback {
+		res.Feedback = append(res.Feedback, &app.Feedback{
+			Original:    f.Original,
+			Correction:  f.Correction,
+			Category:    app.FeedbackCategory(app.FeedbackCategory_value[string(f.Category)]),
+			Explanation: f.Explanation,
+		})
+	}
+	return res
+}
+
+// toAppConversationSummary converts a summary; the recap is only included once it is ready
+func toAppConversationSummary(summary *models.ConversationSummary) (*app.ConversationSummary, error) {
+	res := &app.ConversationSummary{
+		ConversationId: summary.ConversationID,
+		Status:         app.SummaryStatus(app.SummaryStatus_value[string(summary.Status)]),
+		UpdatedAt:      toTimestamp(&summary.UpdatedAt),
+	}
+	if summary.Status != models.SummaryStatusReady {
+		return res, nil
+	}
+	content, err := summary.ParseContent()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse conversation summary: %w", err)
+	}
+	res.Topics = content.Topics
+	for _, item := range content.Vocabulary {
+		res.Vocabulary = append(res.Vocabulary, &app.VocabularyItem{Term: item.Term, Meaning: item.Meaning})
+	}
+	for _, mistake := range content.Mistakes {
+		res.Mistakes = append(res.Mistakes, &app.Mistake{
+			Original:    mistake.Original,
+			Correction:  mistake.Correction,
+			Explanation: mistake.Explanation,
+		})
+	}
+	return res, nil
+}
+
+func toAppUserSettings(settings *models.UserSettings) *app.UserSettings {
+	return &app.UserSettings{
+		AudioOptOut:             settings.AudioOptOut,
+		AudioRetentionDays:      int32(settings.AudioRetentionDays),
+		TranscriptRetentionDays: int32(settings.TranscriptRetentionDays),
+		PrivacyMode:             settings.PrivacyMode,
+		TimeZone:                settings.TimeZone,
+	}
+}
+
+func toAppRetention(policy retention.Policy) *app.Retention {
+	return &app.Retention{
+		AudioDays:      int32(policy.AudioDays),
+		TranscriptDays: int32(policy.TranscriptDays),
+	}
+}
+
+func toAppMemory(memory *models.UserMemory) *app.Memory {
+	m := &app.Memory{
+		MemoryId:  memory.UserMemoriesID,
+		Content:   memory.Content,
+		CreatedAt: toTimestamp(&memory.CreatedAt),
+		UpdatedAt: toTimestamp(&memory.UpdatedAt),
+	}
+	if memory.ConversationID != nil {
+		m.ConversationId = *memory.ConversationID
+	}
+	return m
+}
+
+func toAppVocabularyCard(card *models.VocabularyCard) *app.VocabularyCard {
+	c := &app.VocabularyCard{
+		CardId:         card.VocabularyCardsID,
+		Term:           card.Term,
+		Reading:        card.Reading,
+		Translation:    card.Translation,
+		Example:        card.Example,
+		DueAt:          toTimestamp(&card.DueAt),
+		IntervalDays:   int32(card.IntervalDays),
+		Repetitions:    int32(card.Repetitions),
+		EaseFactor:     card.EaseFactor,
+		LastReviewedAt: toTimestamp(card.LastReviewedAt),
+		CreatedAt:      toTimestamp(&card.CreatedAt),
+	}
+	if card.SourceTurn != nil {
+		c.SourceConversationId = card.SourceTurn.ConversationID
+		c.SourceSeq = int32(card.SourceTurn.Seq)
+	}
+	return c
+}
+
+func toAppVocabularyCards(cards []models.VocabularyCard) []*app.VocabularyCard {
+	res := make([]*app.VocabularyCard, 0, len(cards))
+	for i := range cards {
+		res = append(res, toAppVocabularyCard(&cards[i]))
+	}
+	return res
+}
+
+// toAppMistakePatterns converts patterns, attaching the examples of each
+func toAppMistakePatterns(patterns []repository.MistakePattern, examples []repository.MistakeExample) []*app.MistakePattern {
+	byKey := make(map[repository.MistakeKey][]*app.MistakeExample, len(patterns))
+	for i := range examples {
+		e := &examples[i]
+		key := repository.MistakeKey{Language: e.Language, Category: e.Category, Pattern: e.Pattern}
+		byKey[key] = append(byKey[key], &app.MistakeExample{
+			ConversationId: e.ConversationID,
+			Seq:            int32(e.Seq),
+			Original:       e.Original,
+			Correction:     e.Correction,
+			Explanation:    e.Explanation,
+			OccurredAt:     toTimestamp(&e.OccurredAt),
+		})
+	}
+	res := make([]*app.MistakePattern, 0, len(patterns))
+	for i := range patterns {
+		p := &patterns[i]
+		res = append(res, &app.MistakePattern{
+			Language:           p.Language,
+			Category:           app.FeedbackCategory(app.FeedbackCategory_value[string(p.Category)]),
+			Pattern:            p.Pattern,
+			Count:              int32(p.Count),
+			RecentCount:        int32(p.RecentCount),
+			Conversations:      int32(p.Conversations),
+			FirstSeenAt:        toTimestamp(&p.FirstSeenAt),
+			LastSeenAt:         toTimestamp(&p.LastSeenAt),
+			ConversationsSince: int32(p.ConversationsSince),
+			Resolved:           mistake.Resolved(p.ConversationsSince),
+			Examples:           byKey[p.MistakeKey],
+		})
+	}
+	return res
+}
+
+func toAppProgress(p progress.Progress, loc *time.Location) *app.GetProgressResponse {
+	res := &app.GetProgressResponse{
+		TimeZone: loc.String(),
+		Total:    toAppPracticeTotals(p.Total),
+		Streak: &app.Streak{
+			CurrentDays: int32(p.Streak.Current),
+			LongestDays: int32(p.Streak.Longest),
+		},
+		Weekly:  toAppPracticePeriods(p.Weekly),
+		Monthly: toAppPracticePeriods(p.Monthly),
+	}
+	for _, l := range p.Languages {
+		res.Languages = append(res.Languages, &app.LanguageProgress{
+			Language:        l.Language,
+			Totals:          toAppPracticeTotals(l.Totals),
+			LastPracticedAt: toTimestamp(&l.LastPracticedAt),
+		})
+	}
+	return res
+}
+
+func toAppPracticeTotals(t progress.Totals) *app.PracticeTotals {
+	return &app.PracticeTotals{
+		SpokenSeconds:   int64(t.Spoken.Seconds()),
+		ListenedSeconds: int64(t.Listened.Seconds()),
+		Turns:           int32(t.Turns),
+		Sessions:        int32(t.Sessions),
+		Characters:      int32(t.Characters),
+		Languages:       int32(t.Languages),
+		Days:            int32(t.Days),
+	}
+}
+
+func toAppPracticePeriods(buckets []progress.Bucket) []*app.PracticePeriod {
+	res := make([]*app.PracticePeriod, 0, len(buckets))
+	for _, b := range buckets {
+		res = append(res, &app.PracticePeriod{
+			StartDate: b.Start.Format(time.DateOnly),
+			Totals:    toAppPracticeTotals(b.Totals),
+		})
+	}
+	return res
+}
+
+func toAppDailyGoal(goal *models.LearningGoal) *app.DailyGoal {
+	return &app.DailyGoal{
+		Metric:          app.GoalMetric(app.GoalMetric_value[string(goal.Metric)]),
+		Target:          int32(goal.Target),
+		ReminderEnabled: goal.ReminderEnabled,
+		ReminderTime:    formatReminderTime(goal.ReminderMinute),
+		ReminderChannel: app.NotificationChannel(app.NotificationChannel_value[string(goal.ReminderChannel)]),
+		NextReminderAt:  toTimestamp(goal.NextReminderAt),
+		LastRemindedAt:  toTimestamp(goal.LastRemindedAt),
+	}
+}
+
+// fromAppDailyGoal converts the settable fields of a goal, filling in the default reminder time and channel
+func fromAppDailyGoal(goal *app.DailyGoal) (*models.LearningGoal, error) {
+	res := &models.LearningGoal{
+		Target:          int(goal.Target),
+		ReminderEnabled: goal.ReminderEnabled,
+		ReminderMinute:  reminder.DefaultReminderMinute,
+		ReminderChannel: models.NotificationChannelInApp,
+	}
+	if goal.Metric != app.GoalMetric_GOAL_METRIC_UNSPECIFIED {
+		res.Metric = models.GoalMetric(goal.Metric.String())
+	}
+	if goal.ReminderChannel != app.NotificationChannel_NOTIFICATION_CHANNEL_UNSPECIFIED {
+		res.ReminderChannel = models.NotificationChannel(goal.ReminderChannel.String())
+	}
+	if goal.ReminderTime != "" {
+		minute, err := parseReminderTime(goal.ReminderTime)
+		if err != nil {
+			return nil, err
+		}
+		res.ReminderMinute = minute
+	}
+	return res, nil
+}
+
+func toAppNotification(notification *models.Notification) *app.Notification {
+	return &app.Notification{
+		Id:        notification.NotificationsID,
+		Kind:      notification.Kind,
+		Title:     notification.Title,
+		Body:      notification.Body,
+		CreatedAt: toTimestamp(&notification.CreatedAt),
+		ReadAt:    toTimestamp(notification.ReadAt),
+	}
+}
+
+// toAppScenario converts a scenario loaded with the localization of one language and the user's statistics, if any
+func toAppScenario(scenario *models.Scenario, stats *repository.ScenarioStats) (*app.Scenario, error) {
+	localization := scenario.Localizations[0]
+	goals, err := localization.GoalList()
+	if err != nil {
+		return nil, err
+	}
+	vocabulary, err := localization.VocabularyList()
+	if err != nil {
+		return nil, err
+	}
+	res := &app.Scenario{
+		ScenarioId:  scenario.ScenariosID,
+		Slug:        scenario.Slug,
+		Language:    localization.Language,
+		Title:       localization.Title,
+		Description: localization.Description,
+		Difficulty:  app.ScenarioDifficulty(app.ScenarioDifficulty_value[string(scenario.Difficulty)]),
+		Goals:       goals,
+		Vocabulary:  vocabulary,
+	}
+	if stats != nil {
+		res.Progress = &app.ScenarioProgress{
+			Sessions:          int32(stats.Sessions),
+			Completions:       int32(stats.Completions),
+			BestGoalsAchieved: int32(stats.BestGoalsAchieved),
+			LastPlayedAt:      toTimestamp(&stats.LastPlayedAt),
+		}
+	}
+	return res, nil
+}
+
+// toAppCharacter converts a character as shown to a user on plan, named in the locale
+func toAppCharacter(c *models.Character, locale string, plan models.UserPlan) (*app.Character, error) {
+	languages, err := character.Languages(c)
+	if err != nil {
+		return nil, err
+	}
+	return &app.Character{
+		Key:         c.Key,
+		DisplayName: character.DisplayName(c, locale),
+		Gender:      app.CharacterGender(app.CharacterGender_value[string(c.Gender)]),
+		AgeBand:     app.CharacterAgeBand(app.CharacterAgeBand_value[string(c.AgeBand)]),
+		Languages:   languages,
+		MinPlan:     toAppPlan(c.MinPlan),
+		Available:   character.Available(c, plan),
+	}, nil
+}
+
+func toAdminCharacter(c *models.Character) (*app.AdminCharacter, error) {
+	voices, err := c.VoiceMap()
+	if err != nil {
+		return nil, err
+	}
+	names, err := c.DisplayNameMap()
+	if err != nil {
+		return nil, err
+	}
+	return &app.AdminCharacter{
+		CharacterId:  c.CharactersID,
+		Key:          c.Key,
+		Persona:      c.Persona,
+		Gender:       app.CharacterGender(app.CharacterGender_value[string(c.Gender)]),
+		AgeBand:      app.CharacterAgeBand(app.CharacterAgeBand_value[string(c.AgeBand)]),
+		MinPlan:      toAppPlan(c.MinPlan),
+		Voices:       voices,
+		DisplayNames: names,
+		Position:     int32(c.Position),
+		Active:       c.Active,
+		CreatedAt:    toTimestamp(&c.CreatedAt),
+		UpdatedAt:    toTimestamp(&c.UpdatedAt),
+	}, nil
+}
+
+// fromAdminCharacter converts an edited character. Unspecified enums are left empty for validation to reject.
+func fromAdminCharacter(c *app.AdminCharacter) character.Definition {
+	definition := character.Definition{
+		Key:          c.GetKey(),
+		Persona:      c.GetPersona(),
+		MinPlan:      fromAppPlan(c.GetMinPlan()),
+		Voices:       c.GetVoices(),
+		DisplayNames: c.GetDisplayNames(),
+		Position:     int(c.GetPosition()),
+		Active:       c.GetActive(),
+	}
+	if c.GetGender() != app.CharacterGender_CHARACTER_GENDER_UNSPECIFIED {
+		definition.Gender = models.CharacterGender(c.GetGender().String())
+	}
+	if c.GetAgeBand() != app.CharacterAgeBand_CHARACTER_AGE_BAND_UNSPECIFIED {
+		definition.AgeBand = models.CharacterAgeBand(c.GetAgeBand().String())
+	}
+	return definition
+}
+
+// toAppCustomCharacter converts a custom character as seen by viewerID. Only its owner sees the share token.
+func toAppCustomCharacter(c *models.CustomCharacter, viewerID string) *app.CustomCharacter {
+	res := &app.CustomCharacter{
+		CustomCharacterId: c.CustomCharactersID,
+		Name:              c.Name,
+		Personality:       c.Personality,
+		SpeakingStyle:     c.SpeakingStyle,
+		Formality:         app.CharacterFormality(app.CharacterFormality_value[string(c.Formality)]),
+		Voice:             c.Voice,
+		Shared:            c.IsShared(),
+		Owned:             c.UserID == viewerID,
+		CreatedAt:         toTimestamp(&c.CreatedAt),
+		UpdatedAt:         toTimestamp(&c.UpdatedAt),
+	}
+	if res.Owned && c.ShareToken != nil {
+		res.ShareToken = *c.ShareToken
+	}
+	return res
+}
+
+func fromAppFormality(formality app.CharacterFormality) models.CharacterFormality {
+	if formality == app.CharacterFormality_CHARACTER_FORMALITY_UNSPECIFIED {
+		return ""
+	}
+	return models.CharacterFormality(formality.String())
+}
+
+func toAppLanguageProfile(profile *models.UserLanguageProfile) *app.LanguageProfile {
+	res := &app.LanguageProfile{
+		Language:         profile.Language,
+		Level:            app.CEFRLevel(app.CEFRLevel_value[string(profile.Level)]),
+		PlacementScore:   profile.PlacementScore,
+		PlacedAt:         toTimestamp(profile.PlacedAt),
+		UpdatedAt:        toTimestamp(&profile.UpdatedAt),
+		Estimate:         profile.Estimate,
+		AssessedSessions: int32(profile.AssessedSessions),
+		AssessedAt:       toTimestamp(profile.AssessedAt),
+	}
+	if profile.AssessedSessions > 0 {
+		res.Signals = toAppSignalReadings(profile.Signals)
+	}
+	return res
+}
+
+// toAppLevelChange converts a level change, explaining a change made by conversations with the signals behind it
+func toAppLevelChange(change *models.LevelChange) *app.LevelChange {
+	res := &app.LevelChange{
+		Id:             change.LevelChangesID,
+		Language:       change.Language,
+		Source:         app.LevelChangeSource(app.LevelChangeSource_value[string(change.Source)]),
+		PreviousLevel:  app.CEFRLevel(app.CEFRLevel_value[string(change.PreviousLevel)]),
+		Level:          app.CEFRLevel(app.CEFRLevel_value[string(change.Level)]),
+		Estimate:       change.Estimate,
+		PlacementScore: change.PlacementScore,
+		ChangedAt:      timestamppb.New(change.CreatedAt),
+	}
+	if change.Source == models.LevelChangeSourceConversation {
+		res.Signals = toAppSignalReadings(change.Signals)
+	}
+	return res
+}
+
+func toAppSignalReadings(signals models.LanguageSignals) []*app.SignalReading {
+	readings := proficiency.Readings(signals)
+	res := make([]*app.SignalReading, 0, len(readings))
+	for _, reading := range readings {
+		res = append(res, &app.SignalReading{
+			Signal:   app.ProficiencySignal(app.ProficiencySignal_value[string(reading.Signal)]),
+			Value:    reading.Value,
+			Estimate: reading.Estimate,
+		})
+	}
+	return res
+}
+
+// toTranscriptMatch converts a turn found by a search, highlighting the query in what each speaker said
+func toTranscriptMatch(query search.Query, turn *models.ConversationTurn) *app.TranscriptMatch {
+	match := &app.TranscriptMatch{
+		Conversation: toAppConversation(&turn.Conversation),
+		TurnId:       turn.ConversationTurnsID,
+		Seq:          int32(turn.Seq),
+	}
+	for _, part := range []struct {
+		speaker app.Speaker
+		text    string
+	}{
+		{app.Speaker_SPEAKER_USER, turn.UserTranscript},
+		{app.Speaker_SPEAKER_AI, turn.AIText},
+	} {
+		snippet, ok := query.Highlight(part.text)
+		if !ok {
+			continue
+		}
+		transcript := &app.TranscriptSnippet{Speaker: part.speaker, Text: snippet.Text}
+		for _, h := range snippet.Highlights {
+			transcript.Highlights = append(transcript.Highlights, &app.TextRange{Start: int32(h.Start), End: int32(h.End)})
+		}
+		match.Snippets = append(match.Snippets, transcript)
+	}
+	return match
+}
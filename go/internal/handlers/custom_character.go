@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	app "github.com/hiroky1983/talk/go/gen/app"
+	"github.com/hiroky1983/talk/go/internal/character"
+	"github.com/hiroky1983/talk/go/internal/entitlement"
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+)
+
+type CustomCharacterHandler struct {
+	users      repository.UserRepository
+	characters repository.CustomCharacterRepository
+	plans      *entitlement.Resolver
+}
+
+func NewCustomCharacterHandler(users repository.UserRepository, characters repository.CustomCharacterRepository, plans *entitlement.Resolver) *CustomCharacterHandler {
+	return &CustomCharacterHandler{
+		users:      users,
+		characters: characters,
+		plans:      plans,
+	}
+}
+
+func (h *CustomCharacterHandler) ListCustomCharacters(ctx context.Context, req *connect.Request[app.ListCustomCharactersRequest]) (*connect.Response[app.ListCustomCharactersResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	characters, err := h.characters.ListCustomCharacters(ctx, user.UsersID)
+	if err != nil {
+		return nil, toConnectError("ListCustomCharacters", err)
+	}
+	res := &app.ListCustomCharactersResponse{
+		Characters: make([]*app.CustomCharacter, 0, len(characters)),
+		Voices:     character.CustomVoices,
+	}
+	for i := range characters {
+		res.Characters = append(res.Characters, toAppCustomCharacter(&characters[i], user.UsersID))
+	}
+	return connect.NewResponse(res), nil
+}
+
+// CreateCustomCharacter designs a new character. It requires the premium plan.
+func (h *CustomCharacterHandler) CreateCustomCharacter(ctx context.Context, req *connect.Request[app.CreateCustomCharacterRequest]) (*connect.Response[app.CustomCharacter], error) {
+	user, err := h.requirePremium(ctx)
+	if err != nil {
+		return nil, err
+	}
+	design := character.Custom{
+		Name:          req.Msg.Name,
+		Personality:   req.Msg.Personality,
+		SpeakingStyle: req.Msg.SpeakingStyle,
+		Formality:     fromAppFormality(req.Msg.Formality),
+		Voice:         req.Msg.Voice,
+	}
+	if err := design.Normalize(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	created := &models.CustomCharacter{UserID: user.UsersID}
+	design.Apply(created)
+	if err := setShared(created, req.Msg.Shared); err != nil {
+		return nil, toConnectError("CreateCustomCharacter", err)
+	}
+	if err := h.characters.CreateCustomCharacter(ctx, created, character.MaxCustomPerUser); err != nil {
+		return nil, toConnectError("CreateCustomCharacter", err)
+	}
+	return connect.NewResponse(toAppCustomCharacter(created, user.UsersID)), nil
+}
+
+// UpdateCustomCharacter replaces the design and sharing of a character of the user. It requires the premium plan.
+func (h *CustomCharacterHandler) UpdateCustomCharacter(ctx context.Context, req *connect.Request[app.UpdateCustomCharacterRequest]) (*connect.Response[app.CustomCharacter], error) {
+	user, err := h.requirePremium(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCustomCharacterID(req.Msg.CustomCharacterId); err != nil {
+		return nil, err
+	}
+	design := character.Custom{
+		Name:          req.Msg.Name,
+		Personality:   req.Msg.Personality,
+		SpeakingStyle: req.Msg.SpeakingStyle,
+		Formality:     fromAppFormality(req.Msg.Formality),
+		Voice:         req.Msg.Voice,
+	}
+	if err := design.Normalize(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	updated, err := h.characters.GetCustomCharacter(ctx, user.UsersID, req.Msg.CustomCharacterId)
+	if err != nil {
+		return nil, toConnectError("UpdateCustomCharacter", err)
+	}
+	design.Apply(updated)
+	if err := setShared(updated, req.Msg.Shared); err != nil {
+		return nil, toConnectError("UpdateCustomCharacter", err)
+	}
+	if err := h.characters.UpdateCustomCharacter(ctx, updated); err != nil {
+		return nil, toConnectError("UpdateCustomCharacter", err)
+	}
+	return connect.NewResponse(toAppCustomCharacter(updated, user.UsersID)), nil
+}
+
+// DeleteCustomCharacter deletes a character of the user. It is allowed on every plan so users can clean up after a downgrade.
+func (h *CustomCharacterHandler) DeleteCustomCharacter(ctx context.Context, req *connect.Request[app.DeleteCustomCharacterRequest]) (*connect.Response[app.DeleteCustomCharacterResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCustomCharacterID(req.Msg.CustomCharacterId); err != nil {
+		return nil, err
+	}
+	if err := h.characters.DeleteCustomCharacter(ctx, user.UsersID, req.Msg.CustomCharacterId); err != nil {
+		return nil, toConnectError("DeleteCustomCharacter", err)
+	}
+	return connect.NewResponse(&app.DeleteCustomCharacterResponse{}), nil
+}
+
+// GetSharedCharacter returns the character behind a share link
+func (h *CustomCharacterHandler) GetSharedCharacter(ctx context.Context, req *connect.Request[app.GetSharedCharacterRequest]) (*connect.Response[app.CustomCharacter], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.ShareToken == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("share_token is required"))
+	}
+	shared, err := h.characters.GetSharedCharacter(ctx, req.Msg.ShareToken)
+	if err != nil {
+		return nil, toConnectError("GetSharedCharacter", err)
+	}
+	return connect.NewResponse(toAppCustomCharacter(shared, user.UsersID)), nil
+}
+
+// requirePremium loads the calling user and rejects the request unless their plan includes custom characters
+func (h *CustomCharacterHandler) requirePremium(ctx context.Context) (*models.User, error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := h.plans.Refresh(ctx, user.UsersID)
+	if err != nil {
+		return nil, toConnectError("requirePremium", err)
+	}
+	if entitlement.IsUpgrade(plan, models.PlanPremium) {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("custom characters require the premium plan"))
+	}
+	return user, nil
+}
+
+// setShared issues a share token when sharing is turned on and drops it when turned off.
+// A character that stays shared keeps its link.
+func setShared(c *models.CustomCharacter, shared bool) error {
+	switch {
+	case shared && !c.IsShared():
+		token, err := character.GenerateShareToken()
+		if err != nil {
+			return err
+		}
+		c.ShareToken = &token
+	case !shared:
+		c.ShareToken = nil
+	}
+	return nil
+}
+
+// validateCustomCharacterID rejects IDs that cannot identify a custom character
+func validateCustomCharacterID(customCharacterID string) error {
+	if _, err := uuid.Parse(customCharacterID); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("invalid custom_character_id"))
+	}
+	return nil
+}
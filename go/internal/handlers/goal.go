@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	app "github.com/hiroky1983/talk/go/gen/app"
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/progress"
+	"github.com/hiroky1983/talk/go/internal/reminder"
+	"github.com/hiroky1983/talk/go/internal/repository"
+)
+
+type GoalHandler struct {
+	users    repository.UserRepository
+	goals    repository.GoalRepository
+	settings repository.SettingsRepository
+	progress repository.ProgressRepository
+	location *time.Location
+}
+
+// NewGoalHandler creates a goal handler scheduling reminders in loc for users without a time zone
+func NewGoalHandler(users repository.UserRepository, goals repository.GoalRepository, settings repository.SettingsRepository, progress repository.ProgressRepository, loc *time.Location) *GoalHandler {
+	return &GoalHandler{
+		users:    users,
+		goals:    goals,
+		settings: settings,
+		progress: progress,
+		location: loc,
+	}
+}
+
+func (h *GoalHandler) GetGoal(ctx context.Context, req *connect.Request[app.GetGoalRequest]) (*connect.Response[app.GetGoalResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	goal, err := h.goals.GetGoal(ctx, user.UsersID)
+	if err != nil {
+		return nil, toConnectError("GetGoal", err)
+	}
+	loc, err := h.userLocation(ctx, user.UsersID)
+	if err != nil {
+		return nil, toConnectError("GetGoal", err)
+	}
+	today, err := h.today(ctx, goal, loc)
+	if err != nil {
+		return nil, toConnectError("GetGoal", err)
+	}
+	return connect.NewResponse(&app.GetGoalResponse{Goal: toAppDailyGoal(goal), Today: today}), nil
+}
+
+// SetGoal replaces the user's goal and schedules its next reminder in the user's time zone
+func (h *GoalHandler) SetGoal(ctx context.Context, req *connect.Request[app.SetGoalRequest]) (*connect.Response[app.SetGoalResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.Goal == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("goal is required"))
+	}
+	goal, err := fromAppDailyGoal(req.Msg.Goal)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := reminder.ValidateGoal(goal); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	goal.UserID = user.UsersID
+
+	loc, err := h.userLocation(ctx, user.UsersID)
+	if err != nil {
+		return nil, toConnectError("SetGoal", err)
+	}
+	reminder.Schedule(goal, loc, time.Now())
+	if err := h.goals.SaveGoal(ctx, goal); err != nil {
+		return nil, toConnectError("SetGoal", err)
+	}
+	today, err := h.today(ctx, goal, loc)
+	if err != nil {
+		return nil, toConnectError("SetGoal", err)
+	}
+	return connect.NewResponse(&app.SetGoalResponse{Goal: toAppDailyGoal(goal), Today: today}), nil
+}
+
+func (h *GoalHandler) DeleteGoal(ctx context.Context, req *connect.Request[app.DeleteGoalRequest]) (*connect.Response[app.DeleteGoalResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.goals.DeleteGoal(ctx, user.UsersID); err != nil {
+		return nil, toConnectError("DeleteGoal", err)
+	}
+	return connect.NewResponse(&app.DeleteGoalResponse{}), nil
+}
+
+// userLocation returns the time zone the user's days are counted in
+func (h *GoalHandler) userLocation(ctx context.Context, userID string) (*time.Location, error) {
+	settings, err := h.settings.GetSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return progress.Location(settings.TimeZone, h.location), nil
+}
+
+// today returns the user's practice towards the goal since midnight in loc
+func (h *GoalHandler) today(ctx context.Context, goal *models.LearningGoal, loc *time.Location) (*app.GoalProgress, error) {
+	now := time.Now()
+	start := reminder.StartOfDay(now, loc)
+	sessions, err := h.progress.ListPracticeSessions(ctx, goal.UserID, start)
+	if err != nil {
+		return nil, err
+	}
+	totals := progress.Sum(sessions, loc)
+	return &app.GoalProgress{
+		Date:     start.Format(time.DateOnly),
+		Achieved: int32(reminder.Achieved(goal, totals)),
+		Met:      reminder.Met(goal, totals),
+	}, nil
+}
+
+// parseReminderTime converts an HH:MM time of day into the minute of the day
+func parseReminderTime(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid reminder_time %q: want HH:MM", value)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// formatReminderTime converts a minute of the day into HH:MM
+func formatReminderTime(minute int) string {
+	return fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+}
@@ -1,15 +1,158 @@
 package handlers
 
 import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"connectrpc.com/connect"
 	"github.com/hiroky1983/talk/go/gen/app/appv1connect"
+	"github.com/hiroky1983/talk/go/internal/entitlement"
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/notify"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"github.com/hiroky1983/talk/go/internal/retention"
+	"github.com/hiroky1983/talk/go/internal/storage"
+	"github.com/hiroky1983/talk/go/internal/usage"
+	"github.com/hiroky1983/talk/go/middleware"
 )
 
+// Repositories bundles the data access dependencies of the RPC handlers
+type Repositories struct {
+	User            repository.UserRepository
+	Admin           repository.AdminRepository
+	Promo           repository.PromoRepository
+	Conversation    repository.ConversationRepository
+	Settings        repository.SettingsRepository
+	Memory          repository.MemoryRepository
+	Summary         repository.SummaryRepository
+	Vocabulary      repository.VocabularyRepository
+	Mistake         repository.MistakeRepository
+	Progress        repository.ProgressRepository
+	Goal            repository.GoalRepository
+	Notification    repository.NotificationRepository
+	Scenario        repository.ScenarioRepository
+	Character       repository.CharacterRepository
+	CustomCharacter repository.CustomCharacterRepository
+	Proficiency     repository.ProficiencyRepository
+}
+
+// Services bundles the domain services used by the RPC handlers
+type Services struct {
+	Plans     *entitlement.Resolver
+	Usage     *usage.Service
+	Blobs     storage.BlobStore
+	Retention retention.Policies
+	TimeZone  *time.Location // Days are counted in it for users without a time zone of their own
+	Email     notify.Notifier
+}
+
 type APIHandler struct {
-	UserHandler appv1connect.UserServiceHandler
+	UserHandler            appv1connect.UserServiceHandler
+	AdminHandler           appv1connect.AdminServiceHandler
+	UsageHandler           appv1connect.UsageServiceHandler
+	PromoHandler           appv1connect.PromoServiceHandler
+	ConversationHandler    appv1connect.ConversationServiceHandler
+	SettingsHandler        appv1connect.SettingsServiceHandler
+	MemoryHandler          appv1connect.MemoryServiceHandler
+	VocabularyHandler      appv1connect.VocabularyServiceHandler
+	MistakeHandler         appv1connect.MistakeServiceHandler
+	ProgressHandler        appv1connect.ProgressServiceHandler
+	GoalHandler            appv1connect.GoalServiceHandler
+	NotificationHandler    appv1connect.NotificationServiceHandler
+	ScenarioHandler        appv1connect.ScenarioServiceHandler
+	CharacterHandler       appv1connect.CharacterServiceHandler
+	CustomCharacterHandler appv1connect.CustomCharacterServiceHandler
+	ProficiencyHandler     appv1connect.ProficiencyServiceHandler
 }
 
-func NewAPIHandler() *APIHandler {
+func NewAPIHandler(repos Repositories, services Services) *APIHandler {
 	return &APIHandler{
-		UserHandler: NewUserHandler(),
+		UserHandler:            NewUserHandler(repos.User),
+		AdminHandler:           NewAdminHandler(repos.User, repos.Admin, repos.Promo, repos.Character, services.Email),
+		UsageHandler:           NewUsageHandler(repos.User, services.Plans, services.Usage),
+		PromoHandler:           NewPromoHandler(repos.User, repos.Promo, services.Plans),
+		ConversationHandler:    NewConversationHandler(repos.User, repos.Conversation, repos.Summary, services.Blobs),
+		SettingsHandler:        NewSettingsHandler(repos.User, repos.Settings, services.Retention),
+		MemoryHandler:          NewMemoryHandler(repos.User, repos.Memory),
+		VocabularyHandler:      NewVocabularyHandler(repos.User, repos.Vocabulary, repos.Conversation),
+		MistakeHandler:         NewMistakeHandler(repos.User, repos.Mistake, repos.Vocabulary),
+		ProgressHandler:        NewProgressHandler(repos.User, repos.Settings, repos.Progress, services.TimeZone),
+		GoalHandler:            NewGoalHandler(repos.User, repos.Goal, repos.Settings, repos.Progress, services.TimeZone),
+		NotificationHandler:    NewNotificationHandler(repos.User, repos.Notification),
+		ScenarioHandler:        NewScenarioHandler(repos.User, repos.Scenario),
+		CharacterHandler:       NewCharacterHandler(repos.User, repos.Character, services.Plans),
+		CustomCharacterHandler: NewCustomCharacterHandler(repos.User, repos.CustomCharacter, services.Plans),
+		ProficiencyHandler:     NewProficiencyHandler(repos.User, repos.Proficiency),
+	}
+}
+
+// currentUserID returns the authenticated user ID placed in the context by JWTAuthMiddleware
+func currentUserID(ctx context.Context) (string, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return "", connect.NewError(connect.CodeUnauthenticated, errors.New("authentication required"))
+	}
+	return userID, nil
+}
+
+// currentUser loads the authenticated user and rejects disabled accounts
+func currentUser(ctx context.Context, users repository.UserRepository) (*models.User, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := users.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		return nil, toConnectError("currentUser", err)
+	}
+	if user.IsDisabled() {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("account is disabled"))
+	}
+	return user, nil
+}
+
+// toConnectError maps repository errors to Connect error codes.
+// Unexpected errors are logged and returned without internal details.
+func toConnectError(method string, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrUserNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, repository.ErrUserAlreadyExists):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, repository.ErrInvalidResetToken):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, repository.ErrPromoCodeNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, repository.ErrPromoCodeAlreadyRedeemed):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, repository.ErrPromoCodeExpired), errors.Is(err, repository.ErrPromoCodeExhausted):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, repository.ErrConversationNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, repository.ErrMemoryNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, repository.ErrSummaryNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, repository.ErrCardNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, repository.ErrGoalNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, repository.ErrCharacterNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, repository.ErrCharacterAlreadyExists):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, repository.ErrCustomCharacterNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, repository.ErrCustomCharacterLimit):
+		return connect.NewError(connect.CodeResourceExhausted, err)
+	case errors.Is(err, repository.ErrLanguageProfileNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
 	}
+	log.Printf("%s failed: %v", method, err)
+	return connect.NewError(connect.CodeInternal, errors.New("internal error"))
 }
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	app "github.com/hiroky1983/talk/go/gen/app"
+	"github.com/hiroky1983/talk/go/internal/memory"
+	"github.com/hiroky1983/talk/go/internal/repository"
+)
+
+type MemoryHandler struct {
+	users    repository.UserRepository
+	memories repository.MemoryRepository
+}
+
+func NewMemoryHandler(users repository.UserRepository, memories repository.MemoryRepository) *MemoryHandler {
+	return &MemoryHandler{
+		users:    users,
+		memories: memories,
+	}
+}
+
+func (h *MemoryHandler) ListMemories(ctx context.Context, req *connect.Request[app.ListMemoriesRequest]) (*connect.Response[app.ListMemoriesResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	memories, err := h.memories.ListMemories(ctx, user.UsersID, 0)
+	if err != nil {
+		return nil, toConnectError("ListMemories", err)
+	}
+	res := &app.ListMemoriesResponse{Memories: make([]*app.Memory, 0, len(memories))}
+	for i := range memories {
+		res.Memories = append(res.Memories, toAppMemory(&memories[i]))
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (h *MemoryHandler) UpdateMemory(ctx context.Context, req *connect.Request[app.UpdateMemoryRequest]) (*connect.Response[app.UpdateMemoryResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMemoryID(req.Msg.MemoryId); err != nil {
+		return nil, err
+	}
+	content, err := memory.Normalize(req.Msg.Content)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	updated, err := h.memories.UpdateMemory(ctx, user.UsersID, req.Msg.MemoryId, content)
+	if err != nil {
+		return nil, toConnectError("UpdateMemory", err)
+	}
+	return connect.NewResponse(&app.UpdateMemoryResponse{Memory: toAppMemory(updated)}), nil
+}
+
+func (h *MemoryHandler) DeleteMemory(ctx context.Context, req *connect.Request[app.DeleteMemoryRequest]) (*connect.Response[app.DeleteMemoryResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMemoryID(req.Msg.MemoryId); err != nil {
+		return nil, err
+	}
+	if err := h.memories.DeleteMemory(ctx, user.UsersID, req.Msg.MemoryId); err != nil {
+		return nil, toConnectError("DeleteMemory", err)
+	}
+	return connect.NewResponse(&app.DeleteMemoryResponse{}), nil
+}
+
+// validateMemoryID rejects IDs that cannot identify a memory
+func validateMemoryID(memoryID string) error {
+	if _, err := uuid.Parse(memoryID); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("invalid memory_id"))
+	}
+	return nil
+}
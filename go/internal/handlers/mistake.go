@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	app "github.com/hiroky1983/talk/go/gen/app"
+	"github.com/hiroky1983/talk/go/internal/mistake"
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"github.com/hiroky1983/talk/go/internal/vocabulary"
+)
+
+type MistakeHandler struct {
+	users    repository.UserRepository
+	mistakes repository.MistakeRepository
+	cards    repository.VocabularyRepository
+}
+
+func NewMistakeHandler(users repository.UserRepository, mistakes repository.MistakeRepository, cards repository.VocabularyRepository) *MistakeHandler {
+	return &MistakeHandler{
+		users:    users,
+		mistakes: mistakes,
+		cards:    cards,
+	}
+}
+
+// ListMistakePatterns lists the user's recurring mistakes with their latest corrections
+func (h *MistakeHandler) ListMistakePatterns(ctx context.Context, req *connect.Request[app.ListMistakePatternsRequest]) (*connect.Response[app.ListMistakePatternsResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	limit, offset, err := parsePage(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	minCount := int(req.Msg.MinCount)
+	if minCount <= 0 {
+		minCount = mistake.DefaultMinCount
+	}
+	filter := repository.MistakePatternFilter{
+		UserID:        user.UsersID,
+		Language:      req.Msg.Language,
+		MinCount:      minCount,
+		RecentSince:   time.Now().Add(-mistake.RecentWindow),
+		ResolvedAfter: mistake.ResolvedAfterConversations,
+		OnlyActive:    !req.Msg.IncludeResolved,
+		Limit:         limit,
+		Offset:        offset,
+	}
+	if req.Msg.Category != app.FeedbackCategory_FEEDBACK_CATEGORY_UNSPECIFIED {
+		filter.Category = models.FeedbackCategory(req.Msg.Category.String())
+	}
+
+	patterns, err := h.mistakes.ListMistakePatterns(ctx, filter)
+	if err != nil {
+		return nil, toConnectError("ListMistakePatterns", err)
+	}
+	keys := make([]repository.MistakeKey, 0, len(patterns))
+	for _, p := range patterns {
+		keys = append(keys, p.MistakeKey)
+	}
+	examples, err := h.mistakes.ListMistakeExamples(ctx, user.UsersID, keys, mistake.ExamplesPerPattern)
+	if err != nil {
+		return nil, toConnectError("ListMistakePatterns", err)
+	}
+	return connect.NewResponse(&app.ListMistakePatternsResponse{
+		Patterns:      toAppMistakePatterns(patterns, examples),
+		NextPageToken: nextPageToken(limit, offset, len(patterns)),
+	}), nil
+}
+
+// CreateCardFromMistake adds a vocabulary card from the latest correction of a pattern.
+// It fails with AlreadyExists when the user already has a card for the corrected words.
+func (h *MistakeHandler) CreateCardFromMistake(ctx context.Context, req *connect.Request[app.CreateCardFromMistakeRequest]) (*connect.Response[app.CreateCardFromMistakeResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.Language == "" || req.Msg.Pattern == "" || req.Msg.Category == app.FeedbackCategory_FEEDBACK_CATEGORY_UNSPECIFIED {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("language, category and pattern are required"))
+	}
+	key := repository.MistakeKey{
+		Language: req.Msg.Language,
+		Category: models.FeedbackCategory(req.Msg.Category.String()),
+		Pattern:  req.Msg.Pattern,
+	}
+	examples, err := h.mistakes.ListMistakeExamples(ctx, user.UsersID, []repository.MistakeKey{key}, 1)
+	if err != nil {
+		return nil, toConnectError("CreateCardFromMistake", err)
+	}
+	if len(examples) == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("mistake pattern not found"))
+	}
+	latest := examples[0]
+	fields, err := mistake.CardFields(&latest.TurnFeedback).Normalize()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	existing, err := h.cards.ListCards(ctx, user.UsersID, 0, 0)
+	if err != nil {
+		return nil, toConnectError("CreateCardFromMistake", err)
+	}
+	if _, skipped := vocabulary.Dedupe(existing, []vocabulary.Fields{fields}); skipped > 0 {
+		return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("a card for the correction already exists"))
+	}
+
+	card := vocabulary.NewCard(user.UsersID, fields, time.Now())
+	card.SourceTurnID = &latest.ConversationTurnID
+	cards := []models.VocabularyCard{card}
+	if err := h.cards.CreateCards(ctx, cards); err != nil {
+		return nil, toConnectError("CreateCardFromMistake", err)
+	}
+	cards[0].SourceTurn = &models.ConversationTurn{
+		ConversationTurnsID: latest.ConversationTurnID,
+		ConversationID:      latest.ConversationID,
+		Seq:                 latest.Seq,
+	}
+	return connect.NewResponse(&app.CreateCardFromMistakeResponse{Card: toAppVocabularyCard(&cards[0])}), nil
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	app "github.com/hiroky1983/talk/go/gen/app"
+	"github.com/hiroky1983/talk/go/internal/repository"
+)
+
+// maxMarkedNotifications bounds the IDs a single MarkNotificationsRead call accepts
+const maxMarkedNotifications = 200
+
+type NotificationHandler struct {
+	users         repository.UserRepository
+	notifications repository.NotificationRepository
+}
+
+func NewNotificationHandler(users repository.UserRepository, notifications repository.NotificationRepository) *NotificationHandler {
+	return &NotificationHandler{
+		users:         users,
+		notifications: notifications,
+	}
+}
+
+func (h *NotificationHandler) ListNotifications(ctx context.Context, req *connect.Request[app.ListNotificationsRequest]) (*connect.Response[app.ListNotificationsResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	limit, offset, err := parsePage(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	notifications, err := h.notifications.ListNotifications(ctx, user.UsersID, req.Msg.UnreadOnly, limit, offset)
+	if err != nil {
+		return nil, toConnectError("ListNotifications", err)
+	}
+	unread, err := h.notifications.CountUnreadNotifications(ctx, user.UsersID)
+	if err != nil {
+		return nil, toConnectError("ListNotifications", err)
+	}
+
+	res := &app.ListNotificationsResponse{
+		Notifications: make([]*app.Notification, 0, len(notifications)),
+		NextPageToken: nextPageToken(limit, offset, len(notifications)),
+		UnreadCount:   unread,
+	}
+	for i := range notifications {
+		res.Notifications = append(res.Notifications, toAppNotification(&notifications[i]))
+	}
+	return connect.NewResponse(res), nil
+}
+
+// MarkNotificationsRead marks the given notifications, or all unread ones when none are given, as read
+func (h *NotificationHandler) MarkNotificationsRead(ctx context.Context, req *connect.Request[app.MarkNotificationsReadRequest]) (*connect.Response[app.MarkNotificationsReadResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Msg.Ids) > maxMarkedNotifications {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("too many ids"))
+	}
+	for _, id := range req.Msg.Ids {
+		if _, err := uuid.Parse(id); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid id"))
+		}
+	}
+	marked, err := h.notifications.MarkNotificationsRead(ctx, user.UsersID, req.Msg.Ids, time.Now())
+	if err != nil {
+		return nil, toConnectError("MarkNotificationsRead", err)
+	}
+	return connect.NewResponse(&app.MarkNotificationsReadResponse{Marked: marked}), nil
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+)
+
+// parsePage converts a page size and an opaque offset page token into limit and offset
+func parsePage(pageSize int32, pageToken string) (int, int, error) {
+	limit := int(pageSize)
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	offset := 0
+	if pageToken != "" {
+		parsed, err := strconv.Atoi(pageToken)
+		if err != nil || parsed < 0 {
+			return 0, 0, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid page_token"))
+		}
+		offset = parsed
+	}
+	return limit, offset, nil
+}
+
+// nextPageToken returns the token for the page after the current one, or "" on the last page
+func nextPageToken(limit, offset, returned int) string {
+	if returned < limit {
+		return ""
+	}
+	return strconv.Itoa(offset + returned)
+}
+
+// parseConversationCursor decodes the page token of a conversation listing, returning nil for the first page
+func parseConversationCursor(pageToken string) (*repository.ConversationCursor, error) {
+	if pageToken == "" {
+		return nil, nil
+	}
+	invalid := connect.NewError(connect.CodeInvalidArgument, errors.New("invalid page_token"))
+	decoded, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return nil, invalid
+	}
+	startedAt, conversationID, ok := strings.Cut(string(decoded), "|")
+	if !ok {
+		return nil, invalid
+	}
+	t, err := time.Parse(time.RFC3339Nano, startedAt)
+	if err != nil {
+		return nil, invalid
+	}
+	if _, err := uuid.Parse(conversationID); err != nil {
+		return nil, invalid
+	}
+	return &repository.ConversationCursor{StartedAt: t, ConversationID: conversationID}, nil
+}
+
+// nextConversationPageToken returns the token for the page after the last conversation returned, or "" on the last page
+func nextConversationPageToken(limit int, conversations []models.Conversation) string {
+	if len(conversations) < limit {
+		return ""
+	}
+	last := conversations[len(conversations)-1]
+	cursor := last.StartedAt.UTC().Format(time.RFC3339Nano) + "|" + last.ConversationsID
+	return base64.RawURLEncoding.EncodeToString([]byte(cursor))
+}
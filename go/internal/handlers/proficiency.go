@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	app "github.com/hiroky1983/talk/go/gen/app"
+	"github.com/hiroky1983/talk/go/internal/placement"
+	"github.com/hiroky1983/talk/go/internal/repository"
+)
+
+type ProficiencyHandler struct {
+	users       repository.UserRepository
+	proficiency repository.ProficiencyRepository
+}
+
+func NewProficiencyHandler(users repository.UserRepository, proficiency repository.ProficiencyRepository) *ProficiencyHandler {
+	return &ProficiencyHandler{
+		users:       users,
+		proficiency: proficiency,
+	}
+}
+
+// ListLanguageProfiles returns the user's level in each language they have one in
+func (h *ProficiencyHandler) ListLanguageProfiles(ctx context.Context, req *connect.Request[app.ListLanguageProfilesRequest]) (*connect.Response[app.ListLanguageProfilesResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	profiles, err := h.proficiency.ListLanguageProfiles(ctx, user.UsersID)
+	if err != nil {
+		return nil, toConnectError("ListLanguageProfiles", err)
+	}
+	res := &app.ListLanguageProfilesResponse{
+		Profiles:           make([]*app.LanguageProfile, 0, len(profiles)),
+		PlacementLanguages: placement.Languages(),
+	}
+	for i := range profiles {
+		res.Profiles = append(res.Profiles, toAppLanguageProfile(&profiles[i]))
+	}
+	return connect.NewResponse(res), nil
+}
+
+// ListLevelChanges returns the user's level changes, newest first, with the evidence behind each
+func (h *ProficiencyHandler) ListLevelChanges(ctx context.Context, req *connect.Request[app.ListLevelChangesRequest]) (*connect.Response[app.ListLevelChangesResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	limit, offset, err := parsePage(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	changes, err := h.proficiency.ListLevelChanges(ctx, user.UsersID, req.Msg.Language, limit, offset)
+	if err != nil {
+		return nil, toConnectError("ListLevelChanges", err)
+	}
+	res := &app.ListLevelChangesResponse{
+		Changes:       make([]*app.LevelChange, 0, len(changes)),
+		NextPageToken: nextPageToken(limit, offset, len(changes)),
+	}
+	for i := range changes {
+		res.Changes = append(res.Changes, toAppLevelChange(&changes[i]))
+	}
+	return connect.NewResponse(res), nil
+}
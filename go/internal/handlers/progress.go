@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	app "github.com/hiroky1983/talk/go/gen/app"
+	"github.com/hiroky1983/talk/go/internal/progress"
+	"github.com/hiroky1983/talk/go/internal/repository"
+)
+
+type ProgressHandler struct {
+	users    repository.UserRepository
+	settings repository.SettingsRepository
+	progress repository.ProgressRepository
+	location *time.Location
+}
+
+// NewProgressHandler creates a progress handler counting days in loc for users without a time zone
+func NewProgressHandler(users repository.UserRepository, settings repository.SettingsRepository, progress repository.ProgressRepository, loc *time.Location) *ProgressHandler {
+	return &ProgressHandler{
+		users:    users,
+		settings: settings,
+		progress: progress,
+		location: loc,
+	}
+}
+
+// GetProgress returns the user's practice totals, streaks and charts in their time zone
+func (h *ProgressHandler) GetProgress(ctx context.Context, req *connect.Request[app.GetProgressRequest]) (*connect.Response[app.GetProgressResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := h.settings.GetSettings(ctx, user.UsersID)
+	if err != nil {
+		return nil, toConnectError("GetProgress", err)
+	}
+	sessions, err := h.progress.ListPracticeSessions(ctx, user.UsersID, time.Time{})
+	if err != nil {
+		return nil, toConnectError("GetProgress", err)
+	}
+
+	loc := progress.Location(settings.TimeZone, h.location)
+	return connect.NewResponse(toAppProgress(progress.Compute(sessions, loc, time.Now()), loc)), nil
+}
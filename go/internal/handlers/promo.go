@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"connectrpc.com/connect"
+	app "github.com/hiroky1983/talk/go/gen/app"
+	"github.com/hiroky1983/talk/go/internal/entitlement"
+	"github.com/hiroky1983/talk/go/internal/repository"
+)
+
+type PromoHandler struct {
+	users  repository.UserRepository
+	promos repository.PromoRepository
+	plans  *entitlement.Resolver
+}
+
+func NewPromoHandler(users repository.UserRepository, promos repository.PromoRepository, plans *entitlement.Resolver) *PromoHandler {
+	return &PromoHandler{
+		users:  users,
+		promos: promos,
+		plans:  plans,
+	}
+}
+
+func (h *PromoHandler) RedeemCode(ctx context.Context, req *connect.Request[app.RedeemCodeRequest]) (*connect.Response[app.RedeemCodeResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	code := entitlement.NormalizePromoCode(req.Msg.Code)
+	if code == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("code is required"))
+	}
+
+	// Do not let users burn a code that would not change anything for them
+	promo, err := h.promos.GetPromoCode(ctx, code)
+	if err != nil {
+		return nil, toConnectError("RedeemCode", err)
+	}
+	current, err := h.plans.Refresh(ctx, user.UsersID)
+	if err != nil {
+		return nil, toConnectError("RedeemCode", err)
+	}
+	if !entitlement.IsUpgrade(current, promo.Plan) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("your %s plan already includes %s", current, promo.Plan))
+	}
+
+	grant, err := h.promos.RedeemCode(ctx, user.UsersID, code, time.Now())
+	if err != nil {
+		return nil, toConnectError("RedeemCode", err)
+	}
+	plan, err := h.plans.Refresh(ctx, user.UsersID)
+	if err != nil {
+		return nil, toConnectError("RedeemCode", err)
+	}
+	log.Printf("User %s redeemed promo code %s for %s until %s", user.UsersID, code, grant.Plan, grant.EndsAt.Format(time.RFC3339))
+
+	return connect.NewResponse(&app.RedeemCodeResponse{
+		Plan:        toAppPlan(plan),
+		GrantedPlan: toAppPlan(grant.Plan),
+		GrantEndsAt: toTimestamp(&grant.EndsAt),
+	}), nil
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	app "github.com/hiroky1983/talk/go/gen/app"
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+)
+
+type ScenarioHandler struct {
+	users     repository.UserRepository
+	scenarios repository.ScenarioRepository
+}
+
+func NewScenarioHandler(users repository.UserRepository, scenarios repository.ScenarioRepository) *ScenarioHandler {
+	return &ScenarioHandler{
+		users:     users,
+		scenarios: scenarios,
+	}
+}
+
+// ListScenarios returns the scenarios offered in a practice language with the user's progress in each
+func (h *ScenarioHandler) ListScenarios(ctx context.Context, req *connect.Request[app.ListScenariosRequest]) (*connect.Response[app.ListScenariosResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.Language == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("language is required"))
+	}
+	var difficulty models.ScenarioDifficulty
+	if req.Msg.Difficulty != app.ScenarioDifficulty_SCENARIO_DIFFICULTY_UNSPECIFIED {
+		difficulty = models.ScenarioDifficulty(req.Msg.Difficulty.String())
+	}
+
+	scenarios, err := h.scenarios.ListScenarios(ctx, req.Msg.Language, difficulty)
+	if err != nil {
+		return nil, toConnectError("ListScenarios", err)
+	}
+	stats, err := h.scenarios.ListScenarioStats(ctx, user.UsersID)
+	if err != nil {
+		return nil, toConnectError("ListScenarios", err)
+	}
+	byScenario := make(map[string]*repository.ScenarioStats, len(stats))
+	for i := range stats {
+		byScenario[stats[i].ScenarioID] = &stats[i]
+	}
+
+	res := &app.ListScenariosResponse{Scenarios: make([]*app.Scenario, 0, len(scenarios))}
+	for i := range scenarios {
+		scenario, err := toAppScenario(&scenarios[i], byScenario[scenarios[i].ScenariosID])
+		if err != nil {
+			return nil, toConnectError("ListScenarios", err)
+		}
+		res.Scenarios = append(res.Scenarios, scenario)
+	}
+	return connect.NewResponse(res), nil
+}
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	app "github.com/hiroky1983/talk/go/gen/app"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"github.com/hiroky1983/talk/go/internal/retention"
+)
+
+// maxTimeZoneLength is the longest time zone name stored with the settings
+const maxTimeZoneLength = 64
+
+type SettingsHandler struct {
+	users     repository.UserRepository
+	settings  repository.SettingsRepository
+	retention retention.Policies
+}
+
+func NewSettingsHandler(users repository.UserRepository, settings repository.SettingsRepository, policies retention.Policies) *SettingsHandler {
+	return &SettingsHandler{
+		users:     users,
+		settings:  settings,
+		retention: policies,
+	}
+}
+
+func (h *SettingsHandler) GetSettings(ctx context.Context, req *connect.Request[app.GetSettingsRequest]) (*connect.Response[app.GetSettingsResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := h.settings.GetSettings(ctx, user.UsersID)
+	if err != nil {
+		return nil, toConnectError("GetSettings", err)
+	}
+	return connect.NewResponse(&app.GetSettingsResponse{
+		Settings:  toAppUserSettings(settings),
+		Retention: toAppRetention(h.retention.Effective(user.Plan, settings)),
+	}), nil
+}
+
+func (h *SettingsHandler) UpdateSettings(ctx context.Context, req *connect.Request[app.UpdateSettingsRequest]) (*connect.Response[app.UpdateSettingsResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.Settings == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("settings is required"))
+	}
+	if err := validateRetentionDays("audio_retention_days", req.Msg.Settings.AudioRetentionDays); err != nil {
+		return nil, err
+	}
+	if err := validateRetentionDays("transcript_retention_days", req.Msg.Settings.TranscriptRetentionDays); err != nil {
+		return nil, err
+	}
+	if err := validateTimeZone(req.Msg.Settings.TimeZone); err != nil {
+		return nil, err
+	}
+
+	settings, err := h.settings.GetSettings(ctx, user.UsersID)
+	if err != nil {
+		return nil, toConnectError("UpdateSettings", err)
+	}
+	settings.AudioOptOut = req.Msg.Settings.AudioOptOut
+	settings.AudioRetentionDays = int(req.Msg.Settings.AudioRetentionDays)
+	settings.TranscriptRetentionDays = int(req.Msg.Settings.TranscriptRetentionDays)
+	settings.PrivacyMode = req.Msg.Settings.PrivacyMode
+	settings.TimeZone = req.Msg.Settings.TimeZone
+	if err := h.settings.SaveSettings(ctx, settings); err != nil {
+		return nil, toConnectError("UpdateSettings", err)
+	}
+	return connect.NewResponse(&app.UpdateSettingsResponse{
+		Settings:  toAppUserSettings(settings),
+		Retention: toAppRetention(h.retention.Effective(user.Plan, settings)),
+	}), nil
+}
+
+// validateRetentionDays rejects retentions users cannot set for themselves
+func validateRetentionDays(name string, days int32) error {
+	if days < 0 || days > retention.MaxDays {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s must be between 0 and %d", name, retention.MaxDays))
+	}
+	return nil
+}
+
+// validateTimeZone rejects names that are not IANA time zones; empty uses the service's time zone
+func validateTimeZone(name string) error {
+	if name == "" {
+		return nil
+	}
+	if _, err := time.LoadLocation(name); err != nil || name == "Local" || len(name) > maxTimeZoneLength {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown time_zone %q", name))
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	app "github.com/hiroky1983/talk/go/gen/app"
+	"github.com/hiroky1983/talk/go/internal/entitlement"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"github.com/hiroky1983/talk/go/internal/usage"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type UsageHandler struct {
+	users repository.UserRepository
+	plans *entitlement.Resolver
+	usage *usage.Service
+}
+
+func NewUsageHandler(users repository.UserRepository, plans *entitlement.Resolver, usage *usage.Service) *UsageHandler {
+	return &UsageHandler{
+		users: users,
+		plans: plans,
+		usage: usage,
+	}
+}
+
+func (h *UsageHandler) GetUsage(ctx context.Context, req *connect.Request[app.GetUsageRequest]) (*connect.Response[app.GetUsageResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := h.plans.Refresh(ctx, user.UsersID)
+	if err != nil {
+		return nil, toConnectError("GetUsage", err)
+	}
+	status, err := h.usage.GetStatus(ctx, user.UsersID, plan)
+	if err != nil {
+		return nil, toConnectError("GetUsage", err)
+	}
+
+	return connect.NewResponse(&app.GetUsageResponse{
+		Plan:             toAppPlan(status.Plan),
+		Daily:            toUsageAllowance(status.Daily),
+		Monthly:          toUsageAllowance(status.Monthly),
+		UserAudioSeconds: seconds(status.Month.UserAudio),
+		AiAudioSeconds:   seconds(status.Month.AIAudio),
+	}), nil
+}
+
+func toUsageAllowance(allowance usage.Allowance) *app.UsageAllowance {
+	return &app.UsageAllowance{
+		UsedSeconds:      seconds(allowance.Used),
+		LimitSeconds:     seconds(allowance.Limit),
+		RemainingSeconds: seconds(allowance.Remaining()),
+		Unlimited:        allowance.Unlimited(),
+		ResetsAt:         timestamppb.New(allowance.ResetsAt),
+	}
+}
+
+func seconds(d time.Duration) int64 {
+	return int64(d / time.Second)
+}
@@ -2,16 +2,30 @@ package handlers
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
+	"unicode/utf8"
 
 	"connectrpc.com/connect"
 	app "github.com/hiroky1983/talk/go/gen/app"
+	"github.com/hiroky1983/talk/go/internal/auth"
+	"github.com/hiroky1983/talk/go/internal/repository"
 )
 
-type UserHandler struct{}
+const (
+	// minPasswordLength is the shortest password ResetPassword accepts, in characters
+	minPasswordLength = 8
+	// maxPasswordBytes is the longest password bcrypt hashes
+	maxPasswordBytes = 72
+)
+
+type UserHandler struct {
+	users repository.UserRepository
+}
 
-func NewUserHandler() *UserHandler {
-	return &UserHandler{}
+func NewUserHandler(users repository.UserRepository) *UserHandler {
+	return &UserHandler{users: users}
 }
 
 func (h *UserHandler) CreateUser(ctx context.Context, req *connect.Request[app.User]) (*connect.Response[app.User], error) {
@@ -27,3 +41,20 @@ func (h *UserHandler) GetUser(ctx context.Context, req *connect.Request[app.GetU
 		UserId: req.Msg.UserId,
 	}), nil
 }
+
+// ResetPassword redeems a token emailed by AdminService.TriggerPasswordReset. It needs no
+// authentication: the token proves the caller received the email.
+func (h *UserHandler) ResetPassword(ctx context.Context, req *connect.Request[app.ResetPasswordRequest]) (*connect.Response[app.ResetPasswordResponse], error) {
+	if req.Msg.ResetToken == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("reset_token is required"))
+	}
+	if utf8.RuneCountInString(req.Msg.NewPassword) < minPasswordLength || len(req.Msg.NewPassword) > maxPasswordBytes {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("new_password must be %d characters to %d bytes long", minPasswordLength, maxPasswordBytes))
+	}
+
+	if err := h.users.ResetPassword(ctx, auth.HashResetToken(req.Msg.ResetToken), req.Msg.NewPassword); err != nil {
+		return nil, toConnectError("ResetPassword", err)
+	}
+	return connect.NewResponse(&app.ResetPasswordResponse{}), nil
+}
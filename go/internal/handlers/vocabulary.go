@@ -0,0 +1,244 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	app "github.com/hiroky1983/talk/go/gen/app"
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"github.com/hiroky1983/talk/go/internal/vocabulary"
+)
+
+const (
+	defaultDueLimit = 20
+	maxDueLimit     = 100
+)
+
+type VocabularyHandler struct {
+	users         repository.UserRepository
+	cards         repository.VocabularyRepository
+	conversations repository.ConversationRepository
+}
+
+func NewVocabularyHandler(users repository.UserRepository, cards repository.VocabularyRepository, conversations repository.ConversationRepository) *VocabularyHandler {
+	return &VocabularyHandler{
+		users:         users,
+		cards:         cards,
+		conversations: conversations,
+	}
+}
+
+func (h *VocabularyHandler) ListCards(ctx context.Context, req *connect.Request[app.ListCardsRequest]) (*connect.Response[app.ListCardsResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	limit, offset, err := parsePage(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	cards, err := h.cards.ListCards(ctx, user.UsersID, limit, offset)
+	if err != nil {
+		return nil, toConnectError("ListCards", err)
+	}
+	return connect.NewResponse(&app.ListCardsResponse{
+		Cards:         toAppVocabularyCards(cards),
+		NextPageToken: nextPageToken(limit, offset, len(cards)),
+	}), nil
+}
+
+// CreateCard adds a card, optionally from a turn of the user's conversation.
+// A card added from a turn without an example takes the turn's text as its example.
+func (h *VocabularyHandler) CreateCard(ctx context.Context, req *connect.Request[app.CreateCardRequest]) (*connect.Response[app.CreateCardResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := vocabulary.Fields{
+		Term:        req.Msg.Term,
+		Reading:     req.Msg.Reading,
+		Translation: req.Msg.Translation,
+		Example:     req.Msg.Example,
+	}.Normalize()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var turn *models.ConversationTurn
+	if req.Msg.ConversationId != "" {
+		if err := validateConversationID(req.Msg.ConversationId); err != nil {
+			return nil, err
+		}
+		if req.Msg.Seq <= 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("seq is required with conversation_id"))
+		}
+		turn, err = h.conversations.GetTurn(ctx, user.UsersID, req.Msg.ConversationId, int(req.Msg.Seq))
+		if err != nil {
+			return nil, toConnectError("CreateCard", err)
+		}
+		if fields.Example == "" {
+			fields.Example = vocabulary.ExampleFromTurn(fields.Term, turn)
+		}
+	}
+
+	card := vocabulary.NewCard(user.UsersID, fields, time.Now())
+	if turn != nil {
+		card.SourceTurnID = &turn.ConversationTurnsID
+	}
+	cards := []models.VocabularyCard{card}
+	if err := h.cards.CreateCards(ctx, cards); err != nil {
+		return nil, toConnectError("CreateCard", err)
+	}
+	cards[0].SourceTurn = turn
+	return connect.NewResponse(&app.CreateCardResponse{Card: toAppVocabularyCard(&cards[0])}), nil
+}
+
+func (h *VocabularyHandler) UpdateCard(ctx context.Context, req *connect.Request[app.UpdateCardRequest]) (*connect.Response[app.UpdateCardResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCardID(req.Msg.CardId); err != nil {
+		return nil, err
+	}
+	fields, err := vocabulary.Fields{
+		Term:        req.Msg.Term,
+		Reading:     req.Msg.Reading,
+		Translation: req.Msg.Translation,
+		Example:     req.Msg.Example,
+	}.Normalize()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	card, err := h.cards.GetCard(ctx, user.UsersID, req.Msg.CardId)
+	if err != nil {
+		return nil, toConnectError("UpdateCard", err)
+	}
+	card.Term, card.Reading, card.Translation, card.Example = fields.Term, fields.Reading, fields.Translation, fields.Example
+	if err := h.cards.UpdateCard(ctx, card); err != nil {
+		return nil, toConnectError("UpdateCard", err)
+	}
+	return connect.NewResponse(&app.UpdateCardResponse{Card: toAppVocabularyCard(card)}), nil
+}
+
+func (h *VocabularyHandler) DeleteCard(ctx context.Context, req *connect.Request[app.DeleteCardRequest]) (*connect.Response[app.DeleteCardResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCardID(req.Msg.CardId); err != nil {
+		return nil, err
+	}
+	if err := h.cards.DeleteCard(ctx, user.UsersID, req.Msg.CardId); err != nil {
+		return nil, toConnectError("DeleteCard", err)
+	}
+	return connect.NewResponse(&app.DeleteCardResponse{}), nil
+}
+
+func (h *VocabularyHandler) GetDueCards(ctx context.Context, req *connect.Request[app.GetDueCardsRequest]) (*connect.Response[app.GetDueCardsResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = defaultDueLimit
+	}
+	limit = min(limit, maxDueLimit)
+
+	now := time.Now()
+	cards, err := h.cards.ListDueCards(ctx, user.UsersID, now, limit)
+	if err != nil {
+		return nil, toConnectError("GetDueCards", err)
+	}
+	count, err := h.cards.CountDueCards(ctx, user.UsersID, now)
+	if err != nil {
+		return nil, toConnectError("GetDueCards", err)
+	}
+	return connect.NewResponse(&app.GetDueCardsResponse{
+		Cards:    toAppVocabularyCards(cards),
+		DueCount: int32(count),
+	}), nil
+}
+
+// ReviewCard records how well the user recalled a card and schedules its next review
+func (h *VocabularyHandler) ReviewCard(ctx context.Context, req *connect.Request[app.ReviewCardRequest]) (*connect.Response[app.ReviewCardResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCardID(req.Msg.CardId); err != nil {
+		return nil, err
+	}
+	grade := vocabulary.Grade(req.Msg.Grade)
+	if !grade.Valid() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("grade must be between %d and %d", vocabulary.MinGrade, vocabulary.MaxGrade))
+	}
+
+	card, err := h.cards.GetCard(ctx, user.UsersID, req.Msg.CardId)
+	if err != nil {
+		return nil, toConnectError("ReviewCard", err)
+	}
+	vocabulary.Review(card, grade, time.Now())
+	if err := h.cards.UpdateCard(ctx, card); err != nil {
+		return nil, toConnectError("ReviewCard", err)
+	}
+	return connect.NewResponse(&app.ReviewCardResponse{Card: toAppVocabularyCard(card)}), nil
+}
+
+// ImportCards adds the cards of a CSV or Anki file, skipping terms the user already has
+func (h *VocabularyHandler) ImportCards(ctx context.Context, req *connect.Request[app.ImportCardsRequest]) (*connect.Response[app.ImportCardsResponse], error) {
+	user, err := currentUser(ctx, h.users)
+	if err != nil {
+		return nil, err
+	}
+	var format vocabulary.Format
+	switch req.Msg.Format {
+	case app.VocabularyFileFormat_VOCABULARY_FILE_FORMAT_CSV:
+		format = vocabulary.FormatCSV
+	case app.VocabularyFileFormat_VOCABULARY_FILE_FORMAT_ANKI:
+		format = vocabulary.FormatAnki
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("format is required"))
+	}
+	if len(req.Msg.Data) > vocabulary.MaxImportSize {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("data must be at most %d bytes", vocabulary.MaxImportSize))
+	}
+	fields, err := vocabulary.Read(bytes.NewReader(req.Msg.Data), format)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	existing, err := h.cards.ListCards(ctx, user.UsersID, 0, 0)
+	if err != nil {
+		return nil, toConnectError("ImportCards", err)
+	}
+	fresh, skipped := vocabulary.Dedupe(existing, fields)
+	now := time.Now()
+	cards := make([]models.VocabularyCard, 0, len(fresh))
+	for _, f := range fresh {
+		cards = append(cards, vocabulary.NewCard(user.UsersID, f, now))
+	}
+	if err := h.cards.CreateCards(ctx, cards); err != nil {
+		return nil, toConnectError("ImportCards", err)
+	}
+	return connect.NewResponse(&app.ImportCardsResponse{
+		Imported: int32(len(cards)),
+		Skipped:  int32(skipped),
+	}), nil
+}
+
+// validateCardID rejects IDs that cannot identify a vocabulary card
+func validateCardID(cardID string) error {
+	if _, err := uuid.Parse(cardID); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("invalid card_id"))
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+// Package memory validates the short facts about learners that characters remember
+// between conversations.
+package memory
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+const (
+	// MaxLength is the longest memory in characters
+	MaxLength = 200
+	// MaxPerUser is how many memories a user keeps; further proposals from the AI are ignored
+	MaxPerUser = 100
+	// PromptLimit is how many memories are sent to the AI service at the start of a conversation
+	PromptLimit = 20
+)
+
+var (
+	// ErrEmpty is returned for a memory without text
+	ErrEmpty = errors.New("memory is empty")
+	// ErrTooLong is returned for a memory longer than MaxLength
+	ErrTooLong = errors.New("memory is too long")
+)
+
+// Normalize trims content and collapses its whitespace
+func Normalize(content string) (string, error) {
+	content = strings.Join(strings.Fields(content), " ")
+	if content == "" {
+		return "", ErrEmpty
+	}
+	if utf8.RuneCountInString(content) > MaxLength {
+		return "", ErrTooLong
+	}
+	return content, nil
+}
+
+// Contains reports whether memories already hold content, ignoring case
+func Contains(memories []models.UserMemory, content string) bool {
+	for _, m := range memories {
+		if strings.EqualFold(m.Content, content) {
+			return true
+		}
+	}
+	return false
+}
+
+// Contents returns the text of memories in order
+func Contents(memories []models.UserMemory) []string {
+	contents := make([]string, len(memories))
+	for i, m := range memories {
+		contents[i] = m.Content
+	}
+	return contents
+}
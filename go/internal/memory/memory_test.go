@@ -0,0 +1,37 @@
+package memory
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+		wantErr error
+	}{
+		{name: "trims and collapses whitespace", content: "  has a dog\n named   Pochi ", want: "has a dog named Pochi"},
+		{name: "empty", content: " \t\n", wantErr: ErrEmpty},
+		{name: "max length in characters", content: strings.Repeat("犬", MaxLength), want: strings.Repeat("犬", MaxLength)},
+		{name: "too long", content: strings.Repeat("a", MaxLength+1), wantErr: ErrTooLong},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Normalize(tt.content)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestContains_IgnoresCase(t *testing.T) {
+	memories := []models.UserMemory{{Content: "Works as a nurse"}}
+
+	assert.True(t, Contains(memories, "works as a NURSE"))
+	assert.False(t, Contains(memories, "works as a doctor"))
+}
@@ -0,0 +1,135 @@
+// Package mistake groups the corrections learners receive into recurring mistake patterns.
+//
+// A pattern is the part of the learner's words a correction changed, so "私は猫が好き"
+// corrected to "私が猫が好き" and "彼は来た" corrected to "彼が来た" are both "は → が".
+// Patterns are computed when feedback is stored and grouped per language and category.
+package mistake
+
+import (
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/vocabulary"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// DefaultMinCount is how many corrections make a pattern recurring unless a listing asks otherwise
+	DefaultMinCount = 2
+	// ResolvedAfterConversations is how many later conversations in the same language
+	// without the mistake mark a pattern as resolved
+	ResolvedAfterConversations = 5
+	// RecentWindow is the period the recent count of a pattern covers
+	RecentWindow = 30 * 24 * time.Hour
+	// ExamplesPerPattern is how many of the latest corrections are shown with a pattern
+	ExamplesPerPattern = 3
+)
+
+// emptySide stands for words a correction removed or added
+const emptySide = "∅"
+
+// Resolved reports whether a mistake has stopped appearing, given how many conversations
+// the learner had in its language since it last occurred
+func Resolved(conversationsSince int) bool {
+	return conversationsSince >= ResolvedAfterConversations
+}
+
+// Pattern returns the normalized change a correction makes, e.g. "は → が" or "là → ∅".
+// Words both texts share at their start and end are dropped; when the texts only differ
+// in case, spacing or punctuation, as with pronunciation feedback, the correction itself is the pattern.
+// It returns "" when both texts are empty.
+func Pattern(original, correction string) string {
+	from, to := tokens(original), tokens(correction)
+	prefix := 0
+	for prefix < len(from) && prefix < len(to) && from[prefix] == to[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(from)-prefix && suffix < len(to)-prefix && from[len(from)-1-suffix] == to[len(to)-1-suffix] {
+		suffix++
+	}
+	removed, added := from[prefix:len(from)-suffix], to[prefix:len(to)-suffix]
+	if len(removed) == 0 && len(added) == 0 {
+		return join(to)
+	}
+	return side(removed) + " → " + side(added)
+}
+
+// side renders one side of a change, using emptySide for nothing
+func side(tokens []string) string {
+	if len(tokens) == 0 {
+		return emptySide
+	}
+	return join(tokens)
+}
+
+// tokens splits text into lower-case words. Japanese and Chinese characters are tokens of their own,
+// since the text has no spaces between words; punctuation only separates tokens.
+func tokens(text string) []string {
+	var tokens []string
+	var word strings.Builder
+	flush := func() {
+		if word.Len() > 0 {
+			tokens = append(tokens, word.String())
+			word.Reset()
+		}
+	}
+	for _, r := range norm.NFKC.String(text) {
+		switch {
+		case isCJK(r):
+			flush()
+			tokens = append(tokens, string(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.M, r):
+			word.WriteRune(unicode.ToLower(r))
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
+
+// join joins tokens with spaces, except between Japanese or Chinese characters
+func join(tokens []string) string {
+	var b strings.Builder
+	for i, t := range tokens {
+		if i > 0 && !(isCJKToken(tokens[i-1]) && isCJKToken(t)) {
+			b.WriteByte(' ')
+		}
+		b.WriteString(t)
+	}
+	return b.String()
+}
+
+// isCJK reports whether r belongs to a script written without spaces between words
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana) || r == 'ー'
+}
+
+func isCJKToken(token string) bool {
+	for _, r := range token {
+		return isCJK(r)
+	}
+	return false
+}
+
+// CardFields returns the content of a review card for a correction: the correction is the term,
+// the explanation its translation and what the learner said the example, cut to the card limits.
+func CardFields(feedback *models.TurnFeedback) vocabulary.Fields {
+	return vocabulary.Fields{
+		Term:        truncate(strings.TrimSpace(feedback.Correction), vocabulary.MaxTermLength),
+		Translation: truncate(strings.TrimSpace(feedback.Explanation), vocabulary.MaxFieldLength),
+		Example:     truncate(strings.TrimSpace(feedback.Original), vocabulary.MaxFieldLength),
+	}
+}
+
+// truncate cuts s to at most n characters
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) > n {
+		return string([]rune(s)[:n])
+	}
+	return s
+}
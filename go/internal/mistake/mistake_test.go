@@ -0,0 +1,52 @@
+package mistake
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/vocabulary"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPattern(t *testing.T) {
+	tests := []struct {
+		name       string
+		original   string
+		correction string
+		want       string
+	}{
+		{name: "japanese particle", original: "私は猫が好きです。", correction: "私が猫が好きです。", want: "は → が"},
+		{name: "same pattern in another sentence", original: "彼は来た", correction: "彼が来た", want: "は → が"},
+		{name: "removed word", original: "Tôi là đói", correction: "tôi đói", want: "là → ∅"},
+		{name: "added words", original: "I go school", correction: "I go to the school", want: "∅ → to the"},
+		{name: "tone marks are kept", original: "cam on ban", correction: "cảm ơn bạn", want: "cam on ban → cảm ơn bạn"},
+		{name: "decomposed vietnamese matches composed", original: "co\u0301 le\u0303", correction: "c\u00f3 l\u1ebd", want: "c\u00f3 l\u1ebd"},
+		{name: "only punctuation differs", original: "thank you", correction: "Thank you!", want: "thank you"},
+		{name: "katakana run", original: "コーヒーを飲む", correction: "コーヒーが飲む", want: "を → が"},
+		{name: "empty", original: "", correction: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Pattern(tt.original, tt.correction))
+		})
+	}
+}
+
+func TestResolved(t *testing.T) {
+	assert.False(t, Resolved(ResolvedAfterConversations-1))
+	assert.True(t, Resolved(ResolvedAfterConversations))
+}
+
+func TestCardFields(t *testing.T) {
+	fields := CardFields(&models.TurnFeedback{
+		Original:    " I goed home ",
+		Correction:  "I went home",
+		Explanation: "go の過去形は went",
+	})
+	assert.Equal(t, vocabulary.Fields{Term: "I went home", Translation: "go の過去形は went", Example: "I goed home"}, fields)
+
+	long := CardFields(&models.TurnFeedback{Correction: strings.Repeat("あ", vocabulary.MaxTermLength+1)})
+	assert.Equal(t, vocabulary.MaxTermLength, utf8.RuneCountInString(long.Term))
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+)
+
+// AuditLog records an administrative action taken against a user account or the catalog.
+// Rows are never updated or deleted and intentionally have no foreign keys
+// so the trail survives user deletion.
+type AuditLog struct {
+	AuditLogsID  string      `json:"id" gorm:"primaryKey;type:uuid;column:audit_logs_id;default:gen_random_uuid()"`
+	ActorUserID  *string     `json:"actor_user_id" gorm:"type:uuid;index"`
+	TargetUserID *string     `json:"target_user_id" gorm:"type:uuid;index"`
+	Action       AuditAction `json:"action" gorm:"not null;type:varchar(50)"`
+	Details      string      `json:"details" gorm:"not null;type:text;default:'{}'"`
+	CreatedAt    time.Time   `json:"created_at" gorm:"autoCreateTime;index"`
+}
+
+type AuditAction string
+
+const (
+	AuditActionPlanChanged            AuditAction = "USER_PLAN_CHANGED"
+	AuditActionUserDisabled           AuditAction = "USER_DISABLED"
+	AuditActionUserEnabled            AuditAction = "USER_ENABLED"
+	AuditActionRoleChanged            AuditAction = "USER_ROLE_CHANGED"
+	AuditActionForceLogout            AuditAction = "USER_FORCE_LOGOUT"
+	AuditActionPasswordResetTriggered AuditAction = "USER_PASSWORD_RESET_TRIGGERED"
+	AuditActionPasswordResetCompleted AuditAction = "USER_PASSWORD_RESET_COMPLETED"
+	AuditActionPromoCodesMinted       AuditAction = "PROMO_CODES_MINTED"
+	AuditActionPromoCodeRedeemed      AuditAction = "PROMO_CODE_REDEEMED"
+	AuditActionCharacterCreated       AuditAction = "CHARACTER_CREATED"
+	AuditActionCharacterUpdated       AuditAction = "CHARACTER_UPDATED"
+	AuditActionCharacterDeleted       AuditAction = "CHARACTER_DELETED"
+)
@@ -0,0 +1,88 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// CharacterGender is the gender a character presents as
+type CharacterGender string
+
+const (
+	CharacterGenderFemale  CharacterGender = "CHARACTER_GENDER_FEMALE"
+	CharacterGenderMale    CharacterGender = "CHARACTER_GENDER_MALE"
+	CharacterGenderNeutral CharacterGender = "CHARACTER_GENDER_NEUTRAL"
+)
+
+// CharacterAgeBand is the age range a character is played as
+type CharacterAgeBand string
+
+const (
+	CharacterAgeBandChild      CharacterAgeBand = "CHARACTER_AGE_BAND_CHILD"
+	CharacterAgeBandTeen       CharacterAgeBand = "CHARACTER_AGE_BAND_TEEN"
+	CharacterAgeBandYoungAdult CharacterAgeBand = "CHARACTER_AGE_BAND_YOUNG_ADULT"
+	CharacterAgeBandAdult      CharacterAgeBand = "CHARACTER_AGE_BAND_ADULT"
+	CharacterAgeBandSenior     CharacterAgeBand = "CHARACTER_AGE_BAND_SENIOR"
+)
+
+// CharacterFormality is how politely a custom character addresses the learner
+type CharacterFormality string
+
+const (
+	CharacterFormalityCasual  CharacterFormality = "CHARACTER_FORMALITY_CASUAL"
+	CharacterFormalityNeutral CharacterFormality = "CHARACTER_FORMALITY_NEUTRAL"
+	CharacterFormalityFormal  CharacterFormality = "CHARACTER_FORMALITY_FORMAL"
+)
+
+// Character is a conversation partner the AI plays. Conversations refer to it by Key.
+type Character struct {
+	CharactersID string           `json:"id" gorm:"primaryKey;type:uuid;column:characters_id;default:gen_random_uuid()"`
+	Key          string           `json:"key" gorm:"not null;size:50;uniqueIndex"` // Such as "friend"; the character query parameter of /ws/chat
+	Persona      string           `json:"persona" gorm:"type:text;not null"`       // Who the character is and how they speak, sent to the AI as its instructions
+	Gender       CharacterGender  `json:"gender" gorm:"not null;type:varchar(40)"`
+	AgeBand      CharacterAgeBand `json:"age_band" gorm:"not null;type:varchar(40)"`
+	MinPlan      UserPlan         `json:"min_plan" gorm:"not null;type:varchar(50);default:'PLAN_FREE'"` // Least capable plan the character is available on
+	Voices       string           `json:"voices" gorm:"type:text;not null;default:'{}'"`                 // JSON object of voice IDs by practice language
+	DisplayNames string           `json:"display_names" gorm:"type:text;not null;default:'{}'"`          // JSON object of names by UI locale
+	Position     int              `json:"position" gorm:"not null;default:0"`                            // Order in listings
+	Active       bool             `json:"active" gorm:"not null;default:true"`                           // Retired characters cannot start new conversations
+	CreatedAt    time.Time        `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time        `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// CustomCharacter is a conversation partner designed by a user.
+// It is private to its owner unless shared, when anyone holding its share token can talk to it.
+type CustomCharacter struct {
+	CustomCharactersID string             `json:"id" gorm:"primaryKey;type:uuid;column:custom_characters_id;default:gen_random_uuid()"`
+	UserID             string             `json:"user_id" gorm:"type:uuid;not null;index"`
+	User               User               `json:"-" gorm:"foreignKey:UserID;references:UsersID;constraint:OnDelete:CASCADE"`
+	Name               string             `json:"name" gorm:"not null;size:50"`
+	Personality        string             `json:"personality" gorm:"type:text;not null"`
+	SpeakingStyle      string             `json:"speaking_style" gorm:"type:text;not null;default:''"`
+	Formality          CharacterFormality `json:"formality" gorm:"not null;type:varchar(40)"`
+	Voice              string             `json:"voice" gorm:"not null;size:50"`
+	ShareToken         *string            `json:"share_token" gorm:"size:64;uniqueIndex"` // Set while the character is shared by link
+	CreatedAt          time.Time          `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt          time.Time          `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// IsShared reports whether the character can be talked to by other users holding its link
+func (c *CustomCharacter) IsShared() bool {
+	return c.ShareToken != nil
+}
+
+// VoiceMap decodes Voices
+func (c *Character) VoiceMap() (map[string]string, error) {
+	return decodeStringMap(c.Voices)
+}
+
+// DisplayNameMap decodes DisplayNames
+func (c *Character) DisplayNameMap() (map[string]string, error) {
+	return decodeStringMap(c.DisplayNames)
+}
+
+func decodeStringMap(value string) (map[string]string, error) {
+	m := map[string]string{}
+	err := json.Unmarshal([]byte(value), &m)
+	return m, err
+}
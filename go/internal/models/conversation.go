@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+)
+
+// Conversation is one WebSocket conversation session between a user and the AI
+type Conversation struct {
+	ConversationsID string             `json:"id" gorm:"primaryKey;type:uuid;column:conversations_id;default:gen_random_uuid()"`
+	UserID          string             `json:"user_id" gorm:"not null;type:uuid;index:idx_conversations_user_id_started_at,priority:1"`
+	User            User               `json:"-" gorm:"foreignKey:UserID;references:UsersID;constraint:OnDelete:CASCADE"`
+	Language        string             `json:"language" gorm:"not null;size:10"`
+	Character       string             `json:"character" gorm:"not null;size:50"`
+	ScenarioID      *string            `json:"scenario_id" gorm:"type:uuid"` // Role-play scenario the conversation plays, if any
+	Scenario        *Scenario          `json:"-" gorm:"foreignKey:ScenarioID;references:ScenariosID;constraint:OnDelete:SET NULL"`
+	Plan            UserPlan           `json:"plan" gorm:"not null;type:varchar(50)"`
+	StartedAt       time.Time          `json:"started_at" gorm:"not null;index:idx_conversations_user_id_started_at,priority:2"`
+	EndedAt         *time.Time         `json:"ended_at"`
+	CloseReason     CloseReason        `json:"close_reason" gorm:"not null;type:varchar(50);default:''"`
+	Turns           []ConversationTurn `json:"turns,omitempty" gorm:"foreignKey:ConversationID;references:ConversationsID;constraint:fk_conversation_turns_conversation,OnDelete:CASCADE"`
+	CreatedAt       time.Time          `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt       time.Time          `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// CloseReason records why a conversation session ended
+type CloseReason string
+
+const (
+	CloseReasonClientClosed  CloseReason = "CLOSE_REASON_CLIENT_CLOSED"
+	CloseReasonAIStreamEnded CloseReason = "CLOSE_REASON_AI_STREAM_ENDED"
+	CloseReasonQuotaExceeded CloseReason = "CLOSE_REASON_QUOTA_EXCEEDED"
+	CloseReasonError         CloseReason = "CLOSE_REASON_ERROR"
+)
+
+// ConversationTurn is one exchange of a conversation: what the user said and the AI's answer.
+// AI text is grouped by the response_id of the ChatResponse messages it arrived in.
+type ConversationTurn struct {
+	ConversationTurnsID string         `json:"id" gorm:"primaryKey;type:uuid;column:conversation_turns_id;default:gen_random_uuid()"`
+	ConversationID      string         `json:"conversation_id" gorm:"not null;type:uuid;uniqueIndex:idx_conversation_turns_conversation_id_seq,priority:1"`
+	Conversation        Conversation   `json:"-" gorm:"foreignKey:ConversationID;references:ConversationsID;constraint:OnDelete:CASCADE"`
+	Seq                 int            `json:"seq" gorm:"not null;uniqueIndex:idx_conversation_turns_conversation_id_seq,priority:2"`
+	ResponseID          string         `json:"response_id" gorm:"not null;size:255;default:''"`
+	UserTranscript      string         `json:"user_transcript" gorm:"not null;type:text;default:''"`
+	AIText              string         `json:"ai_text" gorm:"column:ai_text;not null;type:text;default:''"`
+	UserAudioKey        string         `json:"user_audio_key" gorm:"not null;size:255;default:''"` // Blob key of the user's recorded speech, empty when not recorded
+	AIAudioKey          string         `json:"ai_audio_key" gorm:"column:ai_audio_key;not null;size:255;default:''"`
+	SearchText          string         `json:"-" gorm:"not null;type:text;default:'';index:idx_conversation_turns_search_text,type:gin,expression:array_to_tsvector(string_to_array(search_text\\, ' '))"` // Lexemes built by search.Lexemes
+	StartedAt           time.Time      `json:"started_at" gorm:"not null"`
+	EndedAt             *time.Time     `json:"ended_at"`
+	AIStartedAt         *time.Time     `json:"ai_started_at" gorm:"column:ai_started_at"` // Timestamp of the first ChatResponse answering the turn
+	AIEndedAt           *time.Time     `json:"ai_ended_at" gorm:"column:ai_ended_at"`     // Timestamp of the last ChatResponse answering the turn
+	Feedback            []TurnFeedback `json:"feedback,omitempty" gorm:"foreignKey:ConversationTurnID;references:ConversationTurnsID;constraint:fk_turn_feedbacks_conversation_turn,OnDelete:CASCADE"`
+	CreatedAt           time.Time      `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt           time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
+}
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ConversationSummary is the recap generated by the AI service after a conversation ends.
+// There is one row per conversation; a resumed conversation is summarized again.
+type ConversationSummary struct {
+	ConversationSummariesID string        `json:"id" gorm:"primaryKey;type:uuid;column:conversation_summaries_id;default:gen_random_uuid()"`
+	ConversationID          string        `json:"conversation_id" gorm:"not null;type:uuid;uniqueIndex"`
+	Conversation            *Conversation `json:"-" gorm:"foreignKey:ConversationID;references:ConversationsID;constraint:OnDelete:CASCADE"`
+	Status                  SummaryStatus `json:"status" gorm:"not null;type:varchar(30);index:idx_conversation_summaries_status_next_attempt_at,priority:1"`
+	Attempts                int           `json:"attempts" gorm:"not null;default:0"`
+	RequestedAt             time.Time     `json:"requested_at" gorm:"not null"` // Identifies the request a result belongs to
+	NextAttemptAt           time.Time     `json:"next_attempt_at" gorm:"not null;index:idx_conversation_summaries_status_next_attempt_at,priority:2"`
+	LastError               string        `json:"last_error" gorm:"not null;type:text;default:''"`
+	Content                 string        `json:"content" gorm:"not null;type:text;default:'{}'"` // SummaryContent as JSON
+	CreatedAt               time.Time     `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt               time.Time     `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+type SummaryStatus string
+
+const (
+	SummaryStatusPending SummaryStatus = "SUMMARY_STATUS_PENDING"
+	SummaryStatusReady   SummaryStatus = "SUMMARY_STATUS_READY"
+	SummaryStatusFailed  SummaryStatus = "SUMMARY_STATUS_FAILED"
+)
+
+// SummaryContent is the structured recap stored in ConversationSummary.Content
+type SummaryContent struct {
+	Topics     []string            `json:"topics"`
+	Vocabulary []SummaryVocabulary `json:"vocabulary"`
+	Mistakes   []SummaryMistake    `json:"mistakes"`
+}
+
+type SummaryVocabulary struct {
+	Term    string `json:"term"`
+	Meaning string `json:"meaning"`
+}
+
+type SummaryMistake struct {
+	Original    string `json:"original"`
+	Correction  string `json:"correction"`
+	Explanation string `json:"explanation"`
+}
+
+// ParseContent decodes the recap of a ready summary
+func (s *ConversationSummary) ParseContent() (SummaryContent, error) {
+	var content SummaryContent
+	err := json.Unmarshal([]byte(s.Content), &content)
+	return content, err
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+)
+
+// GoalMetric is what a daily goal counts
+type GoalMetric string
+
+const (
+	GoalMetricMinutes  GoalMetric = "GOAL_METRIC_MINUTES"  // Minutes of conversation audio, spoken and listened
+	GoalMetricSessions GoalMetric = "GOAL_METRIC_SESSIONS" // Conversation sessions
+)
+
+// LearningGoal is a user's daily practice goal and the reminder sent on days it is not reached yet.
+// NextReminderAt is the scheduler's state: the next time the reminder is due, nil while reminders are off.
+type LearningGoal struct {
+	LearningGoalsID string              `json:"id" gorm:"primaryKey;type:uuid;column:learning_goals_id;default:gen_random_uuid()"`
+	UserID          string              `json:"user_id" gorm:"not null;type:uuid;uniqueIndex"`
+	User            *User               `json:"-" gorm:"foreignKey:UserID;references:UsersID;constraint:OnDelete:CASCADE"`
+	Metric          GoalMetric          `json:"metric" gorm:"not null;type:varchar(30)"`
+	Target          int                 `json:"target" gorm:"not null"`
+	ReminderEnabled bool                `json:"reminder_enabled" gorm:"not null;default:false"`
+	ReminderMinute  int                 `json:"reminder_minute" gorm:"not null;default:0"` // Minute of the day in the user's time zone
+	ReminderChannel NotificationChannel `json:"reminder_channel" gorm:"not null;type:varchar(40);default:'NOTIFICATION_CHANNEL_IN_APP'"`
+	NextReminderAt  *time.Time          `json:"next_reminder_at" gorm:"index"`
+	LastRemindedAt  *time.Time          `json:"last_reminded_at"`
+	CreatedAt       time.Time           `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt       time.Time           `json:"updated_at" gorm:"autoUpdateTime"`
+}
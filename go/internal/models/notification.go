@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+)
+
+// NotificationChannel is how a notification reaches the user
+type NotificationChannel string
+
+const (
+	NotificationChannelInApp NotificationChannel = "NOTIFICATION_CHANNEL_IN_APP"
+	NotificationChannelEmail NotificationChannel = "NOTIFICATION_CHANNEL_EMAIL"
+)
+
+// Notification is a message shown to the user in the app
+type Notification struct {
+	NotificationsID string     `json:"id" gorm:"primaryKey;type:uuid;column:notifications_id;default:gen_random_uuid()"`
+	UserID          string     `json:"user_id" gorm:"not null;type:uuid;index:idx_notifications_user_id_created_at,priority:1"`
+	User            User       `json:"-" gorm:"foreignKey:UserID;references:UsersID;constraint:OnDelete:CASCADE"`
+	Kind            string     `json:"kind" gorm:"not null;type:varchar(50)"` // Such as "practice_reminder"
+	Title           string     `json:"title" gorm:"not null;type:text"`
+	Body            string     `json:"body" gorm:"not null;type:text;default:''"`
+	ReadAt          *time.Time `json:"read_at"`
+	CreatedAt       time.Time  `json:"created_at" gorm:"autoCreateTime;index:idx_notifications_user_id_created_at,priority:2"`
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+)
+
+// PracticeSession is the statistics of one WebSocket conversation session, kept for the user's learning progress.
+// Rows outlive the conversation they were recorded in, so deleting or purging transcripts keeps the history.
+type PracticeSession struct {
+	PracticeSessionsID string        `json:"id" gorm:"primaryKey;type:uuid;column:practice_sessions_id;default:gen_random_uuid()"`
+	UserID             string        `json:"user_id" gorm:"not null;type:uuid;index:idx_practice_sessions_user_id_started_at,priority:1"`
+	User               User          `json:"-" gorm:"foreignKey:UserID;references:UsersID;constraint:OnDelete:CASCADE"`
+	ConversationID     *string       `json:"conversation_id" gorm:"type:uuid"` // Recorded conversation, nil once it is deleted
+	Conversation       *Conversation `json:"-" gorm:"foreignKey:ConversationID;references:ConversationsID;constraint:OnDelete:SET NULL"`
+	Language           string        `json:"language" gorm:"not null;size:10"`
+	Character          string        `json:"character" gorm:"not null;size:50"`
+	StartedAt          time.Time     `json:"started_at" gorm:"not null;index:idx_practice_sessions_user_id_started_at,priority:2"`
+	EndedAt            time.Time     `json:"ended_at" gorm:"not null"`
+	UserAudioMs        int64         `json:"user_audio_ms" gorm:"not null;default:0"`                  // Audio the user spoke
+	AIAudioMs          int64         `json:"ai_audio_ms" gorm:"column:ai_audio_ms;not null;default:0"` // Audio the user listened to
+	Turns              int           `json:"turns" gorm:"not null;default:0"`
+	AnalysisDueAt      *time.Time    `json:"-" gorm:"index"` // Set while the session waits for the proficiency analyzer
+	CreatedAt          time.Time     `json:"created_at" gorm:"autoCreateTime"`
+}
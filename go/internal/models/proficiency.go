@@ -0,0 +1,66 @@
+package models
+
+import "time"
+
+// CEFRLevel is a level of the Common European Framework of Reference for Languages
+type CEFRLevel string
+
+const (
+	CEFRLevelA1 CEFRLevel = "CEFR_LEVEL_A1"
+	CEFRLevelA2 CEFRLevel = "CEFR_LEVEL_A2"
+	CEFRLevelB1 CEFRLevel = "CEFR_LEVEL_B1"
+	CEFRLevelB2 CEFRLevel = "CEFR_LEVEL_B2"
+	CEFRLevelC1 CEFRLevel = "CEFR_LEVEL_C1"
+	CEFRLevelC2 CEFRLevel = "CEFR_LEVEL_C2"
+)
+
+// LevelChangeSource is what moved a learner to a new level
+type LevelChangeSource string
+
+const (
+	LevelChangeSourcePlacement    LevelChangeSource = "LEVEL_CHANGE_SOURCE_PLACEMENT"
+	LevelChangeSourceConversation LevelChangeSource = "LEVEL_CHANGE_SOURCE_CONVERSATION"
+)
+
+// LanguageSignals are the measures of a learner's speech the level of conversations is estimated from
+type LanguageSignals struct {
+	WordsPerUtterance float64 `json:"words_per_utterance" gorm:"not null;default:0"`
+	LexicalDiversity  float64 `json:"lexical_diversity" gorm:"not null;default:0"` // Distinct words over the square root of words (Guiraud's index)
+	ErrorRate         float64 `json:"error_rate" gorm:"not null;default:0"`        // Corrections per utterance
+	TranslationRate   float64 `json:"translation_rate" gorm:"not null;default:0"`  // Share of utterances asking for a translation
+}
+
+// UserLanguageProfile is what is known about a user's command of one practice language.
+// The level is set by placement tests and moved by the rolling estimate of finished conversations.
+type UserLanguageProfile struct {
+	UserLanguageProfilesID string          `json:"id" gorm:"primaryKey;type:uuid;column:user_language_profiles_id;default:gen_random_uuid()"`
+	UserID                 string          `json:"user_id" gorm:"not null;type:uuid;uniqueIndex:idx_user_language_profiles_user_id_language,priority:1"`
+	User                   User            `json:"-" gorm:"foreignKey:UserID;references:UsersID;constraint:OnDelete:CASCADE"`
+	Language               string          `json:"language" gorm:"not null;size:10;uniqueIndex:idx_user_language_profiles_user_id_language,priority:2"`
+	Level                  CEFRLevel       `json:"level" gorm:"not null;type:varchar(20)"`    // Empty until placed or assessed in enough conversations
+	PlacementScore         float64         `json:"placement_score" gorm:"not null;default:0"` // 0 to 100, from the latest placement test
+	PlacedAt               *time.Time      `json:"placed_at"`                                 // When the latest placement test was scored
+	Estimate               float64         `json:"estimate" gorm:"not null;default:0"`        // Rolling level estimate, 1 (A1) to 7 (above C2), 0 while unknown
+	Signals                LanguageSignals `json:"signals" gorm:"embedded"`                   // Rolling averages of the assessed conversations
+	AssessedSessions       int             `json:"assessed_sessions" gorm:"not null;default:0"`
+	AssessedAt             *time.Time      `json:"assessed_at"` // When a conversation last moved the estimate
+	CreatedAt              time.Time       `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt              time.Time       `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// LevelChange records a learner moving to a new level in a language, with the evidence behind it
+type LevelChange struct {
+	LevelChangesID    string            `json:"id" gorm:"primaryKey;type:uuid;column:level_changes_id;default:gen_random_uuid()"`
+	UserID            string            `json:"user_id" gorm:"not null;type:uuid;index:idx_level_changes_user_id_created_at,priority:1"`
+	User              User              `json:"-" gorm:"foreignKey:UserID;references:UsersID;constraint:OnDelete:CASCADE"`
+	Language          string            `json:"language" gorm:"not null;size:10"`
+	Source            LevelChangeSource `json:"source" gorm:"not null;type:varchar(40)"`
+	PreviousLevel     CEFRLevel         `json:"previous_level" gorm:"not null;type:varchar(20);default:''"` // Empty for the first level
+	Level             CEFRLevel         `json:"level" gorm:"not null;type:varchar(20)"`
+	Estimate          float64           `json:"estimate" gorm:"not null;default:0"`
+	Signals           LanguageSignals   `json:"signals" gorm:"embedded"`                   // Rolling averages at the change; zero for a placement
+	PlacementScore    float64           `json:"placement_score" gorm:"not null;default:0"` // Set for a placement
+	PracticeSessionID *string           `json:"practice_session_id" gorm:"type:uuid"`      // Conversation session whose assessment moved the level
+	PracticeSession   *PracticeSession  `json:"-" gorm:"foreignKey:PracticeSessionID;references:PracticeSessionsID;constraint:OnDelete:SET NULL"`
+	CreatedAt         time.Time         `json:"created_at" gorm:"index:idx_level_changes_user_id_created_at,priority:2"`
+}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+)
+
+// PromoCode grants a plan for a number of days to each user that redeems it
+type PromoCode struct {
+	PromoCodesID    string     `json:"id" gorm:"primaryKey;type:uuid;column:promo_codes_id;default:gen_random_uuid()"`
+	Code            string     `json:"code" gorm:"uniqueIndex;not null;size:64"`
+	Plan            UserPlan   `json:"plan" gorm:"not null;type:varchar(50)"`
+	DurationDays    int        `json:"duration_days" gorm:"not null"`
+	MaxRedemptions  int        `json:"max_redemptions" gorm:"not null;default:0"` // 0 means unlimited
+	RedemptionCount int        `json:"redemption_count" gorm:"not null;default:0"`
+	ExpiresAt       *time.Time `json:"expires_at"`
+	Campaign        string     `json:"campaign" gorm:"not null;size:100;default:'';index"`
+	CreatedByUserID *string    `json:"created_by_user_id" gorm:"type:uuid"`
+	CreatedAt       time.Time  `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// IsExpired reports whether the code can no longer be redeemed at now
+func (p *PromoCode) IsExpired(now time.Time) bool {
+	return p.ExpiresAt != nil && !now.Before(*p.ExpiresAt)
+}
+
+// IsExhausted reports whether the code reached its redemption limit
+func (p *PromoCode) IsExhausted() bool {
+	return p.MaxRedemptions > 0 && p.RedemptionCount >= p.MaxRedemptions
+}
+
+// PlanGrant is a time limited plan granted to a user by redeeming a promo code.
+// A user can redeem each code only once.
+type PlanGrant struct {
+	PlanGrantsID string     `json:"id" gorm:"primaryKey;type:uuid;column:plan_grants_id;default:gen_random_uuid()"`
+	UserID       string     `json:"user_id" gorm:"not null;type:uuid;uniqueIndex:idx_plan_grants_user_id_promo_code_id,priority:1"`
+	User         User       `json:"-" gorm:"foreignKey:UserID;references:UsersID;constraint:OnDelete:CASCADE"`
+	PromoCodeID  string     `json:"promo_code_id" gorm:"not null;type:uuid;uniqueIndex:idx_plan_grants_user_id_promo_code_id,priority:2"`
+	PromoCode    PromoCode  `json:"-" gorm:"foreignKey:PromoCodeID;references:PromoCodesID;constraint:OnDelete:CASCADE"`
+	Plan         UserPlan   `json:"plan" gorm:"not null;type:varchar(50)"`
+	PreviousPlan UserPlan   `json:"previous_plan" gorm:"not null;type:varchar(50)"` // users.plan before the grant, restored when it ends
+	StartsAt     time.Time  `json:"starts_at" gorm:"not null"`
+	EndsAt       time.Time  `json:"ends_at" gorm:"not null;index"`
+	RevertedAt   *time.Time `json:"reverted_at"` // Set once the plan change of an ended grant has been undone
+	CreatedAt    time.Time  `json:"created_at" gorm:"autoCreateTime"`
+}
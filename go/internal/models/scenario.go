@@ -0,0 +1,82 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ScenarioDifficulty is how demanding a role-play scenario is for the learner
+type ScenarioDifficulty string
+
+const (
+	ScenarioDifficultyBeginner     ScenarioDifficulty = "SCENARIO_DIFFICULTY_BEGINNER"
+	ScenarioDifficultyIntermediate ScenarioDifficulty = "SCENARIO_DIFFICULTY_INTERMEDIATE"
+	ScenarioDifficultyAdvanced     ScenarioDifficulty = "SCENARIO_DIFFICULTY_ADVANCED"
+)
+
+// Scenario is a role-play situation of the catalog, such as ordering at a café.
+// Its texts are kept per practice language in Localizations; a scenario is offered in those languages only.
+type Scenario struct {
+	ScenariosID   string                 `json:"id" gorm:"primaryKey;type:uuid;column:scenarios_id;default:gen_random_uuid()"`
+	Slug          string                 `json:"slug" gorm:"not null;size:100;uniqueIndex"` // Stable key of the catalog entry
+	Difficulty    ScenarioDifficulty     `json:"difficulty" gorm:"not null;type:varchar(40)"`
+	Position      int                    `json:"position" gorm:"not null;default:0"`  // Order in the catalog
+	Active        bool                   `json:"active" gorm:"not null;default:true"` // Retired scenarios are no longer offered
+	Localizations []ScenarioLocalization `json:"localizations,omitempty" gorm:"foreignKey:ScenarioID;references:ScenariosID;constraint:fk_scenario_localizations_scenario,OnDelete:CASCADE"`
+	CreatedAt     time.Time              `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt     time.Time              `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// ScenarioLocalization is a scenario written in one practice language
+type ScenarioLocalization struct {
+	ScenarioLocalizationsID string    `json:"id" gorm:"primaryKey;type:uuid;column:scenario_localizations_id;default:gen_random_uuid()"`
+	ScenarioID              string    `json:"scenario_id" gorm:"not null;type:uuid;uniqueIndex:idx_scenario_localizations_scenario_id_language,priority:1"`
+	Scenario                *Scenario `json:"-" gorm:"foreignKey:ScenarioID;references:ScenariosID;constraint:OnDelete:CASCADE"`
+	Language                string    `json:"language" gorm:"not null;size:10;uniqueIndex:idx_scenario_localizations_scenario_id_language,priority:2"`
+	Title                   string    `json:"title" gorm:"not null;size:200"`
+	Description             string    `json:"description" gorm:"type:text;not null;default:''"`
+	Goals                   string    `json:"goals" gorm:"type:text;not null;default:'[]'"`      // JSON array of what the learner should accomplish, in order
+	Vocabulary              string    `json:"vocabulary" gorm:"type:text;not null;default:'[]'"` // JSON array of words and phrases the scenario needs
+	SetupPrompt             string    `json:"setup_prompt" gorm:"type:text;not null"`            // Instructions for the AI playing the scenario
+	CreatedAt               time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt               time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// GoalList decodes Goals
+func (l *ScenarioLocalization) GoalList() ([]string, error) {
+	return decodeStrings(l.Goals)
+}
+
+// VocabularyList decodes Vocabulary
+func (l *ScenarioLocalization) VocabularyList() ([]string, error) {
+	return decodeStrings(l.Vocabulary)
+}
+
+func decodeStrings(value string) ([]string, error) {
+	var list []string
+	err := json.Unmarshal([]byte(value), &list)
+	return list, err
+}
+
+// ScenarioCompletion records a session a user played in a scenario and which of its goals they achieved.
+// Like PracticeSession, rows outlive the conversation they were recorded in.
+type ScenarioCompletion struct {
+	ScenarioCompletionsID string        `json:"id" gorm:"primaryKey;type:uuid;column:scenario_completions_id;default:gen_random_uuid()"`
+	UserID                string        `json:"user_id" gorm:"not null;type:uuid;index:idx_scenario_completions_user_id_scenario_id,priority:1"`
+	User                  User          `json:"-" gorm:"foreignKey:UserID;references:UsersID;constraint:OnDelete:CASCADE"`
+	ScenarioID            string        `json:"scenario_id" gorm:"not null;type:uuid;index:idx_scenario_completions_user_id_scenario_id,priority:2"`
+	Scenario              *Scenario     `json:"-" gorm:"foreignKey:ScenarioID;references:ScenariosID;constraint:OnDelete:CASCADE"`
+	ConversationID        *string       `json:"conversation_id" gorm:"type:uuid"` // Recorded conversation, nil once it is deleted
+	Conversation          *Conversation `json:"-" gorm:"foreignKey:ConversationID;references:ConversationsID;constraint:OnDelete:SET NULL"`
+	Language              string        `json:"language" gorm:"not null;size:10"`
+	GoalsAchieved         int           `json:"goals_achieved" gorm:"not null;default:0"`
+	GoalsTotal            int           `json:"goals_total" gorm:"not null;default:0"`
+	AchievedGoals         string        `json:"achieved_goals" gorm:"type:text;not null;default:'[]'"` // JSON array of the indices of the achieved goals
+	CompletedAt           time.Time     `json:"completed_at" gorm:"not null"`
+	CreatedAt             time.Time     `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// Completed reports whether every goal of the scenario was achieved
+func (c *ScenarioCompletion) Completed() bool {
+	return c.GoalsTotal > 0 && c.GoalsAchieved >= c.GoalsTotal
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"time"
+)
+
+// Subscription mirrors a subscription at the payment provider.
+// The user's effective plan is derived from its status (see package entitlement).
+type Subscription struct {
+	SubscriptionsID        string             `json:"id" gorm:"primaryKey;type:uuid;column:subscriptions_id;default:gen_random_uuid()"`
+	UserID                 string             `json:"user_id" gorm:"not null;type:uuid;index"`
+	User                   User               `json:"-" gorm:"foreignKey:UserID;references:UsersID;constraint:OnDelete:CASCADE"`
+	ProviderSubscriptionID string             `json:"provider_subscription_id" gorm:"uniqueIndex;not null;size:255"`
+	Plan                   UserPlan           `json:"plan" gorm:"not null;type:varchar(50)"`
+	Status                 SubscriptionStatus `json:"status" gorm:"not null;type:varchar(50)"`
+	TrialEndsAt            *time.Time         `json:"trial_ends_at"`
+	CurrentPeriodEnd       *time.Time         `json:"current_period_end"`
+	CanceledAt             *time.Time         `json:"canceled_at"`
+	LastEventAt            time.Time          `json:"last_event_at" gorm:"not null"`
+	CreatedAt              time.Time          `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt              time.Time          `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+type SubscriptionStatus string
+
+const (
+	SubscriptionTrialing SubscriptionStatus = "TRIALING"
+	SubscriptionActive   SubscriptionStatus = "ACTIVE"
+	SubscriptionPastDue  SubscriptionStatus = "PAST_DUE"
+	SubscriptionCanceled SubscriptionStatus = "CANCELED"
+)
+
+// Invoice mirrors an invoice issued by the payment provider for a subscription
+type Invoice struct {
+	InvoicesID        string        `json:"id" gorm:"primaryKey;type:uuid;column:invoices_id;default:gen_random_uuid()"`
+	SubscriptionID    string        `json:"subscription_id" gorm:"not null;type:uuid;index"`
+	Subscription      Subscription  `json:"-" gorm:"foreignKey:SubscriptionID;references:SubscriptionsID;constraint:OnDelete:CASCADE"`
+	UserID            string        `json:"user_id" gorm:"not null;type:uuid;index"`
+	ProviderInvoiceID string        `json:"provider_invoice_id" gorm:"uniqueIndex;not null;size:255"`
+	AmountDue         int64         `json:"amount_due" gorm:"not null"` // In the currency's minor unit
+	Currency          string        `json:"currency" gorm:"not null;size:3"`
+	Status            InvoiceStatus `json:"status" gorm:"not null;type:varchar(50)"`
+	PeriodStart       *time.Time    `json:"period_start"`
+	PeriodEnd         *time.Time    `json:"period_end"`
+	PaidAt            *time.Time    `json:"paid_at"`
+	CreatedAt         time.Time     `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt         time.Time     `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+type InvoiceStatus string
+
+const (
+	InvoiceOpen   InvoiceStatus = "OPEN"
+	InvoicePaid   InvoiceStatus = "PAID"
+	InvoiceFailed InvoiceStatus = "FAILED"
+)
+
+// BillingEvent records a processed webhook event so redeliveries are ignored
+type BillingEvent struct {
+	BillingEventsID string    `json:"id" gorm:"primaryKey;type:uuid;column:billing_events_id;default:gen_random_uuid()"`
+	ProviderEventID string    `json:"provider_event_id" gorm:"uniqueIndex;not null;size:255"`
+	Type            string    `json:"type" gorm:"not null;size:100"`
+	ReceivedAt      time.Time `json:"received_at" gorm:"autoCreateTime"`
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// FeedbackCategory is what kind of mistake a feedback item corrects
+type FeedbackCategory string
+
+const (
+	FeedbackCategoryUnspecified   FeedbackCategory = "FEEDBACK_CATEGORY_UNSPECIFIED"
+	FeedbackCategoryGrammar       FeedbackCategory = "FEEDBACK_CATEGORY_GRAMMAR"
+	FeedbackCategoryVocabulary    FeedbackCategory = "FEEDBACK_CATEGORY_VOCABULARY"
+	FeedbackCategoryPronunciation FeedbackCategory = "FEEDBACK_CATEGORY_PRONUNCIATION"
+)
+
+// Name returns the category in lower case without its prefix, e.g. "grammar"
+func (c FeedbackCategory) Name() string {
+	return strings.ToLower(strings.TrimPrefix(string(c), "FEEDBACK_CATEGORY_"))
+}
+
+// TurnFeedback is a correction the AI gave on what the user said in a turn.
+// Position keeps the order the AI gave the corrections of a turn in.
+type TurnFeedback struct {
+	TurnFeedbacksID    string           `json:"id" gorm:"primaryKey;type:uuid;column:turn_feedbacks_id;default:gen_random_uuid()"`
+	ConversationTurnID string           `json:"conversation_turn_id" gorm:"not null;type:uuid;uniqueIndex:idx_turn_feedbacks_conversation_turn_id_position,priority:1"`
+	Position           int              `json:"position" gorm:"not null;uniqueIndex:idx_turn_feedbacks_conversation_turn_id_position,priority:2"`
+	Category           FeedbackCategory `json:"category" gorm:"not null;type:varchar(40)"`
+	Original           string           `json:"original" gorm:"type:text;not null;default:''"`    // Span of the user's transcript that is wrong
+	Correction         string           `json:"correction" gorm:"type:text;not null;default:''"`  // What a native speaker would say instead
+	Explanation        string           `json:"explanation" gorm:"type:text;not null;default:''"` // In the learner's native language
+	Pattern            string           `json:"pattern" gorm:"type:text;not null;default:''"`     // Recurring mistake the correction belongs to, built by mistake.Pattern
+	CreatedAt          time.Time        `json:"created_at" gorm:"autoCreateTime"`
+}
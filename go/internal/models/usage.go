@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+)
+
+// DailyUsage aggregates the metered conversation audio of a user for one calendar day
+type DailyUsage struct {
+	DailyUsagesID string    `json:"id" gorm:"primaryKey;type:uuid;column:daily_usages_id;default:gen_random_uuid()"`
+	UserID        string    `json:"user_id" gorm:"not null;type:uuid;uniqueIndex:idx_daily_usages_user_id_usage_date,priority:1"`
+	User          User      `json:"-" gorm:"foreignKey:UserID;references:UsersID;constraint:OnDelete:CASCADE"`
+	UsageDate     time.Time `json:"usage_date" gorm:"not null;type:date;uniqueIndex:idx_daily_usages_user_id_usage_date,priority:2"`
+	UserAudioMs   int64     `json:"user_audio_ms" gorm:"not null;default:0"`
+	AIAudioMs     int64     `json:"ai_audio_ms" gorm:"column:ai_audio_ms;not null;default:0"`
+	Sessions      int64     `json:"sessions" gorm:"not null;default:0"`
+	CreatedAt     time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt     time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
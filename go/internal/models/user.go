@@ -6,14 +6,26 @@ import (
 
 // User represents a user in the system
 type User struct {
-	UsersID      string    `json:"id" gorm:"primaryKey;type:uuid;column:users_id;default:gen_random_uuid()"`
-	Username     string    `json:"username" gorm:"not null;size:100"`
-	Email        string    `json:"email" gorm:"uniqueIndex;not null;size:255"`
-	PasswordHash string    `json:"-" gorm:"not null;column:password_hash;size:255"`
-	Gender       string    `json:"gender" gorm:"type:varchar(20)"`
-	Plan         UserPlan  `json:"plan" gorm:"not null;type:varchar(50);default:'PLAN_FREE'"`
-	CreatedAt    time.Time `json:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt    time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+	UsersID      string     `json:"id" gorm:"primaryKey;type:uuid;column:users_id;default:gen_random_uuid()"`
+	Username     string     `json:"username" gorm:"not null;size:100"`
+	Email        string     `json:"email" gorm:"uniqueIndex;not null;size:255"`
+	PasswordHash string     `json:"-" gorm:"not null;column:password_hash;size:255"`
+	Gender       string     `json:"gender" gorm:"type:varchar(20)"`
+	Plan         UserPlan   `json:"plan" gorm:"not null;type:varchar(50);default:'PLAN_FREE'"`
+	Role         UserRole   `json:"role" gorm:"not null;type:varchar(50);default:'ROLE_USER'"`
+	DisabledAt   *time.Time `json:"disabled_at"`
+	CreatedAt    time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// IsAdmin reports whether the user may use the admin API
+func (u *User) IsAdmin() bool {
+	return u.Role == RoleAdmin
+}
+
+// IsDisabled reports whether the account has been disabled by support
+func (u *User) IsDisabled() bool {
+	return u.DisabledAt != nil
 }
 
 // RefreshToken represents a refresh token in the system
@@ -26,6 +38,18 @@ type RefreshToken struct {
 	CreatedAt       time.Time `json:"created_at" gorm:"autoCreateTime"`
 }
 
+// PasswordResetToken represents a pending password reset in the system.
+// Only the SHA-256 hash of the token is stored.
+type PasswordResetToken struct {
+	PasswordResetTokensID string     `json:"id" gorm:"primaryKey;type:uuid;column:password_reset_tokens_id;default:gen_random_uuid()"`
+	UserID                string     `json:"user_id" gorm:"not null;type:uuid;index"`
+	User                  User       `json:"-" gorm:"foreignKey:UserID;references:UsersID;constraint:OnDelete:CASCADE"`
+	TokenHash             string     `json:"-" gorm:"uniqueIndex;not null;size:64"`
+	ExpiresAt             time.Time  `json:"expires_at" gorm:"not null"`
+	UsedAt                *time.Time `json:"used_at"`
+	CreatedAt             time.Time  `json:"created_at" gorm:"autoCreateTime"`
+}
+
 type UserPlan string
 
 const (
@@ -33,3 +57,10 @@ const (
 	PlanLite    UserPlan = "PLAN_LITE"
 	PlanPremium UserPlan = "PLAN_PREMIUM"
 )
+
+type UserRole string
+
+const (
+	RoleUser  UserRole = "ROLE_USER"
+	RoleAdmin UserRole = "ROLE_ADMIN"
+)
@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+)
+
+// UserMemory is a short fact about a user that characters remember between conversations.
+// The AI service proposes memories during a conversation and the user can edit or delete them.
+type UserMemory struct {
+	UserMemoriesID string        `json:"id" gorm:"primaryKey;type:uuid;column:user_memories_id;default:gen_random_uuid()"`
+	UserID         string        `json:"user_id" gorm:"not null;type:uuid;index:idx_user_memories_user_id_updated_at,priority:1"`
+	User           User          `json:"-" gorm:"foreignKey:UserID;references:UsersID;constraint:OnDelete:CASCADE"`
+	Content        string        `json:"content" gorm:"type:text;not null"`
+	ConversationID *string       `json:"conversation_id" gorm:"type:uuid"` // Conversation the fact was learned in
+	Conversation   *Conversation `json:"-" gorm:"foreignKey:ConversationID;references:ConversationsID;constraint:OnDelete:SET NULL"`
+	CreatedAt      time.Time     `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt      time.Time     `json:"updated_at" gorm:"autoUpdateTime;index:idx_user_memories_user_id_updated_at,priority:2"`
+}
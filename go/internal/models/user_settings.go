@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+)
+
+// UserSettings holds the preferences a user controls themselves.
+// Users without a row use DefaultUserSettings.
+type UserSettings struct {
+	UserSettingsID          string    `json:"id" gorm:"primaryKey;type:uuid;column:user_settings_id;default:gen_random_uuid()"`
+	UserID                  string    `json:"user_id" gorm:"not null;type:uuid;uniqueIndex"`
+	User                    User      `json:"-" gorm:"foreignKey:UserID;references:UsersID;constraint:OnDelete:CASCADE"`
+	AudioOptOut             bool      `json:"audio_opt_out" gorm:"not null;default:false"`         // Do not store conversation audio
+	AudioRetentionDays      int       `json:"audio_retention_days" gorm:"not null;default:0"`      // Shortens the plan's audio retention; 0 keeps the plan's
+	TranscriptRetentionDays int       `json:"transcript_retention_days" gorm:"not null;default:0"` // Shortens the plan's transcript retention; 0 keeps the plan's
+	PrivacyMode             bool      `json:"privacy_mode" gorm:"not null;default:false"`          // Store neither audio nor transcripts of conversations
+	TimeZone                string    `json:"time_zone" gorm:"not null;size:64;default:''"`        // IANA name calendar days are counted in; empty uses the service's
+	CreatedAt               time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt               time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// DefaultUserSettings returns the settings of a user who never changed them
+func DefaultUserSettings(userID string) *UserSettings {
+	return &UserSettings{
+		UserID: userID,
+	}
+}
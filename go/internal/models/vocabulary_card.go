@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+)
+
+// VocabularyCard is a word or phrase a user keeps for spaced-repetition review.
+// The schedule fields hold the SM-2 state maintained by the vocabulary package.
+type VocabularyCard struct {
+	VocabularyCardsID string            `json:"id" gorm:"primaryKey;type:uuid;column:vocabulary_cards_id;default:gen_random_uuid()"`
+	UserID            string            `json:"user_id" gorm:"not null;type:uuid;index:idx_vocabulary_cards_user_id_due_at,priority:1"`
+	User              User              `json:"-" gorm:"foreignKey:UserID;references:UsersID;constraint:OnDelete:CASCADE"`
+	Term              string            `json:"term" gorm:"type:text;not null"`
+	Reading           string            `json:"reading" gorm:"type:text;not null;default:''"` // Pronunciation aid such as kana or romanization
+	Translation       string            `json:"translation" gorm:"type:text;not null;default:''"`
+	Example           string            `json:"example" gorm:"type:text;not null;default:''"` // Example sentence
+	SourceTurnID      *string           `json:"source_turn_id" gorm:"type:uuid"`              // Conversation turn the card was added from
+	SourceTurn        *ConversationTurn `json:"-" gorm:"foreignKey:SourceTurnID;references:ConversationTurnsID;constraint:OnDelete:SET NULL"`
+	EaseFactor        float64           `json:"ease_factor" gorm:"not null;default:2.5"`
+	IntervalDays      int               `json:"interval_days" gorm:"not null;default:0"`
+	Repetitions       int               `json:"repetitions" gorm:"not null;default:0"` // Successful reviews in a row
+	DueAt             time.Time         `json:"due_at" gorm:"not null;index:idx_vocabulary_cards_user_id_due_at,priority:2"`
+	LastReviewedAt    *time.Time        `json:"last_reviewed_at"`
+	CreatedAt         time.Time         `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt         time.Time         `json:"updated_at" gorm:"autoUpdateTime"`
+}
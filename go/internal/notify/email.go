@@ -0,0 +1,90 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+// SMTPConfig is the mail server email notifications are sent through
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	Username string // Empty sends without authentication
+	Password string
+	From     string
+}
+
+// SMTPConfigFromEnv reads SMTP_HOST, SMTP_PORT (587 by default), SMTP_USERNAME, SMTP_PASSWORD and MAIL_FROM.
+// It reports false when SMTP_HOST is not set.
+func SMTPConfigFromEnv() (SMTPConfig, bool, error) {
+	config := SMTPConfig{
+		Host:     os.Getenv("SMTP_HOST"),
+		Port:     os.Getenv("SMTP_PORT"),
+		Username: os.Getenv("SMTP_USERNAME"),
+		Password: os.Getenv("SMTP_PASSWORD"),
+		From:     os.Getenv("MAIL_FROM"),
+	}
+	if config.Host == "" {
+		return SMTPConfig{}, false, nil
+	}
+	if config.Port == "" {
+		config.Port = "587"
+	}
+	if config.From == "" {
+		return SMTPConfig{}, false, errors.New("MAIL_FROM is required with SMTP_HOST")
+	}
+	return config, true, nil
+}
+
+// Email sends messages to the user's email address
+type Email struct {
+	config SMTPConfig
+	send   func(addr string, auth smtp.Auth, from string, to []string, msg []byte) error
+	now    func() time.Time
+}
+
+// NewEmail creates a notifier sending email through the SMTP server
+func NewEmail(config SMTPConfig) *Email {
+	return &Email{config: config, send: smtp.SendMail, now: time.Now}
+}
+
+func (n *Email) Notify(ctx context.Context, user *models.User, message Message) error {
+	if user.Email == "" {
+		return errors.New("user has no email address")
+	}
+	var auth smtp.Auth
+	if n.config.Username != "" {
+		auth = smtp.PlainAuth("", n.config.Username, n.config.Password, n.config.Host)
+	}
+	addr := net.JoinHostPort(n.config.Host, n.config.Port)
+	if err := n.send(addr, auth, n.config.From, []string{user.Email}, n.compose(user.Email, message)); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+	return nil
+}
+
+// compose renders a plain text email, encoding the subject for non-ASCII titles
+func (n *Email) compose(to string, message Message) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", n.config.From)
+	fmt.Fprintf(&b, "To: %s\r\n", to)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Title))
+	fmt.Fprintf(&b, "Date: %s\r\n", n.now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(message.Body, "\n", "\r\n"))
+	b.WriteString("\r\n")
+	return b.Bytes()
+}
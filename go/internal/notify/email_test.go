@@ -0,0 +1,73 @@
+package notify
+
+import (
+	"context"
+	"net/smtp"
+	"testing"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmail_Notify(t *testing.T) {
+	notifier := NewEmail(SMTPConfig{Host: "smtp.example.com", Port: "587", From: "talk@example.com"})
+	notifier.now = func() time.Time { return time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC) }
+	var addr string
+	var to []string
+	var msg []byte
+	notifier.send = func(a string, auth smtp.Auth, from string, recipients []string, m []byte) error {
+		addr, to, msg = a, recipients, m
+		assert.Nil(t, auth)
+		assert.Equal(t, "talk@example.com", from)
+		return nil
+	}
+
+	err := notifier.Notify(context.Background(), &models.User{Email: "alice@example.com"}, Message{
+		Kind:  KindPracticeReminder,
+		Title: "練習の時間です",
+		Body:  "line one\nline two",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "smtp.example.com:587", addr)
+	assert.Equal(t, []string{"alice@example.com"}, to)
+	assert.Equal(t, "From: talk@example.com\r\n"+
+		"To: alice@example.com\r\n"+
+		"Subject: =?utf-8?q?=E7=B7=B4=E7=BF=92=E3=81=AE=E6=99=82=E9=96=93=E3=81=A7=E3=81=99?=\r\n"+
+		"Date: Mon, 19 Oct 2026 09:00:00 +0000\r\n"+
+		"MIME-Version: 1.0\r\n"+
+		"Content-Type: text/plain; charset=utf-8\r\n"+
+		"Content-Transfer-Encoding: 8bit\r\n"+
+		"\r\n"+
+		"line one\r\nline two\r\n", string(msg))
+}
+
+func TestEmail_NotifyWithoutAddress(t *testing.T) {
+	notifier := NewEmail(SMTPConfig{Host: "smtp.example.com", Port: "587", From: "talk@example.com"})
+	notifier.send = func(string, smtp.Auth, string, []string, []byte) error {
+		t.Fatal("sent email without a recipient")
+		return nil
+	}
+
+	assert.Error(t, notifier.Notify(context.Background(), &models.User{}, Message{Title: "hi"}))
+}
+
+func TestSMTPConfigFromEnv(t *testing.T) {
+	t.Setenv("SMTP_HOST", "")
+	_, ok, err := SMTPConfigFromEnv()
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	t.Setenv("SMTP_HOST", "smtp.example.com")
+	t.Setenv("MAIL_FROM", "")
+	_, _, err = SMTPConfigFromEnv()
+	assert.Error(t, err)
+
+	t.Setenv("MAIL_FROM", "talk@example.com")
+	config, ok, err := SMTPConfigFromEnv()
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "587", config.Port)
+}
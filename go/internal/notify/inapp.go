@@ -0,0 +1,27 @@
+package notify
+
+import (
+	"context"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+)
+
+// InApp stores messages as notifications the user reads in the app
+type InApp struct {
+	notifications repository.NotificationRepository
+}
+
+// NewInApp creates a notifier storing messages in notifications
+func NewInApp(notifications repository.NotificationRepository) *InApp {
+	return &InApp{notifications: notifications}
+}
+
+func (n *InApp) Notify(ctx context.Context, user *models.User, message Message) error {
+	return n.notifications.CreateNotification(ctx, &models.Notification{
+		UserID: user.UsersID,
+		Kind:   message.Kind,
+		Title:  message.Title,
+		Body:   message.Body,
+	})
+}
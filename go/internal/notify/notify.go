@@ -0,0 +1,44 @@
+// Package notify delivers notifications to users.
+//
+// Notifier has an implementation per channel: InApp stores notifications the app lists,
+// Email sends them over SMTP and Log only writes them to the log, standing in for email
+// in local development where no SMTP server is configured.
+package notify
+
+import (
+	"context"
+	"log"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+// Kinds of notifications
+const (
+	KindPracticeReminder = "practice_reminder"
+	KindPasswordReset    = "password_reset"
+)
+
+// Message is a notification to deliver
+type Message struct {
+	Kind  string
+	Title string
+	Body  string
+}
+
+// Notifier delivers messages to users
+type Notifier interface {
+	Notify(ctx context.Context, user *models.User, message Message) error
+}
+
+// Log writes messages to the log instead of delivering them
+type Log struct{}
+
+// NewLog creates a notifier that only logs messages
+func NewLog() *Log {
+	return &Log{}
+}
+
+func (Log) Notify(_ context.Context, user *models.User, message Message) error {
+	log.Printf("Notification %s to user %s: %s: %s", message.Kind, user.UsersID, message.Title, message.Body)
+	return nil
+}
@@ -0,0 +1,52 @@
+// Package placement runs the placement test that estimates a new learner's CEFR level.
+//
+// During a placement session the AI service asks the graded prompts of the bank (bank.json)
+// in order and scores each answer on a rubric. The proxy collects the answers with their
+// transcripts, and Score combines the rubric with the answers' length and lexical variety
+// into a level stored on the user's language profile.
+package placement
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+//go:embed bank.json
+var bankJSON []byte
+
+// Prompt is a question of the bank, written in the practice language
+type Prompt struct {
+	ID    string           `json:"id"`
+	Level models.CEFRLevel `json:"level"`
+	Text  string           `json:"text"`
+}
+
+// bank holds the prompts by practice language, from the easiest to the hardest
+var bank map[string][]Prompt
+
+func init() {
+	if err := json.Unmarshal(bankJSON, &bank); err != nil {
+		panic(fmt.Sprintf("placement: invalid bank: %v", err))
+	}
+}
+
+// Languages returns the practice languages a placement test is offered in, sorted
+func Languages() []string {
+	languages := make([]string, 0, len(bank))
+	for language := range bank {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+// Prompts returns the prompts of the language in the order they are asked,
+// or false when the language has no placement test
+func Prompts(language string) ([]Prompt, bool) {
+	prompts, ok := bank[language]
+	return prompts, ok
+}
@@ -0,0 +1,119 @@
+package placement
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrompts(t *testing.T) {
+	for _, language := range []string{"en", "ja", "vi"} {
+		prompts, ok := Prompts(language)
+		require.True(t, ok, language)
+		rank := 0
+		for _, p := range prompts {
+			assert.NotEmpty(t, p.ID)
+			assert.NotEmpty(t, p.Text)
+			require.NotZero(t, Rank(p.Level), "%s has an unknown level", p.ID)
+			assert.GreaterOrEqual(t, Rank(p.Level), rank, "%s is out of order", p.ID)
+			rank = Rank(p.Level)
+		}
+	}
+	_, ok := Prompts("xx")
+	assert.False(t, ok)
+}
+
+// answer builds an answer of n distinct words
+func answer(level models.CEFRLevel, rubric int, n int) Answer {
+	words := make([]string, n)
+	for i := range words {
+		words[i] = "word" + strings.Repeat("x", i)
+	}
+	return Answer{
+		Prompt:     Prompt{ID: string(level), Level: level},
+		Rubric:     Rubric{Grammar: rubric, Vocabulary: rubric, Fluency: rubric, TaskCompletion: rubric},
+		Transcript: strings.Join(words, " "),
+	}
+}
+
+func TestAnswerQuality(t *testing.T) {
+	assert.InDelta(t, 1, answer(models.CEFRLevelA1, 4, 5).Quality(), 0.001)
+	assert.InDelta(t, 0, Answer{Prompt: Prompt{Level: models.CEFRLevelB1}}.Quality(), 0.001)
+	// The rubric clamped to its maximum, half the expected length and a variety of √5 out of 3
+	assert.InDelta(t, 0.6+0.2*0.5+0.2*math.Sqrt(5)/3, answer(models.CEFRLevelA2, 9, 5).Quality(), 0.001)
+
+	repeated := answer(models.CEFRLevelA1, 4, 0)
+	repeated.Transcript = "yes yes yes yes yes yes yes yes yes"
+	assert.Less(t, repeated.Quality(), 0.9)
+}
+
+func TestScore(t *testing.T) {
+	tests := []struct {
+		name    string
+		answers []Answer
+		want    models.CEFRLevel
+	}{
+		{name: "fails A1", answers: []Answer{answer(models.CEFRLevelA1, 0, 1)}, want: models.CEFRLevelA1},
+		{name: "passes up to B1", answers: []Answer{
+			answer(models.CEFRLevelA1, 4, 5),
+			answer(models.CEFRLevelA2, 4, 10),
+			answer(models.CEFRLevelB1, 3, 20),
+			answer(models.CEFRLevelB2, 1, 8),
+			answer(models.CEFRLevelC1, 4, 40),
+		}, want: models.CEFRLevelB1},
+		{name: "skips unanswered levels", answers: []Answer{
+			answer(models.CEFRLevelA1, 4, 5),
+			answer(models.CEFRLevelB1, 4, 20),
+		}, want: models.CEFRLevelB1},
+		{name: "averages a level", answers: []Answer{
+			answer(models.CEFRLevelA1, 4, 5),
+			answer(models.CEFRLevelA2, 4, 10),
+			answer(models.CEFRLevelA2, 0, 1),
+		}, want: models.CEFRLevelA1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Score(tt.answers)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result.Level)
+			assert.Equal(t, len(tt.answers), result.Answers)
+		})
+	}
+
+	_, err := Score(nil)
+	assert.ErrorIs(t, err, ErrNoAnswers)
+
+	perfect, err := Score([]Answer{answer(models.CEFRLevelA1, 4, 5), answer(models.CEFRLevelC1, 4, 40)})
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, perfect.Score)
+}
+
+func TestTest(t *testing.T) {
+	prompts, _ := Prompts("en")
+	test := NewTest(prompts[:MinAnswers+1])
+
+	test.OnTranscript("My name is Ken. ")
+	test.OnTranscript("I live in Osaka.")
+	require.True(t, test.OnScore(prompts[0].ID, Rubric{4, 4, 4, 4}))
+	assert.False(t, test.OnScore(prompts[0].ID, Rubric{}), "scored twice")
+	assert.False(t, test.OnScore("unknown", Rubric{}))
+
+	_, ok := test.Finish()
+	assert.False(t, ok, "too few answers")
+
+	for _, p := range prompts[1:MinAnswers] {
+		require.True(t, test.OnScore(p.ID, Rubric{}))
+	}
+	assert.False(t, test.Complete())
+	result, ok := test.Finish()
+	require.True(t, ok)
+	assert.Equal(t, MinAnswers, result.Answers)
+	assert.Equal(t, models.CEFRLevelA1, result.Level)
+
+	_, ok = test.Finish()
+	assert.False(t, ok, "finished twice")
+}
@@ -0,0 +1,150 @@
+package placement
+
+import (
+	"errors"
+	"math"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/search"
+)
+
+const (
+	// MaxRubricScore is the best score of each rubric criterion
+	MaxRubricScore = 4
+	// PassMark is the mean answer quality needed at a level to be placed at it
+	PassMark = 0.6
+	// MinAnswers is the fewest answers an unfinished test is scored with
+	MinAnswers = 4
+
+	rubricWeight  = 0.6
+	lengthWeight  = 0.2
+	varietyWeight = 0.2
+)
+
+// ErrNoAnswers is returned when a test without answers is scored
+var ErrNoAnswers = errors.New("no answers to score")
+
+// Levels are the CEFR levels from the lowest to the highest
+var Levels = []models.CEFRLevel{
+	models.CEFRLevelA1,
+	models.CEFRLevelA2,
+	models.CEFRLevelB1,
+	models.CEFRLevelB2,
+	models.CEFRLevelC1,
+	models.CEFRLevelC2,
+}
+
+// Rank returns the position of a level in Levels starting at 1, or 0 for an unknown level
+func Rank(level models.CEFRLevel) int {
+	for i, l := range Levels {
+		if l == level {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// expectedWords is how long an answer at each level is expected to be, in words
+var expectedWords = map[models.CEFRLevel]float64{
+	models.CEFRLevelA1: 5,
+	models.CEFRLevelA2: 10,
+	models.CEFRLevelB1: 20,
+	models.CEFRLevelB2: 30,
+	models.CEFRLevelC1: 40,
+	models.CEFRLevelC2: 50,
+}
+
+// expectedVariety is the lexical variety expected at each level, as Guiraud's index
+// (distinct words divided by the square root of all words), which unlike the plain ratio
+// does not favour short answers
+var expectedVariety = map[models.CEFRLevel]float64{
+	models.CEFRLevelA1: 2,
+	models.CEFRLevelA2: 3,
+	models.CEFRLevelB1: 4,
+	models.CEFRLevelB2: 5,
+	models.CEFRLevelC1: 6,
+	models.CEFRLevelC2: 7,
+}
+
+// Rubric is the AI service's assessment of an answer, each criterion from 0 to MaxRubricScore
+type Rubric struct {
+	Grammar        int
+	Vocabulary     int
+	Fluency        int
+	TaskCompletion int
+}
+
+// mean returns the rubric's average as a fraction of the best score.
+// Out of range criteria are clamped.
+func (r Rubric) mean() float64 {
+	total := 0
+	for _, score := range []int{r.Grammar, r.Vocabulary, r.Fluency, r.TaskCompletion} {
+		total += max(0, min(score, MaxRubricScore))
+	}
+	return float64(total) / (4 * MaxRubricScore)
+}
+
+// Answer is the learner's answer to a prompt
+type Answer struct {
+	Prompt     Prompt
+	Rubric     Rubric
+	Transcript string
+}
+
+// Quality rates the answer from 0 to 1 for the level of its prompt
+func (a Answer) Quality() float64 {
+	words := search.Words(a.Transcript)
+	length := math.Min(float64(len(words))/expectedWords[a.Prompt.Level], 1)
+	variety := 0.0
+	if len(words) > 0 {
+		distinct := make(map[string]bool, len(words))
+		for _, w := range words {
+			distinct[w] = true
+		}
+		guiraud := float64(len(distinct)) / math.Sqrt(float64(len(words)))
+		variety = math.Min(guiraud/expectedVariety[a.Prompt.Level], 1)
+	}
+	return rubricWeight*a.Rubric.mean() + lengthWeight*length + varietyWeight*variety
+}
+
+// Result is the outcome of a placement test
+type Result struct {
+	Level   models.CEFRLevel
+	Score   float64 // 0 to 100, weighting harder prompts more
+	Answers int
+}
+
+// Score places the learner at the highest level up to which every answered level reaches PassMark.
+// A learner who does not pass A1 is placed at A1.
+func Score(answers []Answer) (Result, error) {
+	if len(answers) == 0 {
+		return Result{}, ErrNoAnswers
+	}
+	sums := make(map[models.CEFRLevel]float64)
+	counts := make(map[models.CEFRLevel]int)
+	weighted, weights := 0.0, 0.0
+	for _, a := range answers {
+		quality := a.Quality()
+		sums[a.Prompt.Level] += quality
+		counts[a.Prompt.Level]++
+		rank := float64(max(Rank(a.Prompt.Level), 1))
+		weighted += rank * quality
+		weights += rank
+	}
+
+	result := Result{
+		Level:   models.CEFRLevelA1,
+		Score:   math.Round(1000*weighted/weights) / 10,
+		Answers: len(answers),
+	}
+	for _, level := range Levels {
+		if counts[level] == 0 {
+			continue
+		}
+		if sums[level]/float64(counts[level]) < PassMark {
+			break
+		}
+		result.Level = level
+	}
+	return result, nil
+}
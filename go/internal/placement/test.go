@@ -0,0 +1,78 @@
+package placement
+
+import (
+	"strings"
+	"sync"
+)
+
+// Test collects the answers of a placement session as the AI service scores them.
+// It is safe for concurrent use.
+type Test struct {
+	mu         sync.Mutex
+	prompts    []Prompt
+	transcript strings.Builder // What the learner said since the last scored answer
+	answers    map[string]Answer
+	finished   bool
+}
+
+// NewTest starts a placement test asking prompts
+func NewTest(prompts []Prompt) *Test {
+	return &Test{
+		prompts: prompts,
+		answers: make(map[string]Answer, len(prompts)),
+	}
+}
+
+// OnTranscript adds a streamed part of what the learner said in answer to the current prompt
+func (t *Test) OnTranscript(text string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.transcript.WriteString(text)
+}
+
+// OnScore records the AI service's rubric for the answer to a prompt, taking what the learner
+// said since the previous score as the answer. It returns false for unknown or already scored prompts.
+func (t *Test) OnScore(promptID string, rubric Rubric) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, scored := t.answers[promptID]; scored {
+		return false
+	}
+	for _, p := range t.prompts {
+		if p.ID == promptID {
+			t.answers[promptID] = Answer{Prompt: p, Rubric: rubric, Transcript: t.transcript.String()}
+			t.transcript.Reset()
+			return true
+		}
+	}
+	return false
+}
+
+// Complete reports whether every prompt has been answered
+func (t *Test) Complete() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.answers) == len(t.prompts)
+}
+
+// Finish scores the test once it is complete or has at least MinAnswers answers.
+// It returns false when there are too few answers or the test was already finished.
+func (t *Test) Finish() (Result, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.finished || len(t.answers) < min(MinAnswers, len(t.prompts)) {
+		return Result{}, false
+	}
+	answers := make([]Answer, 0, len(t.answers))
+	for _, p := range t.prompts {
+		if a, ok := t.answers[p.ID]; ok {
+			answers = append(answers, a)
+		}
+	}
+	result, err := Score(answers)
+	if err != nil {
+		return Result{}, false
+	}
+	t.finished = true
+	return result, true
+}
@@ -0,0 +1,91 @@
+package proficiency
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+)
+
+const (
+	// batchSize is how many due sessions an analyzer claims at once
+	batchSize = 50
+	// leaseDuration keeps claimed sessions from other analyzers; a failed analysis is retried after it
+	leaseDuration = 10 * time.Minute
+)
+
+// Analyzer assesses finished conversation sessions and moves the learners' levels
+type Analyzer struct {
+	proficiency repository.ProficiencyRepository
+	now         func() time.Time
+}
+
+// NewAnalyzer creates a new proficiency analyzer
+func NewAnalyzer(proficiency repository.ProficiencyRepository) *Analyzer {
+	return &Analyzer{
+		proficiency: proficiency,
+		now:         time.Now,
+	}
+}
+
+// Run calls ProcessDue every interval until ctx is canceled
+func (a *Analyzer) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := a.ProcessDue(ctx); err != nil {
+				log.Printf("Failed to analyze practice sessions: %v", err)
+			}
+		}
+	}
+}
+
+// ProcessDue assesses a batch of due sessions. Sessions with fewer than MinUtterances utterances
+// are skipped. A session whose profile was saved meanwhile, e.g. by a placement test, is left to
+// be retried after its lease; only failures to access the database are returned.
+func (a *Analyzer) ProcessDue(ctx context.Context) error {
+	now := a.now()
+	leaseUntil := now.Add(leaseDuration)
+	sessions, err := a.proficiency.ClaimDueAnalyses(ctx, now, leaseUntil, batchSize)
+	if err != nil {
+		return err
+	}
+	for i := range sessions {
+		session := &sessions[i]
+		turns, err := a.proficiency.ListSessionTurns(ctx, session)
+		if err != nil {
+			return err
+		}
+		signals, ok := Measure(turns)
+		if !ok {
+			if err := a.proficiency.CompleteAnalysis(ctx, session.PracticeSessionsID, leaseUntil, nil, nil); err != nil {
+				return err
+			}
+			continue
+		}
+
+		profile, err := a.proficiency.GetLanguageProfile(ctx, session.UserID, session.Language)
+		if errors.Is(err, repository.ErrLanguageProfileNotFound) {
+			profile = &models.UserLanguageProfile{UserID: session.UserID, Language: session.Language}
+		} else if err != nil {
+			return err
+		}
+		change := Assess(profile, signals, session.EndedAt)
+		err = a.proficiency.CompleteAnalysis(ctx, session.PracticeSessionsID, leaseUntil, profile, change)
+		if errors.Is(err, repository.ErrLanguageProfileChanged) {
+			log.Printf("Language profile of practice session %s changed during its analysis, retrying later", session.PracticeSessionsID)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package proficiency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type completion struct {
+	profile *models.UserLanguageProfile
+	change  *models.LevelChange
+}
+
+type fakeProficiency struct {
+	repository.ProficiencyRepository
+	due       []models.PracticeSession
+	turns     map[string][]models.ConversationTurn
+	profiles  map[string]*models.UserLanguageProfile
+	completed map[string]completion
+	changed   bool
+}
+
+func (f *fakeProficiency) ClaimDueAnalyses(_ context.Context, _, _ time.Time, _ int) ([]models.PracticeSession, error) {
+	due := f.due
+	f.due = nil
+	return due, nil
+}
+
+func (f *fakeProficiency) ListSessionTurns(_ context.Context, session *models.PracticeSession) ([]models.ConversationTurn, error) {
+	return f.turns[session.PracticeSessionsID], nil
+}
+
+func (f *fakeProficiency) GetLanguageProfile(_ context.Context, userID, language string) (*models.UserLanguageProfile, error) {
+	profile, ok := f.profiles[userID+"/"+language]
+	if !ok {
+		return nil, repository.ErrLanguageProfileNotFound
+	}
+	copied := *profile
+	return &copied, nil
+}
+
+func (f *fakeProficiency) CompleteAnalysis(_ context.Context, sessionID string, _ time.Time, profile *models.UserLanguageProfile, change *models.LevelChange) error {
+	if f.changed && profile != nil {
+		return repository.ErrLanguageProfileChanged
+	}
+	f.completed[sessionID] = completion{profile: profile, change: change}
+	if profile != nil {
+		f.profiles[profile.UserID+"/"+profile.Language] = profile
+	}
+	return nil
+}
+
+var endedAt = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+func session(id, userID, language string) models.PracticeSession {
+	return models.PracticeSession{PracticeSessionsID: id, UserID: userID, Language: language, EndedAt: endedAt}
+}
+
+func TestAnalyzerProcessDue(t *testing.T) {
+	spoken := []models.ConversationTurn{
+		turn("Tôi muốn một ly cà phê sữa đá", 1),
+		turn("Bao nhiêu tiền vậy", 0),
+		turn("Cảm ơn chị nhiều lắm", 0),
+	}
+	repo := &fakeProficiency{
+		due: []models.PracticeSession{
+			session("s1", "u1", "vi"),
+			session("s2", "u1", "vi"),
+			session("s3", "u2", "vi"),
+		},
+		turns: map[string][]models.ConversationTurn{
+			"s1": spoken,
+			"s2": spoken[:2],
+			"s3": spoken,
+		},
+		profiles: map[string]*models.UserLanguageProfile{
+			"u2/vi": {UserLanguageProfilesID: "p2", UserID: "u2", Language: "vi", Level: models.CEFRLevelA1, Estimate: Anchor(models.CEFRLevelA1)},
+		},
+		completed: map[string]completion{},
+	}
+	analyzer := NewAnalyzer(repo)
+	analyzer.now = func() time.Time { return endedAt.Add(time.Minute) }
+
+	require.NoError(t, analyzer.ProcessDue(context.Background()))
+
+	// A first session creates the profile without a level
+	first := repo.completed["s1"]
+	require.NotNil(t, first.profile)
+	assert.Equal(t, "", first.profile.UserLanguageProfilesID)
+	assert.Equal(t, 1, first.profile.AssessedSessions)
+	assert.Equal(t, models.CEFRLevel(""), first.profile.Level)
+	assert.Nil(t, first.change)
+
+	// Too few utterances to assess
+	short, ok := repo.completed["s2"]
+	require.True(t, ok)
+	assert.Nil(t, short.profile)
+
+	placed := repo.completed["s3"]
+	require.NotNil(t, placed.profile)
+	assert.Equal(t, "p2", placed.profile.UserLanguageProfilesID)
+	assert.Equal(t, &endedAt, placed.profile.AssessedAt)
+	assert.Greater(t, placed.profile.Estimate, Anchor(models.CEFRLevelA1))
+}
+
+func TestAnalyzerProcessDueProfileChanged(t *testing.T) {
+	repo := &fakeProficiency{
+		due: []models.PracticeSession{session("s1", "u1", "en")},
+		turns: map[string][]models.ConversationTurn{
+			"s1": {turn("I went to the market", 0), turn("It was busy", 0), turn("I bought some fruit", 0)},
+		},
+		profiles:  map[string]*models.UserLanguageProfile{},
+		completed: map[string]completion{},
+		changed:   true,
+	}
+	analyzer := NewAnalyzer(repo)
+
+	// The session is left for a later retry rather than failing the batch
+	require.NoError(t, analyzer.ProcessDue(context.Background()))
+	assert.Empty(t, repo.completed)
+}
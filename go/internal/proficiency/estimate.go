@@ -0,0 +1,152 @@
+package proficiency
+
+import (
+	"math"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/placement"
+)
+
+const (
+	// MinSessions is how many assessed sessions give a level to a learner who took no placement test
+	MinSessions = 3
+	// Smoothing is the weight of the latest session in the rolling averages
+	Smoothing = 0.2
+	// Margin is how far past a level boundary the estimate must be for the level to move
+	Margin = 0.25
+
+	// minEstimate and maxEstimate bound the scale: n is the bottom of the nth level, A1 is 1
+	minEstimate = 1.0
+	maxEstimate = 7.0
+)
+
+// benchmark is what a signal typically measures at the bottom of each level, from A1 to C2
+type benchmark struct {
+	signal Signal
+	weight float64
+	points [6]float64
+	value  func(models.LanguageSignals) float64
+}
+
+// benchmarks weigh the signals: corrections and utterance length say the most about a level
+var benchmarks = []benchmark{
+	{SignalWordsPerUtterance, 0.3, [6]float64{2, 4, 7, 10, 14, 18}, func(s models.LanguageSignals) float64 { return s.WordsPerUtterance }},
+	{SignalLexicalDiversity, 0.25, [6]float64{2.5, 3.5, 4.5, 5.5, 6.5, 7.5}, func(s models.LanguageSignals) float64 { return s.LexicalDiversity }},
+	{SignalErrorRate, 0.3, [6]float64{1, 0.7, 0.45, 0.3, 0.15, 0.05}, func(s models.LanguageSignals) float64 { return s.ErrorRate }},
+	{SignalTranslationRate, 0.15, [6]float64{0.3, 0.2, 0.1, 0.05, 0.02, 0}, func(s models.LanguageSignals) float64 { return s.TranslationRate }},
+}
+
+// Reading is a signal's value with the level it suggests on its own
+type Reading struct {
+	Signal   Signal
+	Value    float64
+	Estimate float64
+}
+
+// Readings returns the level each signal suggests, in a fixed order
+func Readings(signals models.LanguageSignals) []Reading {
+	readings := make([]Reading, 0, len(benchmarks))
+	for _, b := range benchmarks {
+		value := b.value(signals)
+		readings = append(readings, Reading{Signal: b.signal, Value: value, Estimate: position(value, b.points)})
+	}
+	return readings
+}
+
+// Estimate returns the level the signals suggest together
+func Estimate(signals models.LanguageSignals) float64 {
+	estimate := 0.0
+	for i, reading := range Readings(signals) {
+		estimate += benchmarks[i].weight * reading.Estimate
+	}
+	return estimate
+}
+
+// position places value on the scale by interpolating between the benchmarks of adjacent levels.
+// Benchmarks may fall, for measures where less is better. Values beyond C2 extrapolate up to maxEstimate.
+func position(value float64, points [6]float64) float64 {
+	if points[len(points)-1] < points[0] {
+		value = -value
+		for i := range points {
+			points[i] = -points[i]
+		}
+	}
+	if value <= points[0] {
+		return minEstimate
+	}
+	for i := 1; i < len(points); i++ {
+		if value < points[i] {
+			return minEstimate + float64(i-1) + (value-points[i-1])/(points[i]-points[i-1])
+		}
+	}
+	last := len(points) - 1
+	beyond := (value - points[last]) / (points[last] - points[last-1])
+	return math.Min(minEstimate+float64(last)+beyond, maxEstimate)
+}
+
+// Anchor returns the estimate of a learner placed at level: the middle of the level
+func Anchor(level models.CEFRLevel) float64 {
+	return float64(max(placement.Rank(level), 1)) + 0.5
+}
+
+// LevelAt returns the level an estimate falls in
+func LevelAt(estimate float64) models.CEFRLevel {
+	i := int(math.Floor(estimate)) - 1
+	return placement.Levels[max(0, min(i, len(placement.Levels)-1))]
+}
+
+// Assess folds the signals of a session into the profile's rolling averages and estimate at the time at.
+// A profile without a level gets one after MinSessions sessions; otherwise the level moves once the
+// estimate is more than Margin past the level's bounds. It returns the change when the level moved.
+func Assess(profile *models.UserLanguageProfile, signals models.LanguageSignals, at time.Time) *models.LevelChange {
+	if profile.AssessedSessions == 0 {
+		profile.Signals = signals
+	} else {
+		profile.Signals = models.LanguageSignals{
+			WordsPerUtterance: smooth(profile.Signals.WordsPerUtterance, signals.WordsPerUtterance),
+			LexicalDiversity:  smooth(profile.Signals.LexicalDiversity, signals.LexicalDiversity),
+			ErrorRate:         smooth(profile.Signals.ErrorRate, signals.ErrorRate),
+			TranslationRate:   smooth(profile.Signals.TranslationRate, signals.TranslationRate),
+		}
+	}
+	if session := Estimate(signals); profile.Estimate == 0 {
+		profile.Estimate = session
+	} else {
+		profile.Estimate = smooth(profile.Estimate, session)
+	}
+	profile.AssessedSessions++
+	profile.AssessedAt = &at
+
+	if profile.Level == "" {
+		if profile.AssessedSessions < MinSessions {
+			return nil
+		}
+	} else {
+		rank := float64(placement.Rank(profile.Level))
+		if profile.Estimate < rank+1+Margin && profile.Estimate >= rank-Margin {
+			return nil
+		}
+	}
+	level := LevelAt(profile.Estimate)
+	if level == profile.Level {
+		return nil
+	}
+	change := &models.LevelChange{
+		UserID:        profile.UserID,
+		Language:      profile.Language,
+		Source:        models.LevelChangeSourceConversation,
+		PreviousLevel: profile.Level,
+		Level:         level,
+		Estimate:      profile.Estimate,
+		Signals:       profile.Signals,
+		CreatedAt:     at,
+	}
+	profile.Level = level
+	return change
+}
+
+// smooth moves a rolling average towards the latest value by Smoothing
+func smooth(average, latest float64) float64 {
+	return average + Smoothing*(latest-average)
+}
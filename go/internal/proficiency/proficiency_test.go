@@ -0,0 +1,166 @@
+package proficiency
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func turn(transcript string, corrections int) models.ConversationTurn {
+	return models.ConversationTurn{UserTranscript: transcript, Feedback: make([]models.TurnFeedback, corrections)}
+}
+
+func TestMeasure(t *testing.T) {
+	signals, ok := Measure([]models.ConversationTurn{
+		turn("I like coffee", 1),
+		turn("", 0),
+		turn("How do you say tea", 0),
+		turn("I like green tea", 2),
+	})
+	require.True(t, ok)
+	assert.InDelta(t, 4.0, signals.WordsPerUtterance, 1e-9)
+	// i, like, coffee, how, do, you, say, tea, green
+	assert.InDelta(t, 9/math.Sqrt(12), signals.LexicalDiversity, 1e-9)
+	assert.InDelta(t, 1.0, signals.ErrorRate, 1e-9)
+	assert.InDelta(t, 1.0/3, signals.TranslationRate, 1e-9)
+
+	_, ok = Measure([]models.ConversationTurn{turn("hello", 0), turn("", 0), turn("bye", 0)})
+	assert.False(t, ok)
+}
+
+func TestAsksTranslation(t *testing.T) {
+	tests := []struct {
+		transcript string
+		want       bool
+	}{
+		{"How do you say 'receipt' in Vietnamese?", true},
+		{"What does bánh mì mean?", true},
+		{"「領収書」ってどういう意味？", true},
+		{"Từ này nghĩa là gì?", true},
+		{"Bạn dịch giúp tôi được không?", true},
+		{"Tôi làm ở công ty dịch vụ", false},
+		{"What does your sister do?", false},
+		{"I say hello every morning", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.transcript, func(t *testing.T) {
+			assert.Equal(t, tt.want, AsksTranslation(tt.transcript))
+		})
+	}
+}
+
+func TestReadings(t *testing.T) {
+	readings := Readings(models.LanguageSignals{
+		WordsPerUtterance: 8.5,
+		LexicalDiversity:  2,
+		ErrorRate:         0.3,
+		TranslationRate:   0,
+	})
+	require.Len(t, readings, 4)
+	assert.Equal(t, SignalWordsPerUtterance, readings[0].Signal)
+	assert.InDelta(t, 3.5, readings[0].Estimate, 1e-9)
+	assert.InDelta(t, 1.0, readings[1].Estimate, 1e-9)
+	assert.InDelta(t, 4.0, readings[2].Estimate, 1e-9)
+	// No translation requests at all is as good as C2
+	assert.InDelta(t, 6.0, readings[3].Estimate, 1e-9)
+
+	assert.InDelta(t, 0.3*3.5+0.25*1+0.3*4+0.15*6, Estimate(models.LanguageSignals{
+		WordsPerUtterance: 8.5, LexicalDiversity: 2, ErrorRate: 0.3,
+	}), 1e-9)
+}
+
+func TestPosition(t *testing.T) {
+	points := [6]float64{2, 4, 7, 10, 14, 18}
+	assert.Equal(t, 1.0, position(0, points))
+	assert.InDelta(t, 1.5, position(3, points), 1e-9)
+	assert.InDelta(t, 6.5, position(20, points), 1e-9)
+	assert.Equal(t, 7.0, position(100, points))
+
+	falling := [6]float64{1, 0.7, 0.45, 0.3, 0.15, 0.05}
+	assert.Equal(t, 1.0, position(2, falling))
+	assert.InDelta(t, 2.0, position(0.7, falling), 1e-9)
+	assert.InDelta(t, 6.5, position(0, falling), 1e-9)
+}
+
+func TestLevelAt(t *testing.T) {
+	assert.Equal(t, models.CEFRLevelA1, LevelAt(1))
+	assert.Equal(t, models.CEFRLevelB1, LevelAt(3.99))
+	assert.Equal(t, models.CEFRLevelC2, LevelAt(7))
+	assert.Equal(t, 3.5, Anchor(models.CEFRLevelB1))
+}
+
+// signalsAt returns signals whose every reading suggests the estimate
+func signalsAt(estimate float64) models.LanguageSignals {
+	var signals models.LanguageSignals
+	for _, b := range benchmarks {
+		i := int(estimate) - 1
+		value := b.points[i] + (estimate-float64(i+1))*(b.points[i+1]-b.points[i])
+		switch b.signal {
+		case SignalWordsPerUtterance:
+			signals.WordsPerUtterance = value
+		case SignalLexicalDiversity:
+			signals.LexicalDiversity = value
+		case SignalErrorRate:
+			signals.ErrorRate = value
+		case SignalTranslationRate:
+			signals.TranslationRate = value
+		}
+	}
+	return signals
+}
+
+func TestAssess(t *testing.T) {
+	at := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("an unplaced learner gets a level after MinSessions sessions", func(t *testing.T) {
+		profile := &models.UserLanguageProfile{UserID: "u1", Language: "vi"}
+		for i := 1; i < MinSessions; i++ {
+			assert.Nil(t, Assess(profile, signalsAt(2.5), at))
+		}
+		change := Assess(profile, signalsAt(2.5), at)
+		require.NotNil(t, change)
+		assert.Equal(t, models.CEFRLevelA2, change.Level)
+		assert.Equal(t, models.CEFRLevel(""), change.PreviousLevel)
+		assert.Equal(t, models.LevelChangeSourceConversation, change.Source)
+		assert.Equal(t, "vi", change.Language)
+		assert.Equal(t, models.CEFRLevelA2, profile.Level)
+		assert.Equal(t, MinSessions, profile.AssessedSessions)
+		assert.Equal(t, &at, profile.AssessedAt)
+	})
+
+	t.Run("one strong session does not move a placed learner", func(t *testing.T) {
+		profile := &models.UserLanguageProfile{Level: models.CEFRLevelB1, Estimate: Anchor(models.CEFRLevelB1)}
+		assert.Nil(t, Assess(profile, signalsAt(5.5), at))
+		assert.InDelta(t, 3.5+Smoothing*2, profile.Estimate, 1e-9)
+		assert.Equal(t, models.CEFRLevelB1, profile.Level)
+		assert.Equal(t, signalsAt(5.5), profile.Signals)
+	})
+
+	t.Run("the level rises once the estimate is past the boundary by the margin", func(t *testing.T) {
+		profile := &models.UserLanguageProfile{Level: models.CEFRLevelB1, Estimate: Anchor(models.CEFRLevelB1)}
+		var change *models.LevelChange
+		sessions := 0
+		for change == nil && sessions < 20 {
+			change = Assess(profile, signalsAt(5.5), at)
+			sessions++
+		}
+		require.NotNil(t, change)
+		assert.Equal(t, models.CEFRLevelB1, change.PreviousLevel)
+		assert.Equal(t, models.CEFRLevelB2, change.Level)
+		assert.GreaterOrEqual(t, change.Estimate, 4+Margin)
+		assert.Equal(t, profile.Signals, change.Signals)
+		assert.Equal(t, 3, sessions)
+	})
+
+	t.Run("the level falls below the margin", func(t *testing.T) {
+		profile := &models.UserLanguageProfile{Level: models.CEFRLevelB1, Estimate: 3.1, AssessedSessions: 4, Signals: signalsAt(3.1)}
+		change := Assess(profile, signalsAt(1.2), at)
+		require.NotNil(t, change)
+		assert.Equal(t, models.CEFRLevelA2, change.Level)
+		assert.InDelta(t, 3.1+Smoothing*(1.2-3.1), change.Estimate, 1e-9)
+	})
+}
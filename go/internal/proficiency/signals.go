@@ -0,0 +1,86 @@
+// Package proficiency keeps learners' levels in step with how they speak in conversations.
+//
+// After a conversation session, Analyzer measures what the learner said: words per utterance,
+// lexical diversity, corrections per utterance and how often they asked for a translation.
+// Each measure suggests a level on a continuous scale, and their weighted mean is folded into
+// a rolling estimate per language. The level only moves once the estimate is clearly past a
+// level boundary, so one good or bad day does not flip it, and every move is recorded with the
+// signals behind it. The level is sent to the AI service as the difficulty of the next session.
+package proficiency
+
+import (
+	"math"
+	"strings"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/search"
+	"golang.org/x/text/unicode/norm"
+)
+
+// MinUtterances is the fewest utterances a session needs to be assessed
+const MinUtterances = 3
+
+// Signal names a measure of the learner's speech
+type Signal string
+
+const (
+	SignalWordsPerUtterance Signal = "PROFICIENCY_SIGNAL_WORDS_PER_UTTERANCE"
+	SignalLexicalDiversity  Signal = "PROFICIENCY_SIGNAL_LEXICAL_DIVERSITY"
+	SignalErrorRate         Signal = "PROFICIENCY_SIGNAL_ERROR_RATE"
+	SignalTranslationRate   Signal = "PROFICIENCY_SIGNAL_TRANSLATION_RATE"
+)
+
+// Measure computes the signals of what the learner said in turns, counting the corrections
+// given on them. It reports false when the turns hold fewer than MinUtterances utterances.
+func Measure(turns []models.ConversationTurn) (models.LanguageSignals, bool) {
+	utterances, words, corrections, translations := 0, 0, 0, 0
+	distinct := make(map[string]bool)
+	for _, turn := range turns {
+		spoken := search.Words(turn.UserTranscript)
+		if len(spoken) == 0 {
+			continue
+		}
+		utterances++
+		words += len(spoken)
+		for _, w := range spoken {
+			distinct[w] = true
+		}
+		corrections += len(turn.Feedback)
+		if AsksTranslation(turn.UserTranscript) {
+			translations++
+		}
+	}
+	if utterances < MinUtterances {
+		return models.LanguageSignals{}, false
+	}
+	return models.LanguageSignals{
+		WordsPerUtterance: float64(words) / float64(utterances),
+		LexicalDiversity:  float64(len(distinct)) / math.Sqrt(float64(words)),
+		ErrorRate:         float64(corrections) / float64(utterances),
+		TranslationRate:   float64(translations) / float64(utterances),
+	}, true
+}
+
+// translationPhrases are how learners ask for a translation, in the practice languages
+// and in the native languages they may fall back to
+var translationPhrases = []string{
+	"how do you say", "how do i say", "how to say", "what does that mean", "what does it mean",
+	"what is that in", "translate",
+	"どういう意味", "何て言う", "なんて言う", "何と言う", "なんと言う", "訳して", "翻訳",
+	"nghĩa là gì", "nói thế nào", "nói như thế nào", "dịch giúp", "dịch sang", "dịch ra",
+}
+
+// AsksTranslation reports whether the learner asked for a translation or the meaning of a word
+func AsksTranslation(transcript string) bool {
+	text := strings.ToLower(norm.NFC.String(transcript))
+	for _, phrase := range translationPhrases {
+		if strings.Contains(text, phrase) {
+			return true
+		}
+	}
+	// "What does X mean?" names the word in between
+	if i := strings.Index(text, "what does "); i >= 0 && strings.Contains(text[i:], " mean") {
+		return true
+	}
+	return false
+}
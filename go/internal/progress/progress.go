@@ -0,0 +1,232 @@
+// Package progress computes a learner's practice statistics and streaks from their practice sessions.
+// Sessions count on the calendar day they started on in the learner's time zone.
+package progress
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+const (
+	// StreakGraceDays is how many days in a row without practice a streak survives
+	StreakGraceDays = 1
+	// ChartWeeks is how many weeks, starting on Monday, the weekly chart covers
+	ChartWeeks = 12
+	// ChartMonths is how many calendar months the monthly chart covers
+	ChartMonths = 12
+)
+
+// Totals is the practice of a period
+type Totals struct {
+	Spoken     time.Duration // Audio the learner spoke
+	Listened   time.Duration // Audio of the AI the learner listened to
+	Turns      int
+	Sessions   int
+	Characters int // Distinct characters talked to
+	Languages  int // Distinct languages practised
+	Days       int // Days with at least one session
+}
+
+// Bucket is the practice of one chart period
+type Bucket struct {
+	Start time.Time // First day of the period, as midnight UTC of the calendar date
+	Totals
+}
+
+// LanguageTotals is the practice of one language over all time
+type LanguageTotals struct {
+	Language        string
+	LastPracticedAt time.Time
+	Totals
+}
+
+// Streak counts the days of practice in a row, where up to StreakGraceDays missed days do not break the row.
+// Missed days are not counted.
+type Streak struct {
+	Current int // Zero once more than StreakGraceDays days passed without practice
+	Longest int
+}
+
+// Progress is a learner's practice statistics
+type Progress struct {
+	Total     Totals
+	Streak    Streak
+	Weekly    []Bucket         // Oldest first, ending with the current week
+	Monthly   []Bucket         // Oldest first, ending with the current month
+	Languages []LanguageTotals // Most spoken first
+}
+
+// Compute returns the progress of the sessions as of now, counting days in loc
+func Compute(sessions []models.PracticeSession, loc *time.Location, now time.Time) Progress {
+	today := Day(now, loc)
+	weekStart := today.AddDate(0, 0, -(int(today.Weekday())+6)%7)
+	monthStart := today.AddDate(0, 0, 1-today.Day())
+
+	weekly := newTallies(func(i int) time.Time { return weekStart.AddDate(0, 0, 7*(i-ChartWeeks+1)) }, ChartWeeks)
+	monthly := newTallies(func(i int) time.Time { return monthStart.AddDate(0, i-ChartMonths+1, 0) }, ChartMonths)
+	total := newTally()
+	languages := make(map[string]*tally)
+	lastPracticed := make(map[string]time.Time)
+
+	for i := range sessions {
+		s := &sessions[i]
+		day := Day(s.StartedAt, loc)
+		total.add(s, day)
+		if languages[s.Language] == nil {
+			languages[s.Language] = newTally()
+		}
+		languages[s.Language].add(s, day)
+		if s.StartedAt.After(lastPracticed[s.Language]) {
+			lastPracticed[s.Language] = s.StartedAt
+		}
+		weekly.add(s, day)
+		monthly.add(s, day)
+	}
+
+	progress := Progress{
+		Total:   total.totals(),
+		Streak:  streak(total.sortedDays(), today),
+		Weekly:  weekly.buckets(),
+		Monthly: monthly.buckets(),
+	}
+	for language, t := range languages {
+		progress.Languages = append(progress.Languages, LanguageTotals{
+			Language:        language,
+			LastPracticedAt: lastPracticed[language],
+			Totals:          t.totals(),
+		})
+	}
+	slices.SortFunc(progress.Languages, func(a, b LanguageTotals) int {
+		return cmp.Or(cmp.Compare(b.Spoken, a.Spoken), cmp.Compare(a.Language, b.Language))
+	})
+	return progress
+}
+
+// Sum returns the totals of the sessions, counting days in loc
+func Sum(sessions []models.PracticeSession, loc *time.Location) Totals {
+	t := newTally()
+	for i := range sessions {
+		t.add(&sessions[i], Day(sessions[i].StartedAt, loc))
+	}
+	return t.totals()
+}
+
+// Location returns the time zone a user set, or fallback when the user set none or it is no longer known
+func Location(name string, fallback *time.Location) *time.Location {
+	if name == "" {
+		return fallback
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return fallback
+	}
+	return loc
+}
+
+// Day returns the calendar date of t in loc as midnight UTC
+func Day(t time.Time, loc *time.Location) time.Time {
+	year, month, day := t.In(loc).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// streak counts the practice days in a row among days, sorted ascending, as of today
+func streak(days []time.Time, today time.Time) Streak {
+	var s Streak
+	run := 0
+	for i, day := range days {
+		if i > 0 && daysBetween(days[i-1], day) > StreakGraceDays+1 {
+			run = 0
+		}
+		run++
+		s.Longest = max(s.Longest, run)
+	}
+	if len(days) > 0 && daysBetween(days[len(days)-1], today) <= StreakGraceDays+1 {
+		s.Current = run
+	}
+	return s
+}
+
+func daysBetween(from, to time.Time) int {
+	return int(to.Sub(from) / (24 * time.Hour))
+}
+
+// tally accumulates the totals of a period, counting distinct characters, languages and days
+type tally struct {
+	t          Totals
+	characters map[string]bool
+	languages  map[string]bool
+	days       map[time.Time]bool
+}
+
+func newTally() *tally {
+	return &tally{
+		characters: make(map[string]bool),
+		languages:  make(map[string]bool),
+		days:       make(map[time.Time]bool),
+	}
+}
+
+func (t *tally) add(s *models.PracticeSession, day time.Time) {
+	t.t.Spoken += time.Duration(s.UserAudioMs) * time.Millisecond
+	t.t.Listened += time.Duration(s.AIAudioMs) * time.Millisecond
+	t.t.Turns += s.Turns
+	t.t.Sessions++
+	t.characters[s.Character] = true
+	t.languages[s.Language] = true
+	t.days[day] = true
+}
+
+func (t *tally) totals() Totals {
+	totals := t.t
+	totals.Characters = len(t.characters)
+	totals.Languages = len(t.languages)
+	totals.Days = len(t.days)
+	return totals
+}
+
+func (t *tally) sortedDays() []time.Time {
+	days := make([]time.Time, 0, len(t.days))
+	for day := range t.days {
+		days = append(days, day)
+	}
+	slices.SortFunc(days, func(a, b time.Time) int { return a.Compare(b) })
+	return days
+}
+
+// tallies are the consecutive periods of a chart
+type tallies struct {
+	starts []time.Time
+	period []*tally
+}
+
+func newTallies(start func(i int) time.Time, n int) *tallies {
+	t := &tallies{starts: make([]time.Time, n), period: make([]*tally, n)}
+	for i := range n {
+		t.starts[i] = start(i)
+		t.period[i] = newTally()
+	}
+	return t
+}
+
+// add counts a session in the period containing day, if the chart covers it
+func (t *tallies) add(s *models.PracticeSession, day time.Time) {
+	if day.Before(t.starts[0]) {
+		return
+	}
+	i, found := slices.BinarySearchFunc(t.starts, day, func(start, day time.Time) int { return start.Compare(day) })
+	if !found {
+		i--
+	}
+	t.period[i].add(s, day)
+}
+
+func (t *tallies) buckets() []Bucket {
+	buckets := make([]Bucket, len(t.starts))
+	for i, start := range t.starts {
+		buckets[i] = Bucket{Start: start, Totals: t.period[i].totals()}
+	}
+	return buckets
+}
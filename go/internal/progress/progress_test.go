@@ -0,0 +1,141 @@
+package progress
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var tokyo = time.FixedZone("JST", 9*60*60)
+
+func session(startedAt time.Time, language, character string) models.PracticeSession {
+	return models.PracticeSession{
+		Language:    language,
+		Character:   character,
+		StartedAt:   startedAt,
+		UserAudioMs: 60_000,
+		AIAudioMs:   90_000,
+		Turns:       4,
+	}
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestDay_CountsInLocation(t *testing.T) {
+	// 2026-01-31 16:00 UTC is already February 1st in Tokyo
+	at := time.Date(2026, 1, 31, 16, 0, 0, 0, time.UTC)
+	assert.Equal(t, date(2026, 2, 1), Day(at, tokyo))
+	assert.Equal(t, date(2026, 1, 31), Day(at, time.UTC))
+}
+
+func TestLocation(t *testing.T) {
+	assert.Equal(t, tokyo, Location("", tokyo))
+	assert.Equal(t, tokyo, Location("Nowhere/Unknown", tokyo))
+	assert.Equal(t, "Europe/Paris", Location("Europe/Paris", tokyo).String())
+}
+
+func TestStreak(t *testing.T) {
+	today := date(2026, 10, 19)
+	tests := []struct {
+		name string
+		days []time.Time
+		want Streak
+	}{
+		{name: "never practised", want: Streak{}},
+		{name: "today only", days: []time.Time{today}, want: Streak{Current: 1, Longest: 1}},
+		{
+			name: "consecutive days up to yesterday",
+			days: []time.Time{date(2026, 10, 16), date(2026, 10, 17), date(2026, 10, 18)},
+			want: Streak{Current: 3, Longest: 3},
+		},
+		{
+			name: "one missed day is forgiven",
+			days: []time.Time{date(2026, 10, 15), date(2026, 10, 16), date(2026, 10, 18), today},
+			want: Streak{Current: 4, Longest: 4},
+		},
+		{
+			name: "two missed days break the streak",
+			days: []time.Time{date(2026, 10, 13), date(2026, 10, 14), date(2026, 10, 15), date(2026, 10, 18), today},
+			want: Streak{Current: 2, Longest: 3},
+		},
+		{
+			name: "grace keeps the streak until the end of today",
+			days: []time.Time{date(2026, 10, 16), date(2026, 10, 17)},
+			want: Streak{Current: 2, Longest: 2},
+		},
+		{
+			name: "lapsed streak",
+			days: []time.Time{date(2026, 10, 15), date(2026, 10, 16)},
+			want: Streak{Current: 0, Longest: 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, streak(tt.days, today))
+		})
+	}
+}
+
+func TestCompute(t *testing.T) {
+	// Monday 2026-10-19, 10:00 in Tokyo
+	now := time.Date(2026, 10, 19, 1, 0, 0, 0, time.UTC)
+	sessions := []models.PracticeSession{
+		session(time.Date(2026, 9, 30, 23, 0, 0, 0, time.UTC), "en", "friend"), // October 1st in Tokyo
+		session(time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC), "vi", "teacher"),
+		session(time.Date(2026, 10, 18, 16, 0, 0, 0, time.UTC), "en", "friend"), // Monday in Tokyo
+		session(time.Date(2026, 10, 19, 0, 30, 0, 0, time.UTC), "en", "teacher"),
+	}
+
+	progress := Compute(sessions, tokyo, now)
+
+	assert.Equal(t, Totals{
+		Spoken:     4 * time.Minute,
+		Listened:   6 * time.Minute,
+		Turns:      16,
+		Sessions:   4,
+		Characters: 2,
+		Languages:  2,
+		Days:       3,
+	}, progress.Total)
+	assert.Equal(t, Streak{Current: 2, Longest: 2}, progress.Streak)
+
+	require.Len(t, progress.Weekly, ChartWeeks)
+	thisWeek := progress.Weekly[ChartWeeks-1]
+	assert.Equal(t, date(2026, 10, 19), thisWeek.Start)
+	assert.Equal(t, 2, thisWeek.Sessions)
+	assert.Equal(t, 1, thisWeek.Days)
+	lastWeek := progress.Weekly[ChartWeeks-2]
+	assert.Equal(t, date(2026, 10, 12), lastWeek.Start)
+	assert.Equal(t, 1, lastWeek.Sessions)
+
+	require.Len(t, progress.Monthly, ChartMonths)
+	thisMonth := progress.Monthly[ChartMonths-1]
+	assert.Equal(t, date(2026, 10, 1), thisMonth.Start)
+	assert.Equal(t, 4, thisMonth.Sessions)
+	assert.Equal(t, date(2025, 11, 1), progress.Monthly[0].Start)
+	assert.Zero(t, progress.Monthly[ChartMonths-2].Sessions)
+
+	require.Len(t, progress.Languages, 2)
+	assert.Equal(t, "en", progress.Languages[0].Language)
+	assert.Equal(t, 3, progress.Languages[0].Sessions)
+	assert.Equal(t, 2, progress.Languages[0].Characters)
+	assert.Equal(t, sessions[3].StartedAt, progress.Languages[0].LastPracticedAt)
+	assert.Equal(t, "vi", progress.Languages[1].Language)
+}
+
+func TestCompute_IgnoresSessionsBeforeCharts(t *testing.T) {
+	now := time.Date(2026, 10, 19, 1, 0, 0, 0, time.UTC)
+	sessions := []models.PracticeSession{session(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), "en", "friend")}
+
+	progress := Compute(sessions, time.UTC, now)
+
+	assert.Equal(t, 1, progress.Total.Sessions)
+	for _, bucket := range append(progress.Weekly, progress.Monthly...) {
+		assert.Zero(t, bucket.Sessions)
+	}
+}
@@ -0,0 +1,90 @@
+// Package reminder keeps learners' daily goals and reminds them to practise when a goal is not reached yet.
+//
+// A reminder is due at a minute of the day in the learner's time zone. The next due time is stored
+// with the goal, so schedules survive restarts, and Scheduler claims due goals with a lease so
+// several servers never send the same reminder twice.
+package reminder
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/progress"
+)
+
+const (
+	// MaxTargetMinutes is the largest daily goal in minutes
+	MaxTargetMinutes = 600
+	// MaxTargetSessions is the largest daily goal in sessions
+	MaxTargetSessions = 50
+	// MinutesPerDay bounds the minute of the day a reminder is sent at
+	MinutesPerDay = 24 * 60
+	// DefaultReminderMinute is when reminders are sent unless the user picks a time: 20:00
+	DefaultReminderMinute = 20 * 60
+)
+
+// ValidateGoal checks the target of a goal and the time of its reminder
+func ValidateGoal(goal *models.LearningGoal) error {
+	switch goal.Metric {
+	case models.GoalMetricMinutes:
+		if goal.Target < 1 || goal.Target > MaxTargetMinutes {
+			return fmt.Errorf("target must be between 1 and %d minutes", MaxTargetMinutes)
+		}
+	case models.GoalMetricSessions:
+		if goal.Target < 1 || goal.Target > MaxTargetSessions {
+			return fmt.Errorf("target must be between 1 and %d sessions", MaxTargetSessions)
+		}
+	default:
+		return fmt.Errorf("unknown goal metric %q", goal.Metric)
+	}
+	if goal.ReminderMinute < 0 || goal.ReminderMinute >= MinutesPerDay {
+		return fmt.Errorf("reminder minute must be between 0 and %d", MinutesPerDay-1)
+	}
+	switch goal.ReminderChannel {
+	case models.NotificationChannelInApp, models.NotificationChannelEmail:
+	default:
+		return fmt.Errorf("unknown notification channel %q", goal.ReminderChannel)
+	}
+	return nil
+}
+
+// Schedule sets when the goal's reminder is due next after now, or clears it when reminders are off
+func Schedule(goal *models.LearningGoal, loc *time.Location, now time.Time) {
+	if !goal.ReminderEnabled {
+		goal.NextReminderAt = nil
+		return
+	}
+	next := Next(goal.ReminderMinute, loc, now)
+	goal.NextReminderAt = &next
+}
+
+// Next returns the first time after after at which the clock in loc shows minute of the day.
+// On days the minute is skipped by a daylight saving change, the reminder moves forward with the clock.
+func Next(minute int, loc *time.Location, after time.Time) time.Time {
+	year, month, day := after.In(loc).Date()
+	at := time.Date(year, month, day, minute/60, minute%60, 0, 0, loc)
+	if !at.After(after) {
+		at = time.Date(year, month, day+1, minute/60, minute%60, 0, 0, loc)
+	}
+	return at
+}
+
+// StartOfDay returns the midnight in loc that starts the day containing t
+func StartOfDay(t time.Time, loc *time.Location) time.Time {
+	year, month, day := t.In(loc).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, loc)
+}
+
+// Achieved returns how much of the goal today's practice covers, in the goal's unit
+func Achieved(goal *models.LearningGoal, today progress.Totals) int {
+	if goal.Metric == models.GoalMetricSessions {
+		return today.Sessions
+	}
+	return int((today.Spoken + today.Listened) / time.Minute)
+}
+
+// Met reports whether today's practice reaches the goal
+func Met(goal *models.LearningGoal, today progress.Totals) bool {
+	return Achieved(goal, today) >= goal.Target
+}
@@ -0,0 +1,108 @@
+package reminder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/progress"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNext(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		minute int
+		loc    *time.Location
+		after  time.Time
+		want   time.Time
+	}{
+		{
+			name:   "later today",
+			minute: 20 * 60,
+			loc:    tokyo,
+			after:  time.Date(2026, 10, 19, 9, 0, 0, 0, tokyo),
+			want:   time.Date(2026, 10, 19, 20, 0, 0, 0, tokyo),
+		},
+		{
+			name:   "already passed today",
+			minute: 8 * 60,
+			loc:    tokyo,
+			after:  time.Date(2026, 10, 19, 9, 0, 0, 0, tokyo),
+			want:   time.Date(2026, 10, 20, 8, 0, 0, 0, tokyo),
+		},
+		{
+			name:   "exactly now is the next day",
+			minute: 9 * 60,
+			loc:    tokyo,
+			after:  time.Date(2026, 10, 19, 9, 0, 0, 0, tokyo),
+			want:   time.Date(2026, 10, 20, 9, 0, 0, 0, tokyo),
+		},
+		{
+			name:   "day of the user's time zone, not UTC",
+			minute: 23*60 + 30,
+			loc:    tokyo,
+			after:  time.Date(2026, 10, 19, 15, 0, 0, 0, time.UTC), // Already 00:00 on the 20th in Tokyo
+			want:   time.Date(2026, 10, 20, 23, 30, 0, 0, tokyo),
+		},
+		{
+			name:   "keeps the local time across daylight saving",
+			minute: 19 * 60,
+			loc:    newYork,
+			after:  time.Date(2026, 11, 1, 20, 0, 0, 0, newYork), // Clocks went back that morning
+			want:   time.Date(2026, 11, 2, 19, 0, 0, 0, newYork),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Next(tt.minute, tt.loc, tt.after)
+			assert.True(t, tt.want.Equal(got), "want %s, got %s", tt.want, got)
+		})
+	}
+}
+
+func TestValidateGoal(t *testing.T) {
+	valid := models.LearningGoal{
+		Metric:          models.GoalMetricMinutes,
+		Target:          15,
+		ReminderMinute:  20 * 60,
+		ReminderChannel: models.NotificationChannelEmail,
+	}
+	assert.NoError(t, ValidateGoal(&valid))
+
+	tests := []struct {
+		name   string
+		modify func(goal *models.LearningGoal)
+	}{
+		{name: "no target", modify: func(goal *models.LearningGoal) { goal.Target = 0 }},
+		{name: "too many minutes", modify: func(goal *models.LearningGoal) { goal.Target = MaxTargetMinutes + 1 }},
+		{name: "too many sessions", modify: func(goal *models.LearningGoal) {
+			goal.Metric, goal.Target = models.GoalMetricSessions, MaxTargetSessions+1
+		}},
+		{name: "unknown metric", modify: func(goal *models.LearningGoal) { goal.Metric = "" }},
+		{name: "minute past the day", modify: func(goal *models.LearningGoal) { goal.ReminderMinute = MinutesPerDay }},
+		{name: "unknown channel", modify: func(goal *models.LearningGoal) { goal.ReminderChannel = "" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			goal := valid
+			tt.modify(&goal)
+			assert.Error(t, ValidateGoal(&goal))
+		})
+	}
+}
+
+func TestMet(t *testing.T) {
+	minutes := &models.LearningGoal{Metric: models.GoalMetricMinutes, Target: 10}
+	assert.False(t, Met(minutes, progress.Totals{Spoken: 4 * time.Minute, Listened: 5 * time.Minute}))
+	assert.True(t, Met(minutes, progress.Totals{Spoken: 4 * time.Minute, Listened: 6 * time.Minute}))
+
+	sessions := &models.LearningGoal{Metric: models.GoalMetricSessions, Target: 2}
+	assert.False(t, Met(sessions, progress.Totals{Sessions: 1, Spoken: time.Hour}))
+	assert.True(t, Met(sessions, progress.Totals{Sessions: 2}))
+}
@@ -0,0 +1,154 @@
+package reminder
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/notify"
+	"github.com/hiroky1983/talk/go/internal/progress"
+	"github.com/hiroky1983/talk/go/internal/repository"
+)
+
+const (
+	// StaleAfter is how late a reminder may be sent; later ones, such as those due while the
+	// server was down, are skipped rather than sent at an unexpected hour
+	StaleAfter = time.Hour
+	// batchSize is how many due reminders a scheduler claims at once
+	batchSize = 50
+	// leaseDuration keeps claimed reminders from other schedulers while a batch is sent
+	leaseDuration = 5 * time.Minute
+)
+
+// Scheduler sends the reminders of daily goals that are due
+type Scheduler struct {
+	goals     repository.GoalRepository
+	settings  repository.SettingsRepository
+	progress  repository.ProgressRepository
+	notifiers map[models.NotificationChannel]notify.Notifier
+	location  *time.Location
+	now       func() time.Time
+}
+
+// NewScheduler creates a scheduler delivering reminders through the notifier of each goal's channel.
+// Days are counted in loc for users without a time zone of their own.
+func NewScheduler(
+	goals repository.GoalRepository,
+	settings repository.SettingsRepository,
+	progress repository.ProgressRepository,
+	notifiers map[models.NotificationChannel]notify.Notifier,
+	loc *time.Location,
+) *Scheduler {
+	return &Scheduler{
+		goals:     goals,
+		settings:  settings,
+		progress:  progress,
+		notifiers: notifiers,
+		location:  loc,
+		now:       time.Now,
+	}
+}
+
+// Run calls ProcessDue every interval until ctx is canceled
+func (s *Scheduler) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.ProcessDue(ctx); err != nil {
+				log.Printf("Failed to process goal reminders: %v", err)
+			}
+		}
+	}
+}
+
+// ProcessDue sends a batch of due reminders and schedules each for the next day.
+// A failed delivery is logged; only failures to access the database are returned.
+func (s *Scheduler) ProcessDue(ctx context.Context) error {
+	now := s.now()
+	// The database keeps microseconds, and RescheduleReminder matches the lease exactly
+	leaseUntil := now.Add(leaseDuration).Truncate(time.Microsecond)
+	goals, err := s.goals.ClaimDueReminders(ctx, now, leaseUntil, batchSize)
+	if err != nil {
+		return err
+	}
+	for i := range goals {
+		if err := s.remind(ctx, &goals[i], now, leaseUntil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// remind sends the reminder of a claimed goal unless it is not due anymore, then schedules the next one
+func (s *Scheduler) remind(ctx context.Context, goal *models.LearningGoal, now, leaseUntil time.Time) error {
+	settings, err := s.settings.GetSettings(ctx, goal.UserID)
+	if err != nil {
+		return err
+	}
+	loc := progress.Location(settings.TimeZone, s.location)
+	dueAt := *goal.NextReminderAt
+
+	var remindedAt *time.Time
+	switch {
+	case goal.User == nil || goal.User.IsDisabled():
+	case now.Sub(dueAt) > StaleAfter:
+		log.Printf("Skipped reminder of user %s due at %s", goal.UserID, dueAt.Format(time.RFC3339))
+	case minuteOfDay(dueAt.In(loc)) != goal.ReminderMinute:
+		// The user moved to another time zone since the reminder was scheduled
+	default:
+		sent, err := s.send(ctx, goal, loc, now)
+		if err != nil {
+			return err
+		}
+		if sent {
+			remindedAt = &now
+		}
+	}
+	return s.goals.RescheduleReminder(ctx, goal.LearningGoalsID, leaseUntil, Next(goal.ReminderMinute, loc, now), remindedAt)
+}
+
+// send notifies the user unless today's practice already reaches the goal, and reports whether it did
+func (s *Scheduler) send(ctx context.Context, goal *models.LearningGoal, loc *time.Location, now time.Time) (bool, error) {
+	sessions, err := s.progress.ListPracticeSessions(ctx, goal.UserID, StartOfDay(now, loc))
+	if err != nil {
+		return false, err
+	}
+	today := progress.Sum(sessions, loc)
+	if Met(goal, today) {
+		return false, nil
+	}
+	notifier, ok := s.notifiers[goal.ReminderChannel]
+	if !ok {
+		log.Printf("No notifier for reminder channel %s of user %s", goal.ReminderChannel, goal.UserID)
+		return false, nil
+	}
+	if err := notifier.Notify(ctx, goal.User, Message(goal, today)); err != nil {
+		log.Printf("Failed to send reminder to user %s: %v", goal.UserID, err)
+		return false, nil
+	}
+	return true, nil
+}
+
+// Message returns the reminder for a goal given today's practice so far
+func Message(goal *models.LearningGoal, today progress.Totals) notify.Message {
+	unit := "minutes"
+	if goal.Metric == models.GoalMetricSessions {
+		unit = "sessions"
+	}
+	return notify.Message{
+		Kind:  notify.KindPracticeReminder,
+		Title: "Time for today's practice",
+		Body: fmt.Sprintf("You have practised %d of %d %s today. A short conversation keeps your streak going.",
+			Achieved(goal, today), goal.Target, unit),
+	}
+}
+
+func minuteOfDay(t time.Time) int {
+	return t.Hour()*60 + t.Minute()
+}
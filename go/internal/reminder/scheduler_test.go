@@ -0,0 +1,174 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/notify"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var tokyo = time.FixedZone("JST", 9*60*60)
+
+type rescheduled struct {
+	next       time.Time
+	remindedAt *time.Time
+}
+
+type fakeGoals struct {
+	repository.GoalRepository
+	due         []models.LearningGoal
+	rescheduled map[string]rescheduled
+}
+
+func (f *fakeGoals) ClaimDueReminders(_ context.Context, _, _ time.Time, _ int) ([]models.LearningGoal, error) {
+	due := f.due
+	f.due = nil
+	return due, nil
+}
+
+func (f *fakeGoals) RescheduleReminder(_ context.Context, goalID string, _, next time.Time, remindedAt *time.Time) error {
+	f.rescheduled[goalID] = rescheduled{next: next, remindedAt: remindedAt}
+	return nil
+}
+
+type fakeSettings struct {
+	repository.SettingsRepository
+	timeZones map[string]string
+}
+
+func (f *fakeSettings) GetSettings(_ context.Context, userID string) (*models.UserSettings, error) {
+	return &models.UserSettings{UserID: userID, TimeZone: f.timeZones[userID]}, nil
+}
+
+type fakeProgress struct {
+	repository.ProgressRepository
+	sessions map[string][]models.PracticeSession
+}
+
+func (f *fakeProgress) ListPracticeSessions(_ context.Context, userID string, since time.Time) ([]models.PracticeSession, error) {
+	var sessions []models.PracticeSession
+	for _, s := range f.sessions[userID] {
+		if !s.StartedAt.Before(since) {
+			sessions = append(sessions, s)
+		}
+	}
+	return sessions, nil
+}
+
+type fakeNotifier struct {
+	sent map[string]notify.Message
+	err  error
+}
+
+func (f *fakeNotifier) Notify(_ context.Context, user *models.User, message notify.Message) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.sent[user.UsersID] = message
+	return nil
+}
+
+func dueGoal(userID string, dueAt time.Time) models.LearningGoal {
+	return models.LearningGoal{
+		LearningGoalsID: "goal-" + userID,
+		UserID:          userID,
+		User:            &models.User{UsersID: userID},
+		Metric:          models.GoalMetricMinutes,
+		Target:          10,
+		ReminderEnabled: true,
+		ReminderMinute:  20 * 60,
+		ReminderChannel: models.NotificationChannelInApp,
+		NextReminderAt:  &dueAt,
+	}
+}
+
+func newTestScheduler(goals *fakeGoals, settings *fakeSettings, practice *fakeProgress, notifier *fakeNotifier, now time.Time) *Scheduler {
+	s := NewScheduler(goals, settings, practice, map[models.NotificationChannel]notify.Notifier{
+		models.NotificationChannelInApp: notifier,
+	}, tokyo)
+	s.now = func() time.Time { return now }
+	return s
+}
+
+func TestScheduler_RemindsUntilGoalIsMet(t *testing.T) {
+	dueAt := time.Date(2026, 10, 19, 20, 0, 0, 0, tokyo)
+	now := dueAt.Add(30 * time.Second)
+	goals := &fakeGoals{
+		due:         []models.LearningGoal{dueGoal("idle", dueAt), dueGoal("busy", dueAt)},
+		rescheduled: map[string]rescheduled{},
+	}
+	practice := &fakeProgress{sessions: map[string][]models.PracticeSession{
+		// Yesterday's practice does not count towards today's goal
+		"idle": {{StartedAt: dueAt.AddDate(0, 0, -1), UserAudioMs: 20 * 60_000}, {StartedAt: dueAt.Add(-time.Hour), UserAudioMs: 3 * 60_000}},
+		"busy": {{StartedAt: dueAt.Add(-time.Hour), UserAudioMs: 5 * 60_000, AIAudioMs: 5 * 60_000}},
+	}}
+	notifier := &fakeNotifier{sent: map[string]notify.Message{}}
+
+	err := newTestScheduler(goals, &fakeSettings{}, practice, notifier, now).ProcessDue(context.Background())
+	require.NoError(t, err)
+
+	require.Contains(t, notifier.sent, "idle")
+	assert.Equal(t, notify.KindPracticeReminder, notifier.sent["idle"].Kind)
+	assert.Contains(t, notifier.sent["idle"].Body, "3 of 10 minutes")
+	assert.NotContains(t, notifier.sent, "busy")
+
+	tomorrow := time.Date(2026, 10, 20, 20, 0, 0, 0, tokyo)
+	require.NotNil(t, goals.rescheduled["goal-idle"].remindedAt)
+	assert.True(t, tomorrow.Equal(goals.rescheduled["goal-idle"].next))
+	assert.Nil(t, goals.rescheduled["goal-busy"].remindedAt)
+	assert.True(t, tomorrow.Equal(goals.rescheduled["goal-busy"].next))
+}
+
+func TestScheduler_SkipsStaleReminders(t *testing.T) {
+	dueAt := time.Date(2026, 10, 19, 20, 0, 0, 0, tokyo)
+	// The server was down for the evening
+	now := dueAt.Add(StaleAfter + time.Minute)
+	goals := &fakeGoals{due: []models.LearningGoal{dueGoal("u", dueAt)}, rescheduled: map[string]rescheduled{}}
+	notifier := &fakeNotifier{sent: map[string]notify.Message{}}
+
+	err := newTestScheduler(goals, &fakeSettings{}, &fakeProgress{}, notifier, now).ProcessDue(context.Background())
+	require.NoError(t, err)
+
+	assert.Empty(t, notifier.sent)
+	assert.True(t, time.Date(2026, 10, 20, 20, 0, 0, 0, tokyo).Equal(goals.rescheduled["goal-u"].next))
+}
+
+func TestScheduler_ReschedulesAfterTimeZoneChange(t *testing.T) {
+	dueAt := time.Date(2026, 10, 19, 20, 0, 0, 0, tokyo)
+	goals := &fakeGoals{due: []models.LearningGoal{dueGoal("u", dueAt)}, rescheduled: map[string]rescheduled{}}
+	settings := &fakeSettings{timeZones: map[string]string{"u": "Europe/Paris"}}
+	notifier := &fakeNotifier{sent: map[string]notify.Message{}}
+
+	err := newTestScheduler(goals, settings, &fakeProgress{}, notifier, dueAt).ProcessDue(context.Background())
+	require.NoError(t, err)
+
+	assert.Empty(t, notifier.sent)
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+	assert.True(t, time.Date(2026, 10, 19, 20, 0, 0, 0, paris).Equal(goals.rescheduled["goal-u"].next))
+}
+
+func TestScheduler_FailedDeliveryMovesOn(t *testing.T) {
+	dueAt := time.Date(2026, 10, 19, 20, 0, 0, 0, tokyo)
+	disabledAt := dueAt.AddDate(0, -1, 0)
+	disabled := dueGoal("disabled", dueAt)
+	disabled.User.DisabledAt = &disabledAt
+	goals := &fakeGoals{
+		due:         []models.LearningGoal{dueGoal("u", dueAt), disabled},
+		rescheduled: map[string]rescheduled{},
+	}
+	notifier := &fakeNotifier{sent: map[string]notify.Message{}, err: errors.New("smtp down")}
+
+	err := newTestScheduler(goals, &fakeSettings{}, &fakeProgress{}, notifier, dueAt).ProcessDue(context.Background())
+	require.NoError(t, err)
+
+	assert.Len(t, goals.rescheduled, 2)
+	assert.Nil(t, goals.rescheduled["goal-u"].remindedAt)
+	assert.Nil(t, goals.rescheduled["goal-disabled"].remindedAt)
+}
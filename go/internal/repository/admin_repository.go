@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+// UserFilter narrows down the users returned by AdminRepository.ListUsers
+type UserFilter struct {
+	EmailPrefix   string
+	Plan          models.UserPlan // Empty matches every plan
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	Limit         int
+	Offset        int
+}
+
+// AuditLogFilter narrows down the entries returned by AdminRepository.ListAuditLogs
+type AuditLogFilter struct {
+	TargetUserID string
+	Limit        int
+	Offset       int
+}
+
+// PurgeResult reports how many rows a purge removed per table
+type PurgeResult struct {
+	RefreshTokens       int64 `json:"refresh_tokens"`
+	PasswordResetTokens int64 `json:"password_reset_tokens"`
+}
+
+// UserStats is an operational snapshot of the user base
+type UserStats struct {
+	TotalUsers       int64                     `json:"total_users"`
+	UsersByPlan      map[models.UserPlan]int64 `json:"users_by_plan"`
+	Admins           int64                     `json:"admins"`
+	DisabledUsers    int64                     `json:"disabled_users"`
+	NewUsersLastWeek int64                     `json:"new_users_last_week"`
+	ActiveSessions   int64                     `json:"active_sessions"`
+}
+
+// AdminRepository is the interface for support operations on user accounts.
+// Every mutating method records an audit log entry in the same transaction.
+// An empty actorID records the action as performed by the system.
+type AdminRepository interface {
+	ListUsers(ctx context.Context, filter UserFilter) ([]models.User, int64, error)
+	CountActiveSessions(ctx context.Context, userID string) (int64, error)
+	// UpdateUserPlan sets the plan chosen by support. Open promo code grants restore it, rather than
+	// the plan the user had when redeeming, once they end.
+	UpdateUserPlan(ctx context.Context, actorID, userID string, plan models.UserPlan, reason string) (*models.User, error)
+	SetUserDisabled(ctx context.Context, actorID, userID string, disabled bool, reason string) (*models.User, error)
+	SetUserRole(ctx context.Context, actorID, userID string, role models.UserRole, reason string) (*models.User, error)
+	RevokeSessions(ctx context.Context, actorID, userID, reason string) (int64, error)
+	CreatePasswordResetToken(ctx context.Context, actorID string, token *models.PasswordResetToken, reason string) error
+	ListAuditLogs(ctx context.Context, filter AuditLogFilter) ([]models.AuditLog, error)
+	PurgeExpired(ctx context.Context) (*PurgeResult, error)
+	GetUserStats(ctx context.Context) (*UserStats, error)
+}
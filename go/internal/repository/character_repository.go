@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+var (
+	// ErrCharacterNotFound is returned when a character does not exist or is retired
+	ErrCharacterNotFound = errors.New("character not found")
+	// ErrCharacterAlreadyExists is returned when another character already uses the key
+	ErrCharacterAlreadyExists = errors.New("character already exists")
+)
+
+// CharacterRepository is the interface for conversation character data operations
+type CharacterRepository interface {
+	// ListCharacters returns the characters in listing order. Retired characters are included only when includeRetired is set.
+	ListCharacters(ctx context.Context, includeRetired bool) ([]models.Character, error)
+	// GetCharacterByKey returns the character with the key, including a retired one
+	GetCharacterByKey(ctx context.Context, key string) (*models.Character, error)
+	// CreateCharacter inserts the character and records the change in the audit log
+	CreateCharacter(ctx context.Context, actorID string, character *models.Character, reason string) error
+	// UpdateCharacter saves every field of the character and records the change in the audit log
+	UpdateCharacter(ctx context.Context, actorID string, character *models.Character, reason string) error
+	// DeleteCharacter retires the character so past conversations keep resolving it, and records the change in the audit log
+	DeleteCharacter(ctx context.Context, actorID, key, reason string) error
+}
+
+var (
+	// ErrCustomCharacterNotFound is returned when a custom character does not exist, belongs to another user or is no longer shared
+	ErrCustomCharacterNotFound = errors.New("custom character not found")
+	// ErrCustomCharacterLimit is returned when the user already keeps the most custom characters allowed
+	ErrCustomCharacterLimit = errors.New("too many custom characters")
+)
+
+// CustomCharacterRepository is the interface for user-designed character data operations
+type CustomCharacterRepository interface {
+	// ListCustomCharacters returns the user's characters, newest first
+	ListCustomCharacters(ctx context.Context, userID string) ([]models.CustomCharacter, error)
+	// GetCustomCharacter returns a character of the user
+	GetCustomCharacter(ctx context.Context, userID, customCharacterID string) (*models.CustomCharacter, error)
+	// GetCustomCharacterByID returns a character of any user
+	GetCustomCharacterByID(ctx context.Context, customCharacterID string) (*models.CustomCharacter, error)
+	// GetSharedCharacter returns the character currently shared with the token
+	GetSharedCharacter(ctx context.Context, shareToken string) (*models.CustomCharacter, error)
+	// CreateCustomCharacter inserts the character unless its owner already keeps limit characters
+	CreateCustomCharacter(ctx context.Context, character *models.CustomCharacter, limit int) error
+	// UpdateCustomCharacter saves the design and share token of a character of its owner
+	UpdateCustomCharacter(ctx context.Context, character *models.CustomCharacter) error
+	DeleteCustomCharacter(ctx context.Context, userID, customCharacterID string) error
+}
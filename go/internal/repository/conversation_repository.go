@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+// ErrConversationNotFound is returned when a conversation does not exist or belongs to another user
+var ErrConversationNotFound = errors.New("conversation not found")
+
+// ConversationCursor is the position of a conversation in the newest first listing
+type ConversationCursor struct {
+	StartedAt      time.Time
+	ConversationID string
+}
+
+// ConversationFilter narrows down the conversations returned by ConversationRepository.ListConversations
+type ConversationFilter struct {
+	UserID        string
+	Language      string // Empty matches every language
+	Character     string // Empty matches every character
+	StartedAfter  *time.Time
+	StartedBefore *time.Time
+	After         *ConversationCursor // Only conversations listed after the cursor
+	Limit         int
+}
+
+// TranscriptSearchFilter narrows down the turns returned by ConversationRepository.SearchTurns
+type TranscriptSearchFilter struct {
+	UserID   string
+	TSQuery  string // tsquery text matched against the lexemes built by search.Lexemes
+	Language string // Empty matches every language
+	Limit    int
+	Offset   int
+}
+
+// ConversationRepository is the interface for conversation data operations
+type ConversationRepository interface {
+	CreateConversation(ctx context.Context, conversation *models.Conversation) error
+	EndConversation(ctx context.Context, conversationID string, endedAt time.Time, reason models.CloseReason) error
+	// SaveTurn appends a turn with its feedback to the conversation, setting its sequence number to the next one.
+	// It returns ErrConversationNotFound when the conversation no longer exists.
+	SaveTurn(ctx context.Context, turn *models.ConversationTurn) error
+	// SetTurnAudio sets the audio keys of a saved turn
+	SetTurnAudio(ctx context.Context, turnID, userAudioKey, aiAudioKey string) error
+	// ListConversations returns a page of conversations, newest first
+	ListConversations(ctx context.Context, filter ConversationFilter) ([]models.Conversation, error)
+	// GetConversation returns a conversation of the user without its turns
+	GetConversation(ctx context.Context, userID, conversationID string) (*models.Conversation, error)
+	// ListConversationTurns returns up to limit turns of a conversation after the sequence number afterSeq in order,
+	// with their feedback. A limit of zero returns every remaining turn.
+	ListConversationTurns(ctx context.Context, conversationID string, afterSeq, limit int) ([]models.ConversationTurn, error)
+	// ListRecentConversationTurns returns the last limit turns of a conversation in order
+	ListRecentConversationTurns(ctx context.Context, conversationID string, limit int) ([]models.ConversationTurn, error)
+	// GetTurn returns a turn of a conversation owned by the user
+	GetTurn(ctx context.Context, userID, conversationID string, seq int) (*models.ConversationTurn, error)
+	DeleteConversation(ctx context.Context, userID, conversationID string) error
+	// SearchTurns returns the user's turns matching the filter with their conversation, best match first
+	SearchTurns(ctx context.Context, filter TranscriptSearchFilter) ([]models.ConversationTurn, error)
+	// ListTurns returns up to limit turns of every user ordered by ID, starting after afterID
+	ListTurns(ctx context.Context, afterID string, limit int) ([]models.ConversationTurn, error)
+	UpdateSearchText(ctx context.Context, turnID, searchText string) error
+	// DeleteAllConversations deletes every conversation of the user and returns how many were deleted
+	DeleteAllConversations(ctx context.Context, userID string) (int64, error)
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+// ErrGoalNotFound is returned when a user has not set a daily goal
+var ErrGoalNotFound = errors.New("learning goal not found")
+
+// GoalRepository is the interface for daily goal data operations
+type GoalRepository interface {
+	GetGoal(ctx context.Context, userID string) (*models.LearningGoal, error)
+	// SaveGoal creates or replaces the goal of goal.UserID
+	SaveGoal(ctx context.Context, goal *models.LearningGoal) error
+	DeleteGoal(ctx context.Context, userID string) error
+	// ClaimDueReminders returns up to limit goals whose reminder is due at now with their user,
+	// postponing them to leaseUntil so other schedulers skip them meanwhile.
+	// The goals are returned as they were before the lease, with the time their reminder was due.
+	ClaimDueReminders(ctx context.Context, now, leaseUntil time.Time, limit int) ([]models.LearningGoal, error)
+	// RescheduleReminder sets the next reminder of a claimed goal and, when remindedAt is set, when it was last sent.
+	// It does nothing when the goal was changed since it was claimed.
+	RescheduleReminder(ctx context.Context, goalID string, leaseUntil, next time.Time, remindedAt *time.Time) error
+}
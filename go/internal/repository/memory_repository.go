@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+// ErrMemoryNotFound is returned when a memory does not exist or belongs to another user
+var ErrMemoryNotFound = errors.New("memory not found")
+
+// MemoryRepository is the interface for user memory data operations
+type MemoryRepository interface {
+	// ListMemories returns up to limit memories of the user, most recently updated first.
+	// A limit of zero returns every memory.
+	ListMemories(ctx context.Context, userID string, limit int) ([]models.UserMemory, error)
+	CreateMemory(ctx context.Context, memory *models.UserMemory) error
+	// UpdateMemory replaces the content of a memory of the user
+	UpdateMemory(ctx context.Context, userID, memoryID, content string) (*models.UserMemory, error)
+	DeleteMemory(ctx context.Context, userID, memoryID string) error
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+// MistakeKey identifies a recurring mistake of a user
+type MistakeKey struct {
+	Language string
+	Category models.FeedbackCategory
+	Pattern  string
+}
+
+// MistakePattern is a mistake the user made in one or more turns
+type MistakePattern struct {
+	MistakeKey
+	Count              int       // Corrections of the mistake
+	RecentCount        int       // Corrections since MistakePatternFilter.RecentSince
+	Conversations      int       // Conversations the mistake occurred in
+	FirstSeenAt        time.Time // Start of the first turn with the mistake
+	LastSeenAt         time.Time // Start of the latest turn with the mistake
+	ConversationsSince int       // Conversations of the user in the language started after LastSeenAt
+}
+
+// MistakePatternFilter narrows down the patterns returned by MistakeRepository.ListMistakePatterns
+type MistakePatternFilter struct {
+	UserID      string
+	Language    string                  // Empty matches every language
+	Category    models.FeedbackCategory // Empty matches every category
+	MinCount    int                     // Patterns corrected fewer times are left out
+	RecentSince time.Time
+	// ResolvedAfter is how many conversations since a pattern last occurred resolve it; 0 never resolves patterns.
+	// Resolved patterns are listed after the active ones.
+	ResolvedAfter int
+	OnlyActive    bool // Leaves out resolved patterns
+	Limit         int
+	Offset        int
+}
+
+// MistakeExample is a correction that belongs to a pattern, with the turn it was given in
+type MistakeExample struct {
+	models.TurnFeedback
+	Language       string
+	ConversationID string
+	Seq            int
+	OccurredAt     time.Time // Start of the turn
+}
+
+// MistakeRepository is the interface for aggregating the feedback stored with conversation turns
+type MistakeRepository interface {
+	// ListMistakePatterns groups the user's feedback by language, category and pattern.
+	// Active patterns come first, most corrected first.
+	ListMistakePatterns(ctx context.Context, filter MistakePatternFilter) ([]MistakePattern, error)
+	// ListMistakeExamples returns up to perPattern of the latest corrections of each pattern of the user
+	ListMistakeExamples(ctx context.Context, userID string, keys []MistakeKey, perPattern int) ([]MistakeExample, error)
+	// ListFeedback returns up to limit feedback items of every user ordered by ID, starting after afterID
+	ListFeedback(ctx context.Context, afterID string, limit int) ([]models.TurnFeedback, error)
+	UpdateFeedbackPattern(ctx context.Context, feedbackID, pattern string) error
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+// NotificationRepository is the interface for in-app notification data operations
+type NotificationRepository interface {
+	CreateNotification(ctx context.Context, notification *models.Notification) error
+	// ListNotifications returns up to limit notifications of the user, newest first
+	ListNotifications(ctx context.Context, userID string, unreadOnly bool, limit, offset int) ([]models.Notification, error)
+	CountUnreadNotifications(ctx context.Context, userID string) (int64, error)
+	// MarkNotificationsRead marks the user's unread notifications with the IDs as read at the time at,
+	// or all of them when no IDs are given, and returns how many were marked
+	MarkNotificationsRead(ctx context.Context, userID string, notificationIDs []string, at time.Time) (int64, error)
+}
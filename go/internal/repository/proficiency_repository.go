@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+var (
+	// ErrLanguageProfileNotFound is returned when a user has no level in a language yet
+	ErrLanguageProfileNotFound = errors.New("language profile not found")
+	// ErrLanguageProfileChanged is returned when a profile was saved by someone else since it was read
+	ErrLanguageProfileChanged = errors.New("language profile changed since it was read")
+)
+
+// ProficiencyRepository is the interface for the data operations on learners' language levels
+type ProficiencyRepository interface {
+	// ListLanguageProfiles returns the user's profiles ordered by language
+	ListLanguageProfiles(ctx context.Context, userID string) ([]models.UserLanguageProfile, error)
+	GetLanguageProfile(ctx context.Context, userID, language string) (*models.UserLanguageProfile, error)
+	// SavePlacement creates or updates the profile of profile.UserID in profile.Language with a placement result,
+	// recording a level change when the placement moves the level
+	SavePlacement(ctx context.Context, profile *models.UserLanguageProfile) error
+	// ListLevelChanges returns up to limit level changes of the user, newest first.
+	// An empty language returns the changes in every language.
+	ListLevelChanges(ctx context.Context, userID, language string, limit, offset int) ([]models.LevelChange, error)
+
+	// ClaimDueAnalyses returns up to limit practice sessions whose analysis is due at now,
+	// postponing them to leaseUntil so other analyzers skip them meanwhile
+	ClaimDueAnalyses(ctx context.Context, now, leaseUntil time.Time, limit int) ([]models.PracticeSession, error)
+	// ListSessionTurns returns the turns of the practice session's conversation started during the session
+	// with their feedback, in order
+	ListSessionTurns(ctx context.Context, session *models.PracticeSession) ([]models.ConversationTurn, error)
+	// CompleteAnalysis ends the analysis of a claimed session. When profile is set, it is saved with the
+	// session's assessment and change, if any, is recorded. It does nothing when the session was claimed
+	// again since leaseUntil, and returns ErrLanguageProfileChanged when the profile was saved since it was read.
+	CompleteAnalysis(ctx context.Context, sessionID string, leaseUntil time.Time, profile *models.UserLanguageProfile, change *models.LevelChange) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+// ProgressRepository is the interface for the practice statistics behind a user's learning progress
+type ProgressRepository interface {
+	CreatePracticeSession(ctx context.Context, session *models.PracticeSession) error
+	// ListPracticeSessions returns the practice sessions of the user started at or after since, oldest first.
+	// A zero since returns every session.
+	ListPracticeSessions(ctx context.Context, userID string, since time.Time) ([]models.PracticeSession, error)
+}
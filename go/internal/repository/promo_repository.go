@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+var (
+	// ErrPromoCodeNotFound is returned when a promo code does not exist
+	ErrPromoCodeNotFound = errors.New("promo code not found")
+	// ErrPromoCodeExpired is returned when a promo code is past its expiry date
+	ErrPromoCodeExpired = errors.New("promo code has expired")
+	// ErrPromoCodeExhausted is returned when a promo code reached its redemption limit
+	ErrPromoCodeExhausted = errors.New("promo code has been fully redeemed")
+	// ErrPromoCodeAlreadyRedeemed is returned when the user already redeemed the promo code
+	ErrPromoCodeAlreadyRedeemed = errors.New("promo code already redeemed")
+)
+
+// PromoCodeFilter narrows down the codes returned by PromoRepository.ListPromoCodes
+type PromoCodeFilter struct {
+	Campaign string // Empty matches every campaign
+	Limit    int
+	Offset   int
+}
+
+// PlanChange is the effective plan a PlanDecider settles on, with the reason recorded in the audit log
+type PlanChange struct {
+	Plan          models.UserPlan
+	Reason        string
+	EndedGrantIDs []string // Grants whose plan change has been undone and are marked reverted
+}
+
+// PlanDecider decides the effective plan of a user from the locked user row, the user's open
+// grants (oldest first) and the subscription that determines the plan, nil when the user has none
+type PlanDecider func(user *models.User, grants []models.PlanGrant, subscription *models.Subscription) PlanChange
+
+// PromoRepository is the interface for promo code and plan grant data operations
+type PromoRepository interface {
+	// CreatePromoCodes inserts codes in a single transaction and records the mint in the audit log
+	CreatePromoCodes(ctx context.Context, actorID string, codes []models.PromoCode, reason string) error
+	ListPromoCodes(ctx context.Context, filter PromoCodeFilter) ([]models.PromoCode, error)
+	GetPromoCode(ctx context.Context, code string) (*models.PromoCode, error)
+	// RedeemCode validates the code and creates a grant starting at now for the user
+	RedeemCode(ctx context.Context, userID, code string, now time.Time) (*models.PlanGrant, error)
+	// RefreshPlan locks the user and, in the same transaction, saves the plan decide returns when it
+	// differs (recording the change in the audit log) and marks the ended grants reverted at revertedAt.
+	// It returns the plan the user had before the refresh and the decision.
+	RefreshPlan(ctx context.Context, userID string, revertedAt time.Time, decide PlanDecider) (models.UserPlan, PlanChange, error)
+	// ListUsersWithEndedGrants returns the users having grants that ended before now but were not reverted yet
+	ListUsersWithEndedGrants(ctx context.Context, now time.Time) ([]string, error)
+}
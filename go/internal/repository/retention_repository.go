@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+// RetentionCriteria selects data older than the retention of its owner at Now.
+// A user's retention is the shorter of PlanDays for their plan (DefaultDays for plans
+// not listed) and the retention they set themselves. Zero days keeps data forever.
+type RetentionCriteria struct {
+	Now         time.Time
+	PlanDays    map[models.UserPlan]int
+	DefaultDays int
+}
+
+// RetentionRepository is the interface for finding and deleting data past its retention
+type RetentionRepository interface {
+	// ListExpiredAudioTurns returns up to limit turns whose audio is past the audio retention, oldest first
+	ListExpiredAudioTurns(ctx context.Context, criteria RetentionCriteria, limit int) ([]models.ConversationTurn, error)
+	CountExpiredAudioTurns(ctx context.Context, criteria RetentionCriteria) (int64, error)
+	// ClearTurnAudio forgets the audio of turns once it has been deleted from the blob store
+	ClearTurnAudio(ctx context.Context, turnIDs []string) error
+	// ListExpiredConversations returns up to limit conversations last active before the transcript retention,
+	// least recently active first, whether they ended or were abandoned without an end time
+	ListExpiredConversations(ctx context.Context, criteria RetentionCriteria, limit int) ([]models.Conversation, error)
+	CountExpiredConversations(ctx context.Context, criteria RetentionCriteria) (int64, error)
+	// DeleteConversations deletes conversations; their turns and summaries are removed by the cascade
+	DeleteConversations(ctx context.Context, conversationIDs []string) error
+}
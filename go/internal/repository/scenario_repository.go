@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+// ErrScenarioNotFound is returned when a scenario does not exist, is retired or is not offered in the requested language
+var ErrScenarioNotFound = errors.New("scenario not found")
+
+// ScenarioStats summarizes the sessions a user played in a scenario
+type ScenarioStats struct {
+	ScenarioID        string
+	Sessions          int
+	Completions       int // Sessions in which every goal was achieved
+	BestGoalsAchieved int
+	LastPlayedAt      time.Time
+}
+
+// ScenarioImport counts the changes of a catalog import
+type ScenarioImport struct {
+	Created int
+	Updated int
+	Retired int
+}
+
+// ScenarioRepository is the interface for role-play scenario data operations
+type ScenarioRepository interface {
+	// ListScenarios returns the active scenarios offered in the language in catalog order,
+	// each with only its localization in that language. An empty difficulty lists every difficulty.
+	ListScenarios(ctx context.Context, language string, difficulty models.ScenarioDifficulty) ([]models.Scenario, error)
+	// GetScenario returns an active scenario with only its localization in the language
+	GetScenario(ctx context.Context, scenarioID, language string) (*models.Scenario, error)
+	// ImportScenarios creates or updates the scenarios by slug, replacing their localizations,
+	// and retires the active scenarios missing from the list
+	ImportScenarios(ctx context.Context, scenarios []*models.Scenario) (ScenarioImport, error)
+	CreateScenarioCompletion(ctx context.Context, completion *models.ScenarioCompletion) error
+	// ListScenarioStats returns the user's statistics of every scenario they played
+	ListScenarioStats(ctx context.Context, userID string) ([]ScenarioStats, error)
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+// SettingsRepository is the interface for user settings data operations
+type SettingsRepository interface {
+	// GetSettings returns the settings of a user, or the defaults if the user never saved any
+	GetSettings(ctx context.Context, userID string) (*models.UserSettings, error)
+	// SaveSettings creates or replaces the settings of settings.UserID
+	SaveSettings(ctx context.Context, settings *models.UserSettings) error
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+var (
+	// ErrSubscriptionNotFound is returned when a subscription is not found
+	ErrSubscriptionNotFound = errors.New("subscription not found")
+)
+
+// SubscriptionRepository is the interface for subscription and invoice data operations
+type SubscriptionRepository interface {
+	GetSubscriptionByProviderID(ctx context.Context, providerSubscriptionID string) (*models.Subscription, error)
+	// GetCurrentSubscription returns the newest subscription that is not canceled,
+	// falling back to the most recently canceled one.
+	GetCurrentSubscription(ctx context.Context, userID string) (*models.Subscription, error)
+	SaveSubscription(ctx context.Context, subscription *models.Subscription) error
+	SaveInvoice(ctx context.Context, invoice *models.Invoice) error
+	HasProcessedEvent(ctx context.Context, providerEventID string) (bool, error)
+	RecordEvent(ctx context.Context, event *models.BillingEvent) error
+}
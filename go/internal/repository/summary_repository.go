@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+// ErrSummaryNotFound is returned when a conversation has no summary or belongs to another user
+var ErrSummaryNotFound = errors.New("conversation summary not found")
+
+// SummaryRepository is the interface for conversation summary data operations
+type SummaryRepository interface {
+	// RequestSummary schedules a summary of the conversation at the time at.
+	// Requesting again replaces the earlier result and resets its attempts.
+	RequestSummary(ctx context.Context, conversationID string, at time.Time) error
+	// GetSummary returns the summary of a conversation owned by the user
+	GetSummary(ctx context.Context, userID, conversationID string) (*models.ConversationSummary, error)
+	// ClaimDueSummaries returns up to limit pending summaries due at now with their conversation,
+	// counting an attempt and postponing them to leaseUntil so other workers skip them meanwhile
+	ClaimDueSummaries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]models.ConversationSummary, error)
+	// CompleteSummary stores the result of a claimed summary.
+	// It does nothing when the summary was requested again since it was claimed.
+	CompleteSummary(ctx context.Context, summary *models.ConversationSummary, content string) error
+	// FailSummary records a failed attempt of a claimed summary. A nil retryAt gives up on it.
+	FailSummary(ctx context.Context, summary *models.ConversationSummary, lastError string, retryAt *time.Time) error
+	// RetryFailedSummaries schedules every failed summary again at the time at and returns how many
+	RetryFailedSummaries(ctx context.Context, at time.Time) (int64, error)
+}
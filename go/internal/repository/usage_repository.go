@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// UsageTotals is the metered usage of a user over a date range
+type UsageTotals struct {
+	UserAudio time.Duration
+	AIAudio   time.Duration
+	Sessions  int64
+}
+
+// Total returns the audio counted against quotas
+func (t UsageTotals) Total() time.Duration {
+	return t.UserAudio + t.AIAudio
+}
+
+// UsageRepository is the interface for usage metering data operations
+type UsageRepository interface {
+	// AddUsage adds usage to the user's row for day, creating it when missing
+	AddUsage(ctx context.Context, userID string, day time.Time, usage UsageTotals) error
+	// GetUsageTotals sums the usage of the days in [from, to)
+	GetUsageTotals(ctx context.Context, userID string, from, to time.Time) (UsageTotals, error)
+}
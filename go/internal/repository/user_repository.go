@@ -14,6 +14,8 @@ var (
 	ErrUserAlreadyExists = errors.New("user already exists")
 	// ErrInvalidCredentials is returned when credentials are invalid
 	ErrInvalidCredentials = errors.New("invalid credentials")
+	// ErrInvalidResetToken is returned when a password reset token is unknown, used or expired
+	ErrInvalidResetToken = errors.New("invalid or expired reset token")
 )
 
 // UserRepository is the interface for user data operations
@@ -26,4 +28,6 @@ type UserRepository interface {
 	GetRefreshToken(ctx context.Context, token string) (*models.RefreshToken, error)
 	DeleteRefreshToken(ctx context.Context, token string) error
 	DeleteExpiredRefreshTokens(ctx context.Context) error
+	// ResetPassword redeems the reset token with the given hash, sets the password and revokes every session
+	ResetPassword(ctx context.Context, tokenHash, password string) error
 }
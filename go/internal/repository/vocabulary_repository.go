@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+// ErrCardNotFound is returned when a vocabulary card does not exist or belongs to another user
+var ErrCardNotFound = errors.New("vocabulary card not found")
+
+// VocabularyRepository is the interface for vocabulary card data operations.
+// Cards are returned with their source turn, if any.
+type VocabularyRepository interface {
+	// ListCards returns up to limit cards of the user after skipping offset, newest first.
+	// A limit of zero returns every card.
+	ListCards(ctx context.Context, userID string, limit, offset int) ([]models.VocabularyCard, error)
+	// ListDueCards returns up to limit cards of the user due at now, most overdue first
+	ListDueCards(ctx context.Context, userID string, now time.Time, limit int) ([]models.VocabularyCard, error)
+	CountDueCards(ctx context.Context, userID string, now time.Time) (int64, error)
+	GetCard(ctx context.Context, userID, cardID string) (*models.VocabularyCard, error)
+	CreateCards(ctx context.Context, cards []models.VocabularyCard) error
+	// UpdateCard saves the content and schedule of a card of the user
+	UpdateCard(ctx context.Context, card *models.VocabularyCard) error
+	DeleteCard(ctx context.Context, userID, cardID string) error
+}
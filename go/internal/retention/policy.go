@@ -0,0 +1,106 @@
+// Package retention deletes recorded audio and transcripts once they are older
+// than the retention of their owner.
+package retention
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+// MaxDays is the longest retention a user may set for themselves
+const MaxDays = 3650
+
+// Policy is how many days a plan keeps each type of data. Zero keeps it forever.
+type Policy struct {
+	AudioDays      int `json:"audio_days"`
+	TranscriptDays int `json:"transcript_days"`
+}
+
+// Policies maps each plan to its retention
+type Policies map[models.UserPlan]Policy
+
+// DefaultPolicies are used for plans without a RETENTION_* override
+var DefaultPolicies = Policies{
+	models.PlanFree:    {AudioDays: 30, TranscriptDays: 365},
+	models.PlanLite:    {AudioDays: 90, TranscriptDays: 730},
+	models.PlanPremium: {AudioDays: 365, TranscriptDays: 0},
+}
+
+// For returns the retention of plan. Unknown plans get the free plan's retention.
+func (p Policies) For(plan models.UserPlan) Policy {
+	if policy, ok := p[plan]; ok {
+		return policy
+	}
+	return p[models.PlanFree]
+}
+
+// Effective returns the retention applied to a user on plan: the shorter of the plan's
+// retention and the one the user set, so users can shorten but never extend it
+func (p Policies) Effective(plan models.UserPlan, settings *models.UserSettings) Policy {
+	policy := p.For(plan)
+	return Policy{
+		AudioDays:      shorter(policy.AudioDays, settings.AudioRetentionDays),
+		TranscriptDays: shorter(policy.TranscriptDays, settings.TranscriptRetentionDays),
+	}
+}
+
+// shorter returns the shorter of two retentions where zero means forever
+func shorter(a, b int) int {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
+
+// audioDays returns the audio retention of each plan
+func (p Policies) audioDays() map[models.UserPlan]int {
+	days := make(map[models.UserPlan]int, len(p))
+	for plan, policy := range p {
+		days[plan] = policy.AudioDays
+	}
+	return days
+}
+
+// transcriptDays returns the transcript retention of each plan
+func (p Policies) transcriptDays() map[models.UserPlan]int {
+	days := make(map[models.UserPlan]int, len(p))
+	for plan, policy := range p {
+		days[plan] = policy.TranscriptDays
+	}
+	return days
+}
+
+// LoadPolicies returns DefaultPolicies overridden by RETENTION_<PLAN>_AUDIO_DAYS and
+// RETENTION_<PLAN>_TRANSCRIPT_DAYS (e.g. RETENTION_FREE_AUDIO_DAYS=30, 0 to keep forever)
+func LoadPolicies() (Policies, error) {
+	policies := make(Policies, len(DefaultPolicies))
+	for plan, policy := range DefaultPolicies {
+		name := strings.TrimPrefix(string(plan), "PLAN_")
+		audio, err := daysFromEnv("RETENTION_"+name+"_AUDIO_DAYS", policy.AudioDays)
+		if err != nil {
+			return nil, err
+		}
+		transcript, err := daysFromEnv("RETENTION_"+name+"_TRANSCRIPT_DAYS", policy.TranscriptDays)
+		if err != nil {
+			return nil, err
+		}
+		policies[plan] = Policy{AudioDays: audio, TranscriptDays: transcript}
+	}
+	return policies, nil
+}
+
+func daysFromEnv(key string, fallback int) (int, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	days, err := strconv.Atoi(value)
+	if err != nil || days < 0 {
+		return 0, fmt.Errorf("invalid %s %q: must be a non-negative number of days", key, value)
+	}
+	return days, nil
+}
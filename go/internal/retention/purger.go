@@ -0,0 +1,150 @@
+package retention
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/conversation"
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"github.com/hiroky1983/talk/go/internal/storage"
+)
+
+// batchSize is how many turns or conversations are deleted per query
+const batchSize = 200
+
+// Report is what a purge deleted, or would delete in a dry run
+type Report struct {
+	DryRun        bool  `json:"dry_run"`
+	AudioTurns    int64 `json:"audio_turns"`   // Turns whose recorded audio is deleted
+	Conversations int64 `json:"conversations"` // Conversations deleted with their transcripts
+}
+
+// Purger deletes recorded audio and transcripts past their retention.
+// Blobs are deleted before the rows referencing them, so a failure leaves
+// the rows in place to be retried by the next purge.
+type Purger struct {
+	retention repository.RetentionRepository
+	blobs     storage.BlobStore
+	policies  Policies
+	now       func() time.Time
+}
+
+// NewPurger creates a new purger enforcing policies
+func NewPurger(retention repository.RetentionRepository, blobs storage.BlobStore, policies Policies) *Purger {
+	return &Purger{
+		retention: retention,
+		blobs:     blobs,
+		policies:  policies,
+		now:       time.Now,
+	}
+}
+
+// Run purges expired data every interval until ctx is canceled. With dryRun, it only logs what it would delete.
+func (p *Purger) Run(ctx context.Context, interval time.Duration, dryRun bool) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			report, err := p.Purge(ctx, dryRun)
+			if err != nil {
+				log.Printf("Failed to purge expired conversation data: %v", err)
+			}
+			if report.AudioTurns > 0 || report.Conversations > 0 {
+				log.Printf("Purged expired conversation data (dry run: %t): audio of %d turns, %d conversations",
+					report.DryRun, report.AudioTurns, report.Conversations)
+			}
+		}
+	}
+}
+
+// Purge deletes expired audio and then expired conversations in batches.
+// A dry run only counts what would be deleted.
+func (p *Purger) Purge(ctx context.Context, dryRun bool) (Report, error) {
+	now := p.now()
+	audio := repository.RetentionCriteria{Now: now, PlanDays: p.policies.audioDays(), DefaultDays: p.policies.For(models.PlanFree).AudioDays}
+	transcripts := repository.RetentionCriteria{Now: now, PlanDays: p.policies.transcriptDays(), DefaultDays: p.policies.For(models.PlanFree).TranscriptDays}
+
+	report := Report{DryRun: dryRun}
+	var err error
+	if dryRun {
+		if report.AudioTurns, err = p.retention.CountExpiredAudioTurns(ctx, audio); err != nil {
+			return report, err
+		}
+		report.Conversations, err = p.retention.CountExpiredConversations(ctx, transcripts)
+		return report, err
+	}
+
+	if report.AudioTurns, err = p.purgeAudio(ctx, audio); err != nil {
+		return report, err
+	}
+	report.Conversations, err = p.purgeConversations(ctx, transcripts)
+	return report, err
+}
+
+// purgeAudio deletes the audio of expired turns and returns how many turns were cleared
+func (p *Purger) purgeAudio(ctx context.Context, criteria repository.RetentionCriteria) (int64, error) {
+	var purged int64
+	for {
+		turns, err := p.retention.ListExpiredAudioTurns(ctx, criteria, batchSize)
+		if err != nil {
+			return purged, err
+		}
+		cleared := make([]string, 0, len(turns))
+		for _, turn := range turns {
+			if p.deleteBlob(ctx, turn.UserAudioKey) && p.deleteBlob(ctx, turn.AIAudioKey) {
+				cleared = append(cleared, turn.ConversationTurnsID)
+			}
+		}
+		if err := p.retention.ClearTurnAudio(ctx, cleared); err != nil {
+			return purged, err
+		}
+		purged += int64(len(cleared))
+		// Stop on a short batch, or when no turn could be cleared so the same batch would be listed again
+		if len(turns) < batchSize || len(cleared) == 0 {
+			return purged, nil
+		}
+	}
+}
+
+// purgeConversations deletes expired conversations with their audio and returns how many were deleted
+func (p *Purger) purgeConversations(ctx context.Context, criteria repository.RetentionCriteria) (int64, error) {
+	var purged int64
+	for {
+		conversations, err := p.retention.ListExpiredConversations(ctx, criteria, batchSize)
+		if err != nil {
+			return purged, err
+		}
+		deleted := make([]string, 0, len(conversations))
+		for _, c := range conversations {
+			if err := p.blobs.DeletePrefix(ctx, conversation.AudioPrefix(c.UserID, c.ConversationsID)); err != nil {
+				log.Printf("Failed to delete audio of expired conversation %s: %v", c.ConversationsID, err)
+				continue
+			}
+			deleted = append(deleted, c.ConversationsID)
+		}
+		if err := p.retention.DeleteConversations(ctx, deleted); err != nil {
+			return purged, err
+		}
+		purged += int64(len(deleted))
+		if len(conversations) < batchSize || len(deleted) == 0 {
+			return purged, nil
+		}
+	}
+}
+
+// deleteBlob deletes a blob and reports whether it is gone
+func (p *Purger) deleteBlob(ctx context.Context, key string) bool {
+	if key == "" {
+		return true
+	}
+	if err := p.blobs.Delete(ctx, key); err != nil {
+		log.Printf("Failed to delete expired audio %s: %v", key, err)
+		return false
+	}
+	return true
+}
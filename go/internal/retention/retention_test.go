@@ -0,0 +1,181 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"github.com/hiroky1983/talk/go/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicies_Effective(t *testing.T) {
+	policies := Policies{
+		models.PlanFree:    {AudioDays: 30, TranscriptDays: 365},
+		models.PlanPremium: {AudioDays: 365, TranscriptDays: 0},
+	}
+
+	tests := []struct {
+		name     string
+		plan     models.UserPlan
+		settings models.UserSettings
+		want     Policy
+	}{
+		{name: "plan retention", plan: models.PlanFree, want: Policy{AudioDays: 30, TranscriptDays: 365}},
+		{name: "user shortens", plan: models.PlanFree, settings: models.UserSettings{AudioRetentionDays: 7}, want: Policy{AudioDays: 7, TranscriptDays: 365}},
+		{name: "user cannot extend", plan: models.PlanFree, settings: models.UserSettings{AudioRetentionDays: 90}, want: Policy{AudioDays: 30, TranscriptDays: 365}},
+		{name: "user limits forever", plan: models.PlanPremium, settings: models.UserSettings{TranscriptRetentionDays: 180}, want: Policy{AudioDays: 365, TranscriptDays: 180}},
+		{name: "unknown plan is free", plan: models.UserPlan("PLAN_TEST"), want: Policy{AudioDays: 30, TranscriptDays: 365}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, policies.Effective(tt.plan, &tt.settings))
+		})
+	}
+}
+
+func TestLoadPolicies_Overrides(t *testing.T) {
+	t.Setenv("RETENTION_FREE_AUDIO_DAYS", "7")
+	t.Setenv("RETENTION_PREMIUM_TRANSCRIPT_DAYS", "30")
+
+	policies, err := LoadPolicies()
+	require.NoError(t, err)
+
+	assert.Equal(t, Policy{AudioDays: 7, TranscriptDays: 365}, policies[models.PlanFree])
+	assert.Equal(t, Policy{AudioDays: 365, TranscriptDays: 30}, policies[models.PlanPremium])
+}
+
+func TestLoadPolicies_RejectsInvalid(t *testing.T) {
+	t.Setenv("RETENTION_LITE_AUDIO_DAYS", "-1")
+
+	_, err := LoadPolicies()
+	assert.Error(t, err)
+}
+
+type fakeRetention struct {
+	repository.RetentionRepository
+	turns         []models.ConversationTurn
+	conversations []models.Conversation
+	criteria      []repository.RetentionCriteria
+	cleared       []string
+	deleted       []string
+}
+
+func (f *fakeRetention) ListExpiredAudioTurns(_ context.Context, criteria repository.RetentionCriteria, limit int) ([]models.ConversationTurn, error) {
+	f.criteria = append(f.criteria, criteria)
+	return f.turns[:min(limit, len(f.turns))], nil
+}
+
+func (f *fakeRetention) CountExpiredAudioTurns(_ context.Context, criteria repository.RetentionCriteria) (int64, error) {
+	f.criteria = append(f.criteria, criteria)
+	return int64(len(f.turns)), nil
+}
+
+func (f *fakeRetention) ClearTurnAudio(_ context.Context, turnIDs []string) error {
+	f.cleared = append(f.cleared, turnIDs...)
+	f.turns = f.turns[:0]
+	return nil
+}
+
+func (f *fakeRetention) ListExpiredConversations(_ context.Context, _ repository.RetentionCriteria, limit int) ([]models.Conversation, error) {
+	return f.conversations[:min(limit, len(f.conversations))], nil
+}
+
+func (f *fakeRetention) CountExpiredConversations(_ context.Context, _ repository.RetentionCriteria) (int64, error) {
+	return int64(len(f.conversations)), nil
+}
+
+func (f *fakeRetention) DeleteConversations(_ context.Context, conversationIDs []string) error {
+	f.deleted = append(f.deleted, conversationIDs...)
+	f.conversations = f.conversations[:0]
+	return nil
+}
+
+type fakeBlobs struct {
+	deleted []string
+	failing string
+}
+
+func (f *fakeBlobs) Put(context.Context, string, io.Reader) error { return nil }
+
+func (f *fakeBlobs) Open(context.Context, string) (storage.Blob, error) {
+	return nil, storage.ErrBlobNotFound
+}
+
+func (f *fakeBlobs) Delete(_ context.Context, key string) error {
+	if key == f.failing {
+		return errors.New("disk error")
+	}
+	f.deleted = append(f.deleted, key)
+	return nil
+}
+
+func (f *fakeBlobs) DeletePrefix(_ context.Context, prefix string) error {
+	if prefix == f.failing {
+		return errors.New("disk error")
+	}
+	f.deleted = append(f.deleted, prefix)
+	return nil
+}
+
+func newTestPurger() (*Purger, *fakeRetention, *fakeBlobs) {
+	repo := &fakeRetention{
+		turns: []models.ConversationTurn{
+			{ConversationTurnsID: "t1", UserAudioKey: "audio/u1/c1/0001-user.wav", AIAudioKey: "audio/u1/c1/0001-ai.wav"},
+			{ConversationTurnsID: "t2", AIAudioKey: "audio/u1/c1/0002-ai.wav"},
+		},
+		conversations: []models.Conversation{
+			{ConversationsID: "c2", UserID: "u1"},
+			{ConversationsID: "c3", UserID: "u2"},
+		},
+	}
+	blobs := &fakeBlobs{}
+	purger := NewPurger(repo, blobs, DefaultPolicies)
+	purger.now = func() time.Time { return time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC) }
+	return purger, repo, blobs
+}
+
+func TestPurger_DeletesBlobsThenRows(t *testing.T) {
+	purger, repo, blobs := newTestPurger()
+
+	report, err := purger.Purge(context.Background(), false)
+	require.NoError(t, err)
+
+	assert.Equal(t, Report{AudioTurns: 2, Conversations: 2}, report)
+	assert.Equal(t, []string{"t1", "t2"}, repo.cleared)
+	assert.Equal(t, []string{"c2", "c3"}, repo.deleted)
+	assert.Equal(t, []string{
+		"audio/u1/c1/0001-user.wav", "audio/u1/c1/0001-ai.wav", "audio/u1/c1/0002-ai.wav",
+		"audio/u1/c2", "audio/u2/c3",
+	}, blobs.deleted)
+	assert.Equal(t, 30, repo.criteria[0].PlanDays[models.PlanFree])
+	assert.Equal(t, 30, repo.criteria[0].DefaultDays)
+}
+
+func TestPurger_KeepsRowsWhoseBlobsFailToDelete(t *testing.T) {
+	purger, repo, blobs := newTestPurger()
+	blobs.failing = "audio/u1/c1/0002-ai.wav"
+
+	report, err := purger.Purge(context.Background(), false)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(1), report.AudioTurns)
+	assert.Equal(t, []string{"t1"}, repo.cleared)
+}
+
+func TestPurger_DryRunDeletesNothing(t *testing.T) {
+	purger, repo, blobs := newTestPurger()
+
+	report, err := purger.Purge(context.Background(), true)
+	require.NoError(t, err)
+
+	assert.Equal(t, Report{DryRun: true, AudioTurns: 2, Conversations: 2}, report)
+	assert.Empty(t, repo.cleared)
+	assert.Empty(t, repo.deleted)
+	assert.Empty(t, blobs.deleted)
+}
@@ -0,0 +1,58 @@
+package scenario
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+)
+
+// Goals tracks which goals of a scenario the learner achieved in a session.
+// It is safe for concurrent use.
+type Goals struct {
+	mu       sync.Mutex
+	total    int
+	achieved map[int]bool
+}
+
+// NewGoals starts tracking a scenario with total goals
+func NewGoals(total int) *Goals {
+	return &Goals{total: total, achieved: make(map[int]bool, total)}
+}
+
+// Achieve marks the goal at index as achieved and reports whether it was newly achieved.
+// Indices outside the scenario's goals are ignored.
+func (g *Goals) Achieve(index int) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if index < 0 || index >= g.total || g.achieved[index] {
+		return false
+	}
+	g.achieved[index] = true
+	return true
+}
+
+// Achieved returns the indices of the achieved goals in ascending order
+func (g *Goals) Achieved() []int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	indices := make([]int, 0, len(g.achieved))
+	for index := range g.achieved {
+		indices = append(indices, index)
+	}
+	sort.Ints(indices)
+	return indices
+}
+
+// Total returns how many goals the scenario has
+func (g *Goals) Total() int {
+	return g.total
+}
+
+// EncodeIndices returns goal indices as stored in ScenarioCompletion.AchievedGoals
+func EncodeIndices(indices []int) string {
+	if len(indices) == 0 {
+		return "[]"
+	}
+	encoded, _ := json.Marshal(indices)
+	return string(encoded)
+}
@@ -0,0 +1,156 @@
+// Package scenario manages the catalog of role-play scenarios and tracks the goals a learner
+// achieves while playing one.
+//
+// The catalog is maintained as JSON (see catalog.json for the default one) and imported into the
+// database with talkctl import-scenarios, so scenarios can be added without a release of the server.
+package scenario
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+const (
+	// MaxGoals is how many goals a scenario has at most
+	MaxGoals = 10
+	// MaxVocabulary is how many required words and phrases a scenario lists at most
+	MaxVocabulary = 50
+	// MaxTitleLength is the longest title in characters
+	MaxTitleLength = 200
+	// maxLanguageLength matches the size of language columns
+	maxLanguageLength = 10
+)
+
+//go:embed catalog.json
+var defaultCatalog []byte
+
+// Text is a scenario written in one practice language
+type Text struct {
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Goals       []string `json:"goals"`
+	Vocabulary  []string `json:"vocabulary"`
+	SetupPrompt string   `json:"setup_prompt"`
+}
+
+// Entry is a scenario of a catalog file with its texts keyed by language code
+type Entry struct {
+	Slug          string                    `json:"slug"`
+	Difficulty    models.ScenarioDifficulty `json:"difficulty"`
+	Localizations map[string]Text           `json:"localizations"`
+}
+
+// DefaultCatalog returns the catalog shipped with the server
+func DefaultCatalog() ([]Entry, error) {
+	return ParseCatalog(bytes.NewReader(defaultCatalog))
+}
+
+// ParseCatalog reads and validates a catalog file: a JSON array of entries
+func ParseCatalog(r io.Reader) ([]Entry, error) {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	var entries []Entry
+	if err := dec.Decode(&entries); err != nil {
+		return nil, fmt.Errorf("invalid catalog: %w", err)
+	}
+	slugs := make(map[string]bool, len(entries))
+	for i := range entries {
+		if err := entries[i].Validate(); err != nil {
+			return nil, fmt.Errorf("scenario %d: %w", i+1, err)
+		}
+		if slugs[entries[i].Slug] {
+			return nil, fmt.Errorf("scenario %d: duplicate slug %q", i+1, entries[i].Slug)
+		}
+		slugs[entries[i].Slug] = true
+	}
+	return entries, nil
+}
+
+// Validate checks that the entry can be stored and played
+func (e *Entry) Validate() error {
+	if e.Slug == "" || len(e.Slug) > 100 || strings.Trim(e.Slug, "abcdefghijklmnopqrstuvwxyz0123456789-") != "" {
+		return fmt.Errorf("invalid slug %q: use lowercase letters, digits and hyphens", e.Slug)
+	}
+	switch e.Difficulty {
+	case models.ScenarioDifficultyBeginner, models.ScenarioDifficultyIntermediate, models.ScenarioDifficultyAdvanced:
+	default:
+		return fmt.Errorf("%s: unknown difficulty %q", e.Slug, e.Difficulty)
+	}
+	if len(e.Localizations) == 0 {
+		return fmt.Errorf("%s: no localizations", e.Slug)
+	}
+	for language, text := range e.Localizations {
+		if language == "" || len(language) > maxLanguageLength {
+			return fmt.Errorf("%s: invalid language %q", e.Slug, language)
+		}
+		if err := text.validate(); err != nil {
+			return fmt.Errorf("%s (%s): %w", e.Slug, language, err)
+		}
+	}
+	return nil
+}
+
+func (t *Text) validate() error {
+	if strings.TrimSpace(t.Title) == "" {
+		return errors.New("title is empty")
+	}
+	if utf8.RuneCountInString(t.Title) > MaxTitleLength {
+		return fmt.Errorf("title exceeds %d characters", MaxTitleLength)
+	}
+	if len(t.Goals) == 0 || len(t.Goals) > MaxGoals {
+		return fmt.Errorf("a scenario needs 1 to %d goals", MaxGoals)
+	}
+	for _, goal := range t.Goals {
+		if strings.TrimSpace(goal) == "" {
+			return errors.New("goal is empty")
+		}
+	}
+	if len(t.Vocabulary) > MaxVocabulary {
+		return fmt.Errorf("vocabulary exceeds %d entries", MaxVocabulary)
+	}
+	if strings.TrimSpace(t.SetupPrompt) == "" {
+		return errors.New("setup prompt is empty")
+	}
+	return nil
+}
+
+// Model converts the entry into a scenario listed at position in the catalog
+func (e *Entry) Model(position int) (*models.Scenario, error) {
+	scenario := &models.Scenario{
+		Slug:       e.Slug,
+		Difficulty: e.Difficulty,
+		Position:   position,
+		Active:     true,
+	}
+	for language, text := range e.Localizations {
+		goals, err := json.Marshal(text.Goals)
+		if err != nil {
+			return nil, err
+		}
+		vocabulary := text.Vocabulary
+		if vocabulary == nil {
+			vocabulary = []string{}
+		}
+		words, err := json.Marshal(vocabulary)
+		if err != nil {
+			return nil, err
+		}
+		scenario.Localizations = append(scenario.Localizations, models.ScenarioLocalization{
+			Language:    language,
+			Title:       strings.TrimSpace(text.Title),
+			Description: strings.TrimSpace(text.Description),
+			Goals:       string(goals),
+			Vocabulary:  string(words),
+			SetupPrompt: strings.TrimSpace(text.SetupPrompt),
+		})
+	}
+	return scenario, nil
+}
@@ -0,0 +1,90 @@
+package scenario
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultCatalog(t *testing.T) {
+	entries, err := DefaultCatalog()
+	require.NoError(t, err)
+	require.NotEmpty(t, entries)
+	for _, entry := range entries {
+		assert.NotEmpty(t, entry.Localizations["en"].Title, "%s has no English text", entry.Slug)
+	}
+}
+
+func TestParseCatalog(t *testing.T) {
+	valid := `{"slug": "cafe", "difficulty": "SCENARIO_DIFFICULTY_BEGINNER", "localizations": {"en": {"title": "Café", "goals": ["Order"], "setup_prompt": "You are a barista."}}}`
+
+	tests := []struct {
+		name    string
+		catalog string
+		wantErr string
+	}{
+		{name: "valid", catalog: "[" + valid + "]"},
+		{name: "not an array", catalog: valid, wantErr: "invalid catalog"},
+		{name: "unknown field", catalog: `[{"slug": "cafe", "level": 1}]`, wantErr: "unknown field"},
+		{name: "duplicate slug", catalog: "[" + valid + "," + valid + "]", wantErr: "duplicate slug"},
+		{name: "invalid slug", catalog: "[" + strings.Replace(valid, `"cafe"`, `"Café"`, 1) + "]", wantErr: "invalid slug"},
+		{name: "unknown difficulty", catalog: "[" + strings.Replace(valid, "BEGINNER", "EASY", 1) + "]", wantErr: "unknown difficulty"},
+		{name: "no goals", catalog: "[" + strings.Replace(valid, `["Order"]`, `[]`, 1) + "]", wantErr: "goals"},
+		{name: "no setup prompt", catalog: "[" + strings.Replace(valid, "You are a barista.", " ", 1) + "]", wantErr: "setup prompt"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries, err := ParseCatalog(strings.NewReader(tt.catalog))
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, entries, 1)
+		})
+	}
+}
+
+func TestEntry_Model(t *testing.T) {
+	entry := Entry{
+		Slug:       "cafe",
+		Difficulty: models.ScenarioDifficultyBeginner,
+		Localizations: map[string]Text{
+			"vi": {Title: " Quán cà phê ", Goals: []string{"Chào", "Gọi đồ uống"}, SetupPrompt: "You are a barista."},
+		},
+	}
+
+	scenario, err := entry.Model(3)
+	require.NoError(t, err)
+	assert.Equal(t, 3, scenario.Position)
+	assert.True(t, scenario.Active)
+	require.Len(t, scenario.Localizations, 1)
+
+	localization := scenario.Localizations[0]
+	assert.Equal(t, "Quán cà phê", localization.Title)
+	goals, err := localization.GoalList()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Chào", "Gọi đồ uống"}, goals)
+	assert.Equal(t, "[]", localization.Vocabulary)
+}
+
+func TestGoals(t *testing.T) {
+	goals := NewGoals(3)
+
+	assert.True(t, goals.Achieve(2))
+	assert.False(t, goals.Achieve(2), "a goal is achieved once")
+	assert.False(t, goals.Achieve(3), "out of range")
+	assert.False(t, goals.Achieve(-1), "out of range")
+	assert.True(t, goals.Achieve(0))
+
+	assert.Equal(t, []int{0, 2}, goals.Achieved())
+	var decoded []int
+	require.NoError(t, json.Unmarshal([]byte(EncodeIndices(goals.Achieved())), &decoded))
+	assert.Equal(t, []int{0, 2}, decoded)
+	assert.Equal(t, "[]", EncodeIndices(nil))
+}
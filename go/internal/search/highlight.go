@@ -0,0 +1,98 @@
+package search
+
+import (
+	"slices"
+)
+
+const (
+	// snippetLength is the number of characters of a snippet
+	snippetLength = 120
+	// snippetLead is how many characters a snippet shows before the first match
+	snippetLead = 30
+)
+
+// Range is a highlighted part of a snippet in Unicode code points, End exclusive
+type Range struct {
+	Start int
+	End   int
+}
+
+// Snippet is an excerpt of a text with the query's matches highlighted
+type Snippet struct {
+	Text       string
+	Highlights []Range
+}
+
+// Highlight finds the query's terms in text and returns an excerpt around the first match.
+// It returns false when no term occurs in text.
+func (q Query) Highlight(text string) (Snippet, bool) {
+	f := foldText(text)
+	var matches []Range
+	for _, t := range q.terms {
+		for _, m := range find(f.runes, t) {
+			matches = append(matches, f.span(m.Start, m.End))
+		}
+	}
+	if len(matches) == 0 {
+		return Snippet{}, false
+	}
+	matches = merge(matches)
+
+	original := []rune(text)
+	start := max(0, matches[0].Start-snippetLead)
+	end := min(len(original), start+snippetLength)
+	if end-start < snippetLength {
+		start = max(0, end-snippetLength)
+	}
+
+	snippet := Snippet{Text: string(original[start:end])}
+	offset := -start
+	if start > 0 {
+		snippet.Text = "…" + snippet.Text
+		offset++
+	}
+	if end < len(original) {
+		snippet.Text += "…"
+	}
+	for _, m := range matches {
+		if m.End <= start || m.Start >= end {
+			continue
+		}
+		snippet.Highlights = append(snippet.Highlights, Range{
+			Start: max(m.Start, start) + offset,
+			End:   min(m.End, end) + offset,
+		})
+	}
+	return snippet, true
+}
+
+// find returns the positions of t in runes. Words only match whole words.
+func find(runes []rune, t term) []Range {
+	var found []Range
+	for i := 0; i+len(t.runes) <= len(runes); i++ {
+		if !slices.Equal(runes[i:i+len(t.runes)], t.runes) {
+			continue
+		}
+		end := i + len(t.runes)
+		if t.kind == kindWord && (i > 0 && classify(runes[i-1]) == kindWord || end < len(runes) && classify(runes[end]) == kindWord) {
+			continue
+		}
+		found = append(found, Range{Start: i, End: end})
+	}
+	return found
+}
+
+// merge sorts ranges and joins overlapping or adjacent ones
+func merge(ranges []Range) []Range {
+	slices.SortFunc(ranges, func(a, b Range) int { return a.Start - b.Start })
+	merged := ranges[:1]
+	for _, r := range ranges[1:] {
+		last := &merged[len(merged)-1]
+		if r.Start <= last.End {
+			last.End = max(last.End, r.End)
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
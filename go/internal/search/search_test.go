@@ -0,0 +1,88 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLexemes_FoldsVietnameseDiacritics(t *testing.T) {
+	assert.Equal(t, "cam on ban di dau", Lexemes("Cảm ơn bạn!", "Đi đâu?"))
+}
+
+func TestLexemes_IndexesJapaneseNGrams(t *testing.T) {
+	assert.Equal(t, "日 本 語 日本 本語 ok", Lexemes("日本語, OK"))
+}
+
+func TestLexemes_KeepsKanaVoicing(t *testing.T) {
+	assert.Equal(t, "ガ ス ガス", Lexemes("ｶﾞｽ"))
+}
+
+func TestWords(t *testing.T) {
+	assert.Equal(t, []string{"toi", "an", "pho"}, Words("Tôi ăn phở."))
+	assert.Equal(t, []string{"昨日", "は友", "達と", "映画", "見た", "ok"}, Words("昨日は友達と映画、見た。OK"))
+	assert.Empty(t, Words(" ... "))
+}
+
+func TestParseQuery_TSQuery(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"cam on", "'cam' & 'on'"},
+		{"Cảm ơn", "'cam' & 'on'"},
+		{"日本語", "'日本' & '本語'"},
+		{"犬", "'犬'"},
+		{"it's", "'it' & 's'"},
+		{"  !? ", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseQuery(tt.query).TSQuery())
+		})
+	}
+	assert.True(t, ParseQuery("!?").Empty())
+}
+
+func TestHighlight_MatchesWithoutDiacritics(t *testing.T) {
+	snippet, ok := ParseQuery("cam on").Highlight("Không, cảm ơn bạn")
+
+	assert.True(t, ok)
+	assert.Equal(t, "Không, cảm ơn bạn", snippet.Text)
+	// "on" must not match inside "Không"
+	assert.Equal(t, []Range{{Start: 7, End: 10}, {Start: 11, End: 13}}, snippet.Highlights)
+}
+
+func TestHighlight_Japanese(t *testing.T) {
+	snippet, ok := ParseQuery("日本語").Highlight("私は日本語を勉強しています")
+
+	assert.True(t, ok)
+	assert.Equal(t, []Range{{Start: 2, End: 5}}, snippet.Highlights)
+}
+
+func TestHighlight_TrimsLongText(t *testing.T) {
+	text := ""
+	for range 50 {
+		text += "lorem "
+	}
+	text += "target"
+	for range 50 {
+		text += " ipsum"
+	}
+
+	snippet, ok := ParseQuery("target").Highlight(text)
+
+	assert.True(t, ok)
+	runes := []rune(snippet.Text)
+	assert.Equal(t, "…", string(runes[0]))
+	assert.Equal(t, "…", string(runes[len(runes)-1]))
+	if assert.Len(t, snippet.Highlights, 1) {
+		h := snippet.Highlights[0]
+		assert.Equal(t, "target", string(runes[h.Start:h.End]))
+	}
+}
+
+func TestHighlight_NoMatch(t *testing.T) {
+	_, ok := ParseQuery("xyz").Highlight("hello")
+	assert.False(t, ok)
+}
@@ -0,0 +1,236 @@
+// Package search turns transcripts into full-text search lexemes and highlights matches.
+//
+// Postgres' text search parsers split words on spaces and know nothing about
+// Vietnamese tone marks or Japanese, so lexemes are computed here and stored as
+// plain text. The database builds the tsvector with array_to_tsvector and never
+// parses the text itself:
+//
+//   - Latin script words are lowercased and stripped of diacritics (đ becomes d),
+//     so "cảm ơn" and "cam on" match each other.
+//   - Japanese and Chinese script is indexed as character unigrams and bigrams,
+//     and queries are matched by their bigrams, since the text has no spaces.
+package search
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// kind classifies a folded rune for tokenization
+type kind int
+
+const (
+	kindSeparator kind = iota
+	kindWord
+	kindCJK
+)
+
+func classify(r rune) kind {
+	switch {
+	case isCJK(r):
+		return kindCJK
+	case unicode.IsLetter(r) || unicode.IsDigit(r):
+		return kindWord
+	}
+	return kindSeparator
+}
+
+// isCJK reports whether r belongs to a script written without spaces between words
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana) || r == 'ー'
+}
+
+// fold returns the searchable form of r: diacritics are removed from Latin script,
+// while kana keep their voicing marks (ガ and カ are different sounds)
+func fold(r rune) []rune {
+	if isCJK(r) {
+		return []rune(norm.NFKC.String(string(r)))
+	}
+	var folded []rune
+	for _, d := range norm.NFKD.String(string(r)) {
+		if unicode.Is(unicode.Mn, d) {
+			continue
+		}
+		d = unicode.ToLower(d)
+		if d == 'đ' {
+			d = 'd'
+		}
+		folded = append(folded, d)
+	}
+	return folded
+}
+
+// voicingMark returns the combining form of a kana voicing mark, including the
+// spacing and half-width forms typed separately from the kana they modify
+func voicingMark(r rune) (rune, bool) {
+	switch r {
+	case '\u3099', '\u309B', '\uFF9E':
+		return '\u3099', true
+	case '\u309A', '\u309C', '\uFF9F':
+		return '\u309A', true
+	}
+	return 0, false
+}
+
+// folded is text folded rune by rune, remembering which original rune each folded rune came from
+type folded struct {
+	runes  []rune
+	origin []int
+	length int // Number of runes of the original text
+}
+
+func foldText(text string) folded {
+	original := []rune(text)
+	f := folded{length: len(original)}
+	for i, r := range original {
+		if mark, ok := voicingMark(r); ok && len(f.runes) > 0 && isCJK(f.runes[len(f.runes)-1]) {
+			last := &f.runes[len(f.runes)-1]
+			if composed := []rune(norm.NFC.String(string([]rune{*last, mark}))); len(composed) == 1 {
+				*last = composed[0]
+				continue
+			}
+		}
+		for _, d := range fold(r) {
+			f.runes = append(f.runes, d)
+			f.origin = append(f.origin, i)
+		}
+	}
+	return f
+}
+
+// span returns the original rune range of the folded runes [start, end)
+func (f folded) span(start, end int) Range {
+	r := Range{Start: f.origin[start], End: f.length}
+	if end < len(f.runes) {
+		r.End = f.origin[end]
+	}
+	return r
+}
+
+// run is a maximal sequence of folded runes of the same kind
+type run struct {
+	kind  kind
+	runes []rune
+}
+
+func runs(text string) []run {
+	var result []run
+	var current run
+	flush := func() {
+		if current.kind != kindSeparator && len(current.runes) > 0 {
+			result = append(result, current)
+		}
+		current = run{}
+	}
+	for _, r := range foldText(text).runes {
+		k := classify(r)
+		if k != current.kind {
+			flush()
+			current.kind = k
+		}
+		current.runes = append(current.runes, r)
+	}
+	flush()
+	return result
+}
+
+// bigrams returns the character bigrams of runes, or the single character of a one character run
+func bigrams(runes []rune) []string {
+	if len(runes) == 1 {
+		return []string{string(runes)}
+	}
+	grams := make([]string, 0, len(runes)-1)
+	for i := 0; i+1 < len(runes); i++ {
+		grams = append(grams, string(runes[i:i+2]))
+	}
+	return grams
+}
+
+// Lexemes returns the space separated lexemes indexing texts
+func Lexemes(texts ...string) string {
+	seen := make(map[string]bool)
+	var lexemes []string
+	add := func(lexeme string) {
+		if !seen[lexeme] {
+			seen[lexeme] = true
+			lexemes = append(lexemes, lexeme)
+		}
+	}
+	for _, text := range texts {
+		for _, r := range runs(text) {
+			if r.kind == kindWord {
+				add(string(r.runes))
+				continue
+			}
+			for _, c := range r.runes {
+				add(string(c))
+			}
+			if len(r.runes) > 1 {
+				for _, gram := range bigrams(r.runes) {
+					add(gram)
+				}
+			}
+		}
+	}
+	return strings.Join(lexemes, " ")
+}
+
+// Words splits text into the units its length and lexical variety are measured in.
+// Latin script yields its folded words. Japanese and Chinese script has no spaces, so its runs
+// are cut into two character chunks, about the average length of a Japanese word.
+func Words(text string) []string {
+	var words []string
+	for _, r := range runs(text) {
+		if r.kind == kindWord {
+			words = append(words, string(r.runes))
+			continue
+		}
+		for i := 0; i < len(r.runes); i += 2 {
+			words = append(words, string(r.runes[i:min(i+2, len(r.runes))]))
+		}
+	}
+	return words
+}
+
+// Query is a parsed search query
+type Query struct {
+	terms []term
+}
+
+// term is a word or a CJK run of the query
+type term struct {
+	kind  kind
+	runes []rune
+}
+
+// ParseQuery parses free text typed by the user into a query matching every term
+func ParseQuery(text string) Query {
+	var q Query
+	for _, r := range runs(text) {
+		q.terms = append(q.terms, term{kind: r.kind, runes: r.runes})
+	}
+	return q
+}
+
+// Empty reports whether the query has nothing to search for
+func (q Query) Empty() bool {
+	return len(q.terms) == 0
+}
+
+// TSQuery returns the query as tsquery text matching the lexemes built by Lexemes.
+// Lexemes only contain letters and digits, so quoting them is enough.
+func (q Query) TSQuery() string {
+	var lexemes []string
+	for _, t := range q.terms {
+		if t.kind == kindWord {
+			lexemes = append(lexemes, "'"+string(t.runes)+"'")
+			continue
+		}
+		for _, gram := range bigrams(t.runes) {
+			lexemes = append(lexemes, "'"+gram+"'")
+		}
+	}
+	return strings.Join(lexemes, " & ")
+}
@@ -0,0 +1,132 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileStore is a BlobStore keeping blobs as files under a root directory
+type FileStore struct {
+	root string
+}
+
+// DefaultDir is where blobs are stored when BLOB_STORAGE_DIR is not set
+const DefaultDir = "data/blobs"
+
+// DirFromEnv returns the blob directory configured by BLOB_STORAGE_DIR
+func DirFromEnv() string {
+	if dir := os.Getenv("BLOB_STORAGE_DIR"); dir != "" {
+		return dir
+	}
+	return DefaultDir
+}
+
+// NewFileStore creates a file store rooted at dir, creating the directory if needed
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create blob directory: %w", err)
+	}
+	return &FileStore{root: dir}, nil
+}
+
+// path maps a key to a file path, rejecting keys that would escape the root
+func (s *FileStore) path(key string) (string, error) {
+	local := filepath.FromSlash(key)
+	if key == "" || !filepath.IsLocal(local) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return filepath.Join(s.root, local), nil
+}
+
+// Put writes data under key, replacing any existing blob.
+// The data is written to a temporary file first so readers never see a partial blob.
+func (s *FileStore) Put(ctx context.Context, key string, data io.Reader) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("failed to create blob directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create blob: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write blob: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write blob: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to store blob: %w", err)
+	}
+	return nil
+}
+
+// Open opens the blob stored under key
+func (s *FileStore) Open(ctx context.Context, key string) (Blob, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrBlobNotFound
+		}
+		return nil, fmt.Errorf("failed to open blob: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to stat blob: %w", err)
+	}
+	return &fileBlob{File: file, info: info}, nil
+}
+
+// Delete deletes the blob stored under key. Deleting a missing blob is not an error.
+func (s *FileStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete blob: %w", err)
+	}
+	return nil
+}
+
+// DeletePrefix deletes every blob whose key is under the directory prefix
+func (s *FileStore) DeletePrefix(ctx context.Context, prefix string) error {
+	path, err := s.path(prefix)
+	if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(path); err != nil {
+		return fmt.Errorf("failed to delete blobs: %w", err)
+	}
+	return nil
+}
+
+type fileBlob struct {
+	*os.File
+	info fs.FileInfo
+}
+
+func (b *fileBlob) Size() int64 {
+	return b.info.Size()
+}
+
+func (b *fileBlob) ModTime() time.Time {
+	return b.info.ModTime()
+}
@@ -0,0 +1,27 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+// ErrBlobNotFound is returned when no blob is stored under a key
+var ErrBlobNotFound = errors.New("blob not found")
+
+// Blob is an opened stored object. It is seekable so it can serve range requests.
+type Blob interface {
+	io.ReadSeekCloser
+	Size() int64
+	ModTime() time.Time
+}
+
+// BlobStore stores binary objects such as recorded audio under slash separated keys
+type BlobStore interface {
+	Put(ctx context.Context, key string, data io.Reader) error
+	Open(ctx context.Context, key string) (Blob, error)
+	Delete(ctx context.Context, key string) error
+	// DeletePrefix deletes every blob whose key is under the directory prefix
+	DeletePrefix(ctx context.Context, prefix string) error
+}
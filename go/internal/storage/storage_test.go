@@ -0,0 +1,40 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStore_PutOpenDelete(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewFileStore(t.TempDir())
+	require.NoError(t, err)
+
+	require.NoError(t, store.Put(ctx, "audio/u1/c1/0001-user.wav", strings.NewReader("RIFF")))
+	blob, err := store.Open(ctx, "audio/u1/c1/0001-user.wav")
+	require.NoError(t, err)
+	data, err := io.ReadAll(blob)
+	require.NoError(t, err)
+	blob.Close()
+	assert.Equal(t, "RIFF", string(data))
+	assert.Equal(t, int64(4), blob.Size())
+
+	require.NoError(t, store.DeletePrefix(ctx, "audio/u1/c1"))
+	_, err = store.Open(ctx, "audio/u1/c1/0001-user.wav")
+	assert.ErrorIs(t, err, ErrBlobNotFound)
+	assert.NoError(t, store.Delete(ctx, "audio/u1/c1/0001-user.wav"))
+}
+
+func TestFileStore_RejectsKeysOutsideRoot(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	require.NoError(t, err)
+
+	for _, key := range []string{"", "../secret", "/etc/passwd", "audio/../../x"} {
+		assert.Error(t, store.Put(context.Background(), key, strings.NewReader("x")), key)
+	}
+}
@@ -0,0 +1,178 @@
+// Package summary generates the recap of finished conversations with the AI service.
+//
+// Summaries are queued in the conversation_summaries table, so requests survive restarts.
+// Worker claims due requests, retries failures with exponential backoff and gives up after
+// MaxAttempts. Results are tied to the request they answer, so a conversation resumed and
+// summarized again never ends up with a stale recap.
+package summary
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"time"
+
+	ai "github.com/hiroky1983/talk/go/gen/ai"
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+)
+
+const (
+	// MaxAttempts is how often a summary is attempted before it is marked failed
+	MaxAttempts = 8
+	// batchSize is how many due summaries a worker claims at once
+	batchSize = 10
+	// callTimeout bounds a Summarize call to the AI service
+	callTimeout = 2 * time.Minute
+	// leaseDuration keeps claimed summaries from other workers; it outlasts a batch of calls
+	leaseDuration = batchSize*callTimeout + time.Minute
+	// baseBackoff and maxBackoff bound the delay before retrying a failed attempt
+	baseBackoff = time.Minute
+	maxBackoff  = 6 * time.Hour
+)
+
+// errAIUnavailable is returned while the AI service client is not connected
+var errAIUnavailable = errors.New("AI service client is not available")
+
+// ClientProvider returns the AI service client, or nil while it is not connected
+type ClientProvider interface {
+	GetGRPCClient() ai.AIConversationServiceClient
+}
+
+// Worker summarizes conversations whose summary was requested
+type Worker struct {
+	summaries     repository.SummaryRepository
+	conversations repository.ConversationRepository
+	ai            ClientProvider
+	now           func() time.Time
+}
+
+// NewWorker creates a new summary worker
+func NewWorker(summaries repository.SummaryRepository, conversations repository.ConversationRepository, ai ClientProvider) *Worker {
+	return &Worker{
+		summaries:     summaries,
+		conversations: conversations,
+		ai:            ai,
+		now:           time.Now,
+	}
+}
+
+// Run calls ProcessDue every interval until ctx is canceled
+func (w *Worker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.ProcessDue(ctx); err != nil {
+				log.Printf("Failed to process conversation summaries: %v", err)
+			}
+		}
+	}
+}
+
+// ProcessDue summarizes a batch of due conversations. A failed summary is
+// scheduled for retry; only failures to access the database are returned.
+func (w *Worker) ProcessDue(ctx context.Context) error {
+	now := w.now()
+	summaries, err := w.summaries.ClaimDueSummaries(ctx, now, now.Add(leaseDuration), batchSize)
+	if err != nil {
+		return err
+	}
+	for i := range summaries {
+		summary := &summaries[i]
+		content, err := w.summarize(ctx, summary)
+		if err != nil {
+			log.Printf("Failed to summarize conversation %s (attempt %d/%d): %v",
+				summary.ConversationID, summary.Attempts, MaxAttempts, err)
+			var retryAt *time.Time
+			if summary.Attempts < MaxAttempts {
+				at := w.now().Add(backoff(summary.Attempts))
+				retryAt = &at
+			}
+			if err := w.summaries.FailSummary(ctx, summary, err.Error(), retryAt); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := w.summaries.CompleteSummary(ctx, summary, content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// summarize asks the AI service to summarize a conversation and returns the recap as JSON
+func (w *Worker) summarize(ctx context.Context, summary *models.ConversationSummary) (string, error) {
+	turns, err := w.conversations.ListConversationTurns(ctx, summary.ConversationID, 0, 0)
+	if err != nil {
+		return "", err
+	}
+	// A conversation without turns has nothing to recap
+	res := &ai.SummarizeResponse{}
+	if len(turns) > 0 {
+		client := w.ai.GetGRPCClient()
+		if client == nil {
+			return "", errAIUnavailable
+		}
+		req := &ai.SummarizeRequest{
+			ConversationId: summary.ConversationID,
+			Turns:          make([]*ai.HistoryTurn, 0, len(turns)),
+		}
+		if summary.Conversation != nil {
+			req.Language = summary.Conversation.Language
+			req.Character = summary.Conversation.Character
+		}
+		for _, turn := range turns {
+			req.Turns = append(req.Turns, &ai.HistoryTurn{UserTranscript: turn.UserTranscript, AiText: turn.AIText})
+		}
+
+		callCtx, cancel := context.WithTimeout(ctx, callTimeout)
+		defer cancel()
+		if res, err = client.Summarize(callCtx, req); err != nil {
+			return "", err
+		}
+	}
+	encoded, err := json.Marshal(toSummaryContent(res))
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
+
+// toSummaryContent converts the AI service's recap, dropping empty entries
+func toSummaryContent(res *ai.SummarizeResponse) models.SummaryContent {
+	content := models.SummaryContent{Topics: []string{}, Vocabulary: []models.SummaryVocabulary{}, Mistakes: []models.SummaryMistake{}}
+	for _, topic := range res.GetTopics() {
+		if topic != "" {
+			content.Topics = append(content.Topics, topic)
+		}
+	}
+	for _, item := range res.GetVocabulary() {
+		if item.GetTerm() != "" {
+			content.Vocabulary = append(content.Vocabulary, models.SummaryVocabulary{Term: item.GetTerm(), Meaning: item.GetMeaning()})
+		}
+	}
+	for _, mistake := range res.GetMistakes() {
+		if mistake.GetOriginal() != "" || mistake.GetCorrection() != "" {
+			content.Mistakes = append(content.Mistakes, models.SummaryMistake{
+				Original:    mistake.GetOriginal(),
+				Correction:  mistake.GetCorrection(),
+				Explanation: mistake.GetExplanation(),
+			})
+		}
+	}
+	return content
+}
+
+// backoff returns the delay before retrying after the given number of attempts
+func backoff(attempts int) time.Duration {
+	delay := baseBackoff
+	for i := 1; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxBackoff)
+}
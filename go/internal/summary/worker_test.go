@@ -0,0 +1,152 @@
+package summary
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ai "github.com/hiroky1983/talk/go/gen/ai"
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type fakeSummaries struct {
+	repository.SummaryRepository
+	due       []models.ConversationSummary
+	completed map[string]string
+	failed    map[string]*time.Time
+}
+
+func (f *fakeSummaries) ClaimDueSummaries(_ context.Context, _, _ time.Time, _ int) ([]models.ConversationSummary, error) {
+	due := f.due
+	f.due = nil
+	return due, nil
+}
+
+func (f *fakeSummaries) CompleteSummary(_ context.Context, summary *models.ConversationSummary, content string) error {
+	f.completed[summary.ConversationID] = content
+	return nil
+}
+
+func (f *fakeSummaries) FailSummary(_ context.Context, summary *models.ConversationSummary, _ string, retryAt *time.Time) error {
+	f.failed[summary.ConversationID] = retryAt
+	return nil
+}
+
+type fakeTurns struct {
+	repository.ConversationRepository
+	turns map[string][]models.ConversationTurn
+}
+
+func (f *fakeTurns) ListConversationTurns(_ context.Context, conversationID string, _, _ int) ([]models.ConversationTurn, error) {
+	return f.turns[conversationID], nil
+}
+
+type fakeAI struct {
+	ai.AIConversationServiceClient
+	requests []*ai.SummarizeRequest
+	err      error
+}
+
+func (f *fakeAI) GetGRPCClient() ai.AIConversationServiceClient {
+	return f
+}
+
+func (f *fakeAI) Summarize(_ context.Context, req *ai.SummarizeRequest, _ ...grpc.CallOption) (*ai.SummarizeResponse, error) {
+	f.requests = append(f.requests, req)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ai.SummarizeResponse{
+		Topics:     []string{"pets", ""},
+		Vocabulary: []*ai.VocabularyItem{{Term: "con chó", Meaning: "dog"}},
+		Mistakes:   []*ai.Mistake{{Original: "tôi có một chó", Correction: "tôi có một con chó"}},
+	}, nil
+}
+
+var now = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestWorker(client *fakeAI, due ...models.ConversationSummary) (*Worker, *fakeSummaries) {
+	summaries := &fakeSummaries{due: due, completed: map[string]string{}, failed: map[string]*time.Time{}}
+	turns := &fakeTurns{turns: map[string][]models.ConversationTurn{
+		"conversation-1": {{Seq: 1, UserTranscript: "tôi có một chó", AIText: "Con chó tên gì?"}},
+	}}
+	w := NewWorker(summaries, turns, client)
+	w.now = func() time.Time { return now }
+	return w, summaries
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 1, want: time.Minute},
+		{attempts: 2, want: 2 * time.Minute},
+		{attempts: 5, want: 16 * time.Minute},
+		{attempts: 20, want: maxBackoff},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, backoff(tt.attempts), "attempts %d", tt.attempts)
+	}
+}
+
+func TestWorker_StoresSummary(t *testing.T) {
+	client := &fakeAI{}
+	w, summaries := newTestWorker(client, models.ConversationSummary{
+		ConversationID: "conversation-1",
+		Attempts:       1,
+		Conversation:   &models.Conversation{Language: "vi", Character: "friend"},
+	})
+
+	require.NoError(t, w.ProcessDue(context.Background()))
+
+	require.Len(t, client.requests, 1)
+	assert.Equal(t, "vi", client.requests[0].Language)
+	assert.Equal(t, "tôi có một chó", client.requests[0].Turns[0].UserTranscript)
+	assert.JSONEq(t, `{
+		"topics": ["pets"],
+		"vocabulary": [{"term": "con chó", "meaning": "dog"}],
+		"mistakes": [{"original": "tôi có một chó", "correction": "tôi có một con chó", "explanation": ""}]
+	}`, summaries.completed["conversation-1"])
+}
+
+func TestWorker_EmptyConversationSkipsAI(t *testing.T) {
+	client := &fakeAI{}
+	w, summaries := newTestWorker(client, models.ConversationSummary{ConversationID: "conversation-2", Attempts: 1})
+
+	require.NoError(t, w.ProcessDue(context.Background()))
+
+	assert.Empty(t, client.requests)
+	assert.JSONEq(t, `{"topics": [], "vocabulary": [], "mistakes": []}`, summaries.completed["conversation-2"])
+}
+
+func TestWorker_RetriesFailures(t *testing.T) {
+	client := &fakeAI{err: errors.New("unavailable")}
+	w, summaries := newTestWorker(client,
+		models.ConversationSummary{ConversationID: "conversation-1", Attempts: 3},
+	)
+
+	require.NoError(t, w.ProcessDue(context.Background()))
+
+	require.Contains(t, summaries.failed, "conversation-1")
+	require.NotNil(t, summaries.failed["conversation-1"])
+	assert.Equal(t, now.Add(4*time.Minute), *summaries.failed["conversation-1"])
+	assert.Empty(t, summaries.completed)
+}
+
+func TestWorker_GivesUpAfterMaxAttempts(t *testing.T) {
+	client := &fakeAI{err: errors.New("unavailable")}
+	w, summaries := newTestWorker(client,
+		models.ConversationSummary{ConversationID: "conversation-1", Attempts: MaxAttempts},
+	)
+
+	require.NoError(t, w.ProcessDue(context.Background()))
+
+	require.Contains(t, summaries.failed, "conversation-1")
+	assert.Nil(t, summaries.failed["conversation-1"])
+}
@@ -0,0 +1,126 @@
+package usage
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/repository"
+)
+
+// Audio formats streamed through the WebSocket proxy (16-bit mono PCM)
+const (
+	userAudioBytesPerSecond = 16000 * 2 // Recorded by the browser at 16kHz
+	aiAudioBytesPerSecond   = 24000 * 2 // Generated by the AI service at 24kHz
+)
+
+// Period identifies the quota period that was exceeded
+type Period string
+
+const (
+	PeriodDaily   Period = "daily"
+	PeriodMonthly Period = "monthly"
+)
+
+// Exceeded describes a quota that a session ran out of
+type Exceeded struct {
+	Period   Period
+	Limit    time.Duration
+	Used     time.Duration
+	ResetsAt time.Time
+}
+
+// Meter counts the audio of one conversation session against the user's quota.
+// It is safe for concurrent use by the send and receive loops of a session.
+//
+// The persisted usage of the day and month is refreshed on every flush, so the usage of
+// the user's other sessions counts too, and a session running past midnight moves to the new day.
+type Meter struct {
+	mu      sync.Mutex
+	limits  Limits
+	window  Window
+	daily   time.Duration // Persisted usage of the day as of the last refresh, including flushed audio of this session
+	monthly time.Duration // Persisted usage of the month as of the last refresh, including flushed audio of this session
+	session repository.UsageTotals
+	pending repository.UsageTotals // Not yet persisted
+}
+
+func newMeter(limits Limits, window Window, daily, monthly time.Duration) *Meter {
+	return &Meter{
+		limits:  limits,
+		window:  window,
+		daily:   daily,
+		monthly: monthly,
+		pending: repository.UsageTotals{Sessions: 1},
+	}
+}
+
+// AddUserAudio meters PCM audio sent by the user
+func (m *Meter) AddUserAudio(bytes int) {
+	m.add(repository.UsageTotals{UserAudio: pcmDuration(bytes, userAudioBytesPerSecond)})
+}
+
+// AddAIAudio meters PCM audio generated by the AI
+func (m *Meter) AddAIAudio(bytes int) {
+	m.add(repository.UsageTotals{AIAudio: pcmDuration(bytes, aiAudioBytesPerSecond)})
+}
+
+func (m *Meter) add(usage repository.UsageTotals) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.session.UserAudio += usage.UserAudio
+	m.session.AIAudio += usage.AIAudio
+	m.pending.UserAudio += usage.UserAudio
+	m.pending.AIAudio += usage.AIAudio
+}
+
+// Exceeded reports the first quota the user has used up, if any
+func (m *Meter) Exceeded() (Exceeded, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	used := m.pending.Total()
+	if m.limits.Daily > 0 && m.daily+used >= m.limits.Daily {
+		return Exceeded{Period: PeriodDaily, Limit: m.limits.Daily, Used: m.daily + used, ResetsAt: m.window.DayEnd}, true
+	}
+	if m.limits.Monthly > 0 && m.monthly+used >= m.limits.Monthly {
+		return Exceeded{Period: PeriodMonthly, Limit: m.limits.Monthly, Used: m.monthly + used, ResetsAt: m.window.MonthEnd}, true
+	}
+	return Exceeded{}, false
+}
+
+// SessionTotals returns the audio metered since the session started
+func (m *Meter) SessionTotals() repository.UsageTotals {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.session
+}
+
+// refresh replaces the persisted usage the quotas are checked against
+func (m *Meter) refresh(window Window, daily, monthly time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.window = window
+	m.daily = daily
+	m.monthly = monthly
+}
+
+// takePending returns the usage metered since the last call and resets it
+func (m *Meter) takePending() repository.UsageTotals {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	pending := m.pending
+	m.pending = repository.UsageTotals{}
+	return pending
+}
+
+// restorePending adds usage that failed to persist back so the next flush retries it
+func (m *Meter) restorePending(usage repository.UsageTotals) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pending.UserAudio += usage.UserAudio
+	m.pending.AIAudio += usage.AIAudio
+	m.pending.Sessions += usage.Sessions
+}
+
+func pcmDuration(bytes, bytesPerSecond int) time.Duration {
+	return time.Duration(bytes) * time.Second / time.Duration(bytesPerSecond)
+}
@@ -0,0 +1,66 @@
+package usage
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+// Limits is the conversation audio a plan may use per period. Zero means unlimited.
+type Limits struct {
+	Daily   time.Duration
+	Monthly time.Duration
+}
+
+// Quotas maps each plan to its limits
+type Quotas map[models.UserPlan]Limits
+
+// DefaultQuotas are used for plans without a QUOTA_* override
+var DefaultQuotas = Quotas{
+	models.PlanFree:    {Daily: 10 * time.Minute, Monthly: 60 * time.Minute},
+	models.PlanLite:    {Daily: 30 * time.Minute, Monthly: 600 * time.Minute},
+	models.PlanPremium: {Daily: 120 * time.Minute, Monthly: 3000 * time.Minute},
+}
+
+// For returns the limits of plan. Unknown plans get the free plan's limits.
+func (q Quotas) For(plan models.UserPlan) Limits {
+	if limits, ok := q[plan]; ok {
+		return limits
+	}
+	return q[models.PlanFree]
+}
+
+// LoadQuotas returns DefaultQuotas overridden by QUOTA_<PLAN>_DAILY_MINUTES and
+// QUOTA_<PLAN>_MONTHLY_MINUTES (e.g. QUOTA_FREE_DAILY_MINUTES=15, 0 for unlimited)
+func LoadQuotas() (Quotas, error) {
+	quotas := make(Quotas, len(DefaultQuotas))
+	for plan, limits := range DefaultQuotas {
+		name := strings.TrimPrefix(string(plan), "PLAN_")
+		daily, err := minutesFromEnv("QUOTA_"+name+"_DAILY_MINUTES", limits.Daily)
+		if err != nil {
+			return nil, err
+		}
+		monthly, err := minutesFromEnv("QUOTA_"+name+"_MONTHLY_MINUTES", limits.Monthly)
+		if err != nil {
+			return nil, err
+		}
+		quotas[plan] = Limits{Daily: daily, Monthly: monthly}
+	}
+	return quotas, nil
+}
+
+func minutesFromEnv(key string, fallback time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	minutes, err := strconv.Atoi(value)
+	if err != nil || minutes < 0 {
+		return 0, fmt.Errorf("invalid %s %q: must be a non-negative number of minutes", key, value)
+	}
+	return time.Duration(minutes) * time.Minute, nil
+}
@@ -0,0 +1,140 @@
+package usage
+
+import (
+	"context"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+)
+
+// Window is the day and month containing a point in time, in the metering time zone
+type Window struct {
+	Day        time.Time // Calendar date used as the daily_usages key
+	DayEnd     time.Time
+	MonthStart time.Time // Calendar date of the first day of the month
+	MonthEnd   time.Time
+}
+
+// WindowAt returns the window containing t in loc
+func WindowAt(t time.Time, loc *time.Location) Window {
+	local := t.In(loc)
+	year, month, day := local.Date()
+	return Window{
+		Day:        time.Date(year, month, day, 0, 0, 0, 0, time.UTC),
+		DayEnd:     time.Date(year, month, day+1, 0, 0, 0, 0, loc),
+		MonthStart: time.Date(year, month, 1, 0, 0, 0, 0, time.UTC),
+		MonthEnd:   time.Date(year, month+1, 1, 0, 0, 0, 0, loc),
+	}
+}
+
+// Allowance is the usage of a period against its limit
+type Allowance struct {
+	Used     time.Duration
+	Limit    time.Duration // Zero when unlimited
+	ResetsAt time.Time
+}
+
+// Unlimited reports whether the period has no limit
+func (a Allowance) Unlimited() bool {
+	return a.Limit == 0
+}
+
+// Remaining returns the usage left in the period
+func (a Allowance) Remaining() time.Duration {
+	if a.Unlimited() || a.Used >= a.Limit {
+		return 0
+	}
+	return a.Limit - a.Used
+}
+
+// Status is a user's usage for the current day and month
+type Status struct {
+	Plan    models.UserPlan
+	Daily   Allowance
+	Monthly Allowance
+	Month   repository.UsageTotals
+}
+
+// Service meters conversation audio and enforces per-plan quotas
+type Service struct {
+	usage    repository.UsageRepository
+	quotas   Quotas
+	location *time.Location
+	now      func() time.Time
+}
+
+// NewService creates a new usage service counting calendar days in loc
+func NewService(usage repository.UsageRepository, quotas Quotas, loc *time.Location) *Service {
+	return &Service{
+		usage:    usage,
+		quotas:   quotas,
+		location: loc,
+		now:      time.Now,
+	}
+}
+
+// GetStatus returns the usage of the user's current day and month against plan's limits
+func (s *Service) GetStatus(ctx context.Context, userID string, plan models.UserPlan) (*Status, error) {
+	window := WindowAt(s.now(), s.location)
+	daily, monthly, err := s.totals(ctx, userID, window)
+	if err != nil {
+		return nil, err
+	}
+	limits := s.quotas.For(plan)
+	return &Status{
+		Plan:    plan,
+		Daily:   Allowance{Used: daily.Total(), Limit: limits.Daily, ResetsAt: window.DayEnd},
+		Monthly: Allowance{Used: monthly.Total(), Limit: limits.Monthly, ResetsAt: window.MonthEnd},
+		Month:   monthly,
+	}, nil
+}
+
+// StartSession creates a meter for a new conversation session of the user
+func (s *Service) StartSession(ctx context.Context, userID string, plan models.UserPlan) (*Session, error) {
+	window := WindowAt(s.now(), s.location)
+	daily, monthly, err := s.totals(ctx, userID, window)
+	if err != nil {
+		return nil, err
+	}
+	return &Session{
+		Meter:   newMeter(s.quotas.For(plan), window, daily.Total(), monthly.Total()),
+		service: s,
+		userID:  userID,
+	}, nil
+}
+
+func (s *Service) totals(ctx context.Context, userID string, window Window) (daily, monthly repository.UsageTotals, err error) {
+	dayEnd := window.Day.AddDate(0, 0, 1)
+	if daily, err = s.usage.GetUsageTotals(ctx, userID, window.Day, dayEnd); err != nil {
+		return
+	}
+	monthly, err = s.usage.GetUsageTotals(ctx, userID, window.MonthStart, window.MonthStart.AddDate(0, 1, 0))
+	return
+}
+
+// Session is the metered usage of one conversation session
+type Session struct {
+	*Meter
+	service *Service
+	userID  string
+}
+
+// Flush persists the usage metered since the previous flush, then re-reads the user's usage
+// so the quotas include the user's other sessions and follow the current day.
+// Usage is attributed to the day the flush happens on.
+func (s *Session) Flush(ctx context.Context) error {
+	window := WindowAt(s.service.now(), s.service.location)
+	if pending := s.takePending(); pending != (repository.UsageTotals{}) {
+		if err := s.service.usage.AddUsage(ctx, s.userID, window.Day, pending); err != nil {
+			s.restorePending(pending)
+			return err
+		}
+	}
+	daily, monthly, err := s.service.totals(ctx, s.userID, window)
+	if err != nil {
+		return err
+	}
+	s.refresh(window, daily.Total(), monthly.Total())
+	return nil
+}
@@ -0,0 +1,177 @@
+package usage
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryUsage is an in-memory UsageRepository shared by the sessions of a test
+type memoryUsage struct {
+	mu   sync.Mutex
+	days map[time.Time]repository.UsageTotals
+}
+
+func (r *memoryUsage) AddUsage(_ context.Context, _ string, day time.Time, usage repository.UsageTotals) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.days == nil {
+		r.days = make(map[time.Time]repository.UsageTotals)
+	}
+	totals := r.days[day]
+	totals.UserAudio += usage.UserAudio
+	totals.AIAudio += usage.AIAudio
+	totals.Sessions += usage.Sessions
+	r.days[day] = totals
+	return nil
+}
+
+func (r *memoryUsage) GetUsageTotals(_ context.Context, _ string, from, to time.Time) (repository.UsageTotals, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var sum repository.UsageTotals
+	for day, totals := range r.days {
+		if !day.Before(from) && day.Before(to) {
+			sum.UserAudio += totals.UserAudio
+			sum.AIAudio += totals.AIAudio
+			sum.Sessions += totals.Sessions
+		}
+	}
+	return sum, nil
+}
+
+func TestWindowAt_CountsDaysInLocation(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	// 2026-01-31 16:00 UTC is already February 1st in Tokyo
+	window := WindowAt(time.Date(2026, 1, 31, 16, 0, 0, 0, time.UTC), tokyo)
+
+	assert.Equal(t, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), window.Day)
+	assert.Equal(t, time.Date(2026, 2, 2, 0, 0, 0, 0, tokyo), window.DayEnd)
+	assert.Equal(t, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), window.MonthStart)
+	assert.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, tokyo), window.MonthEnd)
+}
+
+func TestMeter_CountsPCMAudio(t *testing.T) {
+	meter := newMeter(Limits{}, Window{}, 0, 0)
+
+	meter.AddUserAudio(userAudioBytesPerSecond * 2)
+	meter.AddAIAudio(aiAudioBytesPerSecond / 2)
+
+	pending := meter.takePending()
+	assert.Equal(t, 2*time.Second, pending.UserAudio)
+	assert.Equal(t, 500*time.Millisecond, pending.AIAudio)
+	assert.Equal(t, int64(1), pending.Sessions)
+	assert.Zero(t, meter.takePending())
+}
+
+func TestMeter_ExceedsDailyQuota(t *testing.T) {
+	window := WindowAt(time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC), time.UTC)
+	meter := newMeter(Limits{Daily: time.Minute, Monthly: time.Hour}, window, 50*time.Second, 50*time.Second)
+
+	meter.AddUserAudio(userAudioBytesPerSecond * 5)
+	_, exceeded := meter.Exceeded()
+	assert.False(t, exceeded)
+
+	meter.AddAIAudio(aiAudioBytesPerSecond * 5)
+	result, exceeded := meter.Exceeded()
+	assert.True(t, exceeded)
+	assert.Equal(t, PeriodDaily, result.Period)
+	assert.Equal(t, time.Minute, result.Used)
+	assert.Equal(t, window.DayEnd, result.ResetsAt)
+}
+
+func TestMeter_ExceedsMonthlyQuota(t *testing.T) {
+	meter := newMeter(Limits{Daily: time.Hour, Monthly: 10 * time.Hour}, Window{}, 0, 10*time.Hour)
+
+	result, exceeded := meter.Exceeded()
+	assert.True(t, exceeded)
+	assert.Equal(t, PeriodMonthly, result.Period)
+}
+
+func TestMeter_UnlimitedPlan(t *testing.T) {
+	meter := newMeter(Limits{}, Window{}, 100*time.Hour, 1000*time.Hour)
+
+	_, exceeded := meter.Exceeded()
+	assert.False(t, exceeded)
+}
+
+func TestSession_CountsConcurrentSessionsOfTheUser(t *testing.T) {
+	ctx := context.Background()
+	service := NewService(&memoryUsage{}, Quotas{models.PlanFree: {Daily: time.Minute}}, time.UTC)
+	service.now = func() time.Time { return time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC) }
+
+	first, err := service.StartSession(ctx, "user", models.PlanFree)
+	require.NoError(t, err)
+	second, err := service.StartSession(ctx, "user", models.PlanFree)
+	require.NoError(t, err)
+
+	first.AddUserAudio(userAudioBytesPerSecond * 40)
+	require.NoError(t, first.Flush(ctx))
+	second.AddUserAudio(userAudioBytesPerSecond * 10)
+	_, exceeded := second.Exceeded()
+	assert.False(t, exceeded, "the first session's usage is not seen before the second flushes")
+
+	require.NoError(t, second.Flush(ctx))
+	second.AddUserAudio(userAudioBytesPerSecond * 10)
+	result, exceeded := second.Exceeded()
+	assert.True(t, exceeded)
+	assert.Equal(t, PeriodDaily, result.Period)
+	assert.Equal(t, time.Minute, result.Used)
+
+	require.NoError(t, first.Flush(ctx))
+	_, exceeded = first.Exceeded()
+	assert.False(t, exceeded, "the second session's unflushed audio is not counted yet")
+	require.NoError(t, second.Flush(ctx))
+	require.NoError(t, first.Flush(ctx))
+	_, exceeded = first.Exceeded()
+	assert.True(t, exceeded)
+}
+
+func TestSession_MovesToTheNewDayAtMidnight(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 1, 23, 59, 0, 0, time.UTC)
+	service := NewService(&memoryUsage{}, Quotas{models.PlanFree: {Daily: time.Minute}}, time.UTC)
+	service.now = func() time.Time { return now }
+
+	session, err := service.StartSession(ctx, "user", models.PlanFree)
+	require.NoError(t, err)
+	session.AddUserAudio(userAudioBytesPerSecond * 60)
+	require.NoError(t, session.Flush(ctx))
+	result, exceeded := session.Exceeded()
+	require.True(t, exceeded)
+	assert.Equal(t, time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC), result.ResetsAt)
+
+	now = now.Add(2 * time.Minute)
+	require.NoError(t, session.Flush(ctx))
+	_, exceeded = session.Exceeded()
+	assert.False(t, exceeded)
+	session.AddUserAudio(userAudioBytesPerSecond * 60)
+	require.NoError(t, session.Flush(ctx))
+	result, exceeded = session.Exceeded()
+	require.True(t, exceeded)
+	assert.Equal(t, time.Date(2026, 10, 3, 0, 0, 0, 0, time.UTC), result.ResetsAt)
+}
+
+func TestLoadQuotas_EnvOverride(t *testing.T) {
+	t.Setenv("QUOTA_FREE_DAILY_MINUTES", "15")
+	t.Setenv("QUOTA_PREMIUM_MONTHLY_MINUTES", "0")
+
+	quotas, err := LoadQuotas()
+	assert.NoError(t, err)
+	assert.Equal(t, 15*time.Minute, quotas.For(models.PlanFree).Daily)
+	assert.Equal(t, DefaultQuotas[models.PlanFree].Monthly, quotas.For(models.PlanFree).Monthly)
+	assert.Zero(t, quotas.For(models.PlanPremium).Monthly)
+}
+
+func TestLoadQuotas_InvalidValue(t *testing.T) {
+	t.Setenv("QUOTA_LITE_DAILY_MINUTES", "abc")
+
+	_, err := LoadQuotas()
+	assert.Error(t, err)
+}
@@ -0,0 +1,130 @@
+// Package vocabulary keeps the learner's vocabulary cards: validating their content,
+// scheduling reviews with SM-2 and moving cards in and out as CSV or Anki-compatible TSV.
+package vocabulary
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+const (
+	// MaxTermLength is the longest term in characters
+	MaxTermLength = 200
+	// MaxFieldLength is the longest reading, translation or example in characters
+	MaxFieldLength = 1000
+)
+
+var (
+	// ErrEmptyTerm is returned for a card without a term
+	ErrEmptyTerm = errors.New("term is empty")
+	// ErrTooLong is returned for a card field longer than its limit
+	ErrTooLong = errors.New("field is too long")
+)
+
+// Fields is the content of a card the learner writes
+type Fields struct {
+	Term        string
+	Reading     string
+	Translation string
+	Example     string
+}
+
+// Normalize trims the fields and checks their lengths
+func (f Fields) Normalize() (Fields, error) {
+	f = Fields{
+		Term:        strings.Join(strings.Fields(f.Term), " "),
+		Reading:     strings.TrimSpace(f.Reading),
+		Translation: strings.TrimSpace(f.Translation),
+		Example:     strings.TrimSpace(f.Example),
+	}
+	if f.Term == "" {
+		return Fields{}, ErrEmptyTerm
+	}
+	if utf8.RuneCountInString(f.Term) > MaxTermLength {
+		return Fields{}, fmt.Errorf("%w: term exceeds %d characters", ErrTooLong, MaxTermLength)
+	}
+	if err := checkLength("reading", f.Reading); err != nil {
+		return Fields{}, err
+	}
+	if err := checkLength("translation", f.Translation); err != nil {
+		return Fields{}, err
+	}
+	if err := checkLength("example", f.Example); err != nil {
+		return Fields{}, err
+	}
+	return f, nil
+}
+
+// checkLength rejects a reading, translation or example longer than MaxFieldLength
+func checkLength(name, value string) error {
+	if utf8.RuneCountInString(value) > MaxFieldLength {
+		return fmt.Errorf("%w: %s exceeds %d characters", ErrTooLong, name, MaxFieldLength)
+	}
+	return nil
+}
+
+// NewCard returns a card of the user with the fields, due for its first review at now
+func NewCard(userID string, fields Fields, now time.Time) models.VocabularyCard {
+	return models.VocabularyCard{
+		UserID:      userID,
+		Term:        fields.Term,
+		Reading:     fields.Reading,
+		Translation: fields.Translation,
+		Example:     fields.Example,
+		EaseFactor:  InitialEaseFactor,
+		DueAt:       now,
+	}
+}
+
+// CardFields returns the content of a card
+func CardFields(card *models.VocabularyCard) Fields {
+	return Fields{
+		Term:        card.Term,
+		Reading:     card.Reading,
+		Translation: card.Translation,
+		Example:     card.Example,
+	}
+}
+
+// ExampleFromTurn picks the text of a conversation turn to use as the example sentence of term.
+// The AI's text is preferred over the user's when both or neither contain the term.
+func ExampleFromTurn(term string, turn *models.ConversationTurn) string {
+	example := turn.AIText
+	if example == "" || (!containsFold(example, term) && containsFold(turn.UserTranscript, term)) {
+		example = turn.UserTranscript
+	}
+	example = strings.TrimSpace(example)
+	if utf8.RuneCountInString(example) > MaxFieldLength {
+		example = string([]rune(example)[:MaxFieldLength])
+	}
+	return example
+}
+
+// Dedupe drops the fields whose term the user already has or which repeat an earlier term, ignoring case.
+// It returns the remaining fields and how many were dropped.
+func Dedupe(existing []models.VocabularyCard, fields []Fields) ([]Fields, int) {
+	seen := make(map[string]bool, len(existing)+len(fields))
+	for _, card := range existing {
+		seen[strings.ToLower(card.Term)] = true
+	}
+	fresh := make([]Fields, 0, len(fields))
+	for _, f := range fields {
+		key := strings.ToLower(f.Term)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		fresh = append(fresh, f)
+	}
+	return fresh, len(fields) - len(fresh)
+}
+
+// containsFold reports whether s contains substr, ignoring case
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
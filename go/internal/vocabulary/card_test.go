@@ -0,0 +1,53 @@
+package vocabulary
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFields_Normalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		fields  Fields
+		want    Fields
+		wantErr error
+	}{
+		{
+			name:   "trims fields and collapses term whitespace",
+			fields: Fields{Term: "  xin \n chào ", Reading: " sin chao ", Translation: "hello ", Example: " Xin chào bạn! "},
+			want:   Fields{Term: "xin chào", Reading: "sin chao", Translation: "hello", Example: "Xin chào bạn!"},
+		},
+		{name: "empty term", fields: Fields{Term: " ", Translation: "hello"}, wantErr: ErrEmptyTerm},
+		{name: "term too long", fields: Fields{Term: strings.Repeat("語", MaxTermLength+1)}, wantErr: ErrTooLong},
+		{name: "example too long", fields: Fields{Term: "a", Example: strings.Repeat("a", MaxFieldLength+1)}, wantErr: ErrTooLong},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fields.Normalize()
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExampleFromTurn(t *testing.T) {
+	turn := &models.ConversationTurn{UserTranscript: "Tôi thích phở", AIText: "Bạn thích ăn gì?"}
+
+	assert.Equal(t, "Tôi thích phở", ExampleFromTurn("phở", turn))
+	assert.Equal(t, "Bạn thích ăn gì?", ExampleFromTurn("ăn", turn))
+	assert.Equal(t, "Bạn thích ăn gì?", ExampleFromTurn("bún", turn))
+	assert.Equal(t, "Tôi thích phở", ExampleFromTurn("bún", &models.ConversationTurn{UserTranscript: "Tôi thích phở"}))
+}
+
+func TestDedupe_IgnoresCase(t *testing.T) {
+	existing := []models.VocabularyCard{{Term: "Xin chào"}}
+	fields := []Fields{{Term: "xin chào"}, {Term: "cảm ơn"}, {Term: "Cảm ơn"}, {Term: "tạm biệt"}}
+
+	fresh, skipped := Dedupe(existing, fields)
+
+	assert.Equal(t, []Fields{{Term: "cảm ơn"}, {Term: "tạm biệt"}}, fresh)
+	assert.Equal(t, 2, skipped)
+}
@@ -0,0 +1,51 @@
+package vocabulary
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"github.com/hiroky1983/talk/go/middleware"
+)
+
+// ExportHandler serves the user's vocabulary cards as a download
+type ExportHandler struct {
+	cards repository.VocabularyRepository
+}
+
+// NewExportHandler creates a new export handler
+func NewExportHandler(cards repository.VocabularyRepository) *ExportHandler {
+	return &ExportHandler{cards: cards}
+}
+
+// ServeExport serves GET /vocabulary/export?format=csv|anki as a download, newest cards first
+func (h *ExportHandler) ServeExport(c *gin.Context) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		return
+	}
+	format, ok := ParseFormat(c.DefaultQuery("format", string(FormatCSV)))
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "format must be csv or anki"})
+		return
+	}
+
+	cards, err := h.cards.ListCards(c.Request.Context(), userID, 0, 0)
+	if err != nil {
+		log.Printf("ServeExport failed: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	c.Header("Content-Type", format.ContentType())
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="vocabulary.%s"`, format.Extension()))
+	c.Header("Cache-Control", "private, no-store")
+	c.Status(http.StatusOK)
+	if err := Write(c.Writer, format, cards); err != nil {
+		log.Printf("ServeExport failed after writing started: %v", err)
+		c.Abort()
+	}
+}
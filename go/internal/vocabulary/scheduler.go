@@ -0,0 +1,60 @@
+package vocabulary
+
+import (
+	"math"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+// Grade is how well the learner recalled a card, from 0 (complete blackout) to 5 (perfect recall) as in SM-2
+type Grade int
+
+const (
+	MinGrade Grade = 0
+	MaxGrade Grade = 5
+	// passingGrade is the lowest grade that counts as recalled
+	passingGrade Grade = 3
+)
+
+// Valid reports whether the grade is on the SM-2 scale
+func (g Grade) Valid() bool {
+	return g >= MinGrade && g <= MaxGrade
+}
+
+const (
+	// InitialEaseFactor is the ease factor of a new card
+	InitialEaseFactor = 2.5
+	// MinEaseFactor keeps hard cards from being shown ever more often
+	MinEaseFactor = 1.3
+	// MaxIntervalDays caps how far ahead a review is scheduled
+	MaxIntervalDays = 3650
+)
+
+// Review updates the schedule of a card the learner graded at now, following SM-2:
+// a recalled card is shown again after 1 day, then 6 days, then the previous interval times the ease factor;
+// a forgotten card starts over at 1 day. The ease factor moves with every grade and never drops below MinEaseFactor.
+func Review(card *models.VocabularyCard, grade Grade, now time.Time) {
+	q := float64(MaxGrade - grade)
+	card.EaseFactor = math.Max(MinEaseFactor, card.EaseFactor+0.1-q*(0.08+q*0.02))
+	// Keep the stored factor readable instead of accumulating float noise
+	card.EaseFactor = math.Round(card.EaseFactor*100) / 100
+
+	if grade < passingGrade {
+		card.Repetitions = 0
+		card.IntervalDays = 1
+	} else {
+		switch card.Repetitions {
+		case 0:
+			card.IntervalDays = 1
+		case 1:
+			card.IntervalDays = 6
+		default:
+			card.IntervalDays = int(math.Round(float64(card.IntervalDays) * card.EaseFactor))
+		}
+		card.Repetitions++
+	}
+	card.IntervalDays = min(card.IntervalDays, MaxIntervalDays)
+	card.DueAt = now.AddDate(0, 0, card.IntervalDays)
+	card.LastReviewedAt = &now
+}
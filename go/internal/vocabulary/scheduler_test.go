@@ -0,0 +1,71 @@
+package vocabulary
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReview_GrowsIntervalsOfRecalledCard(t *testing.T) {
+	now := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	card := NewCard("user-1", Fields{Term: "cảm ơn"}, now)
+
+	var intervals []int
+	for range 4 {
+		Review(&card, 4, now)
+		intervals = append(intervals, card.IntervalDays)
+	}
+
+	assert.Equal(t, []int{1, 6, 15, 38}, intervals)
+	assert.Equal(t, 4, card.Repetitions)
+	assert.Equal(t, 2.5, card.EaseFactor)
+	assert.Equal(t, now.AddDate(0, 0, 38), card.DueAt)
+	assert.Equal(t, &now, card.LastReviewedAt)
+}
+
+func TestReview_EaseFactorFollowsGrade(t *testing.T) {
+	tests := []struct {
+		grade Grade
+		want  float64
+	}{
+		{grade: 5, want: 2.6},
+		{grade: 4, want: 2.5},
+		{grade: 3, want: 2.36},
+		{grade: 2, want: 2.18},
+		{grade: 0, want: 1.7},
+	}
+	for _, tt := range tests {
+		card := models.VocabularyCard{EaseFactor: InitialEaseFactor}
+		Review(&card, tt.grade, time.Now())
+		assert.Equal(t, tt.want, card.EaseFactor, "grade %d", tt.grade)
+	}
+}
+
+func TestReview_LapseStartsOver(t *testing.T) {
+	now := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	card := models.VocabularyCard{EaseFactor: 1.4, IntervalDays: 40, Repetitions: 5}
+
+	Review(&card, 1, now)
+
+	assert.Equal(t, 0, card.Repetitions)
+	assert.Equal(t, 1, card.IntervalDays)
+	assert.Equal(t, MinEaseFactor, card.EaseFactor)
+	assert.Equal(t, now.AddDate(0, 0, 1), card.DueAt)
+}
+
+func TestReview_CapsInterval(t *testing.T) {
+	card := models.VocabularyCard{EaseFactor: InitialEaseFactor, IntervalDays: 3000, Repetitions: 10}
+
+	Review(&card, 5, time.Now())
+
+	assert.Equal(t, MaxIntervalDays, card.IntervalDays)
+}
+
+func TestGrade_Valid(t *testing.T) {
+	assert.True(t, Grade(0).Valid())
+	assert.True(t, Grade(5).Valid())
+	assert.False(t, Grade(-1).Valid())
+	assert.False(t, Grade(6).Valid())
+}
@@ -0,0 +1,140 @@
+package vocabulary
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+)
+
+// Format is a file format cards are imported from and exported to
+type Format string
+
+const (
+	// FormatCSV is comma-separated values with a term,reading,translation,example header
+	FormatCSV Format = "csv"
+	// FormatAnki is tab-separated values with the header lines Anki's text importer reads
+	FormatAnki Format = "anki"
+)
+
+// ParseFormat parses the name of a format
+func ParseFormat(s string) (Format, bool) {
+	switch f := Format(strings.ToLower(s)); f {
+	case FormatCSV, FormatAnki:
+		return f, true
+	}
+	return "", false
+}
+
+// ContentType returns the MIME type of the format
+func (f Format) ContentType() string {
+	if f == FormatAnki {
+		return "text/tab-separated-values; charset=utf-8"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// Extension returns the file name extension of the format; Anki imports plain text files
+func (f Format) Extension() string {
+	if f == FormatAnki {
+		return "txt"
+	}
+	return "csv"
+}
+
+const (
+	// MaxImportCards is how many cards a single import may hold
+	MaxImportCards = 5000
+	// MaxImportSize is the largest file accepted for import in bytes
+	MaxImportSize = 5 << 20
+)
+
+// ErrTooManyCards is returned for an import with more than MaxImportCards cards
+var ErrTooManyCards = fmt.Errorf("import holds more than %d cards", MaxImportCards)
+
+// columns are the card fields in file order
+var columns = []string{"term", "reading", "translation", "example"}
+
+// utf8BOM is written by spreadsheet applications at the start of CSV files
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// Write writes cards in the format
+func Write(w io.Writer, format Format, cards []models.VocabularyCard) error {
+	out := csv.NewWriter(w)
+	if format == FormatAnki {
+		// Anki reads these header lines to map columns without asking the user
+		if _, err := io.WriteString(w, "#separator:tab\n#html:false\n#columns:Term\tReading\tTranslation\tExample\n"); err != nil {
+			return err
+		}
+		out.Comma = '\t'
+	} else if err := out.Write(columns); err != nil {
+		return err
+	}
+	for i := range cards {
+		f := CardFields(&cards[i])
+		if err := out.Write([]string{f.Term, f.Reading, f.Translation, f.Example}); err != nil {
+			return err
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
+
+// Read reads cards in the format and returns their normalized fields.
+// Missing trailing columns are empty and blank lines are skipped. A CSV header row is
+// recognized by its first column being "term"; Anki's "#" header lines are skipped.
+func Read(r io.Reader, format Format) ([]Fields, error) {
+	buffered := bufio.NewReader(r)
+	if prefix, err := buffered.Peek(len(utf8BOM)); err == nil && bytes.Equal(prefix, utf8BOM) {
+		buffered.Discard(len(utf8BOM))
+	}
+	in := csv.NewReader(buffered)
+	in.FieldsPerRecord = -1
+	in.LazyQuotes = true
+	if format == FormatAnki {
+		in.Comma = '\t'
+		in.Comment = '#'
+	}
+
+	var fields []Fields
+	for first := true; ; first = false {
+		record, err := in.Read()
+		if errors.Is(err, io.EOF) {
+			return fields, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if first && format == FormatCSV && strings.EqualFold(strings.TrimSpace(record[0]), columns[0]) {
+			continue
+		}
+		if isBlank(record) {
+			continue
+		}
+		if len(fields) == MaxImportCards {
+			return nil, ErrTooManyCards
+		}
+		record = append(record, make([]string, len(columns))...)
+		f, err := Fields{Term: record[0], Reading: record[1], Translation: record[2], Example: record[3]}.Normalize()
+		if err != nil {
+			line, _ := in.FieldPos(0)
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		fields = append(fields, f)
+	}
+}
+
+// isBlank reports whether every column of a record is empty
+func isBlank(record []string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
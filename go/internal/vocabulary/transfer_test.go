@@ -0,0 +1,80 @@
+package vocabulary
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var transferCards = []models.VocabularyCard{
+	{Term: "xin chào", Reading: "sin chao", Translation: "hello", Example: "Xin chào, bạn khỏe không?"},
+	{Term: "ありがとう", Translation: "thank you", Example: "line one\nline \"two\"\tend"},
+}
+
+func TestWrite_CSV(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, FormatCSV, transferCards[:1]))
+
+	assert.Equal(t, "term,reading,translation,example\nxin chào,sin chao,hello,\"Xin chào, bạn khỏe không?\"\n", buf.String())
+}
+
+func TestWrite_Anki(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, FormatAnki, transferCards[:1]))
+
+	assert.Equal(t, "#separator:tab\n#html:false\n#columns:Term\tReading\tTranslation\tExample\n"+
+		"xin chào\tsin chao\thello\tXin chào, bạn khỏe không?\n", buf.String())
+}
+
+func TestReadWrite_RoundTrip(t *testing.T) {
+	for _, format := range []Format{FormatCSV, FormatAnki} {
+		t.Run(string(format), func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, Write(&buf, format, transferCards))
+
+			fields, err := Read(&buf, format)
+
+			require.NoError(t, err)
+			assert.Equal(t, []Fields{CardFields(&transferCards[0]), CardFields(&transferCards[1])}, fields)
+		})
+	}
+}
+
+func TestRead_CSVWithoutHeaderAndMissingColumns(t *testing.T) {
+	input := "\xEF\xBB\xBFcảm ơn,,thank you\n\n,,\ntạm biệt\n"
+
+	fields, err := Read(strings.NewReader(input), FormatCSV)
+
+	require.NoError(t, err)
+	assert.Equal(t, []Fields{{Term: "cảm ơn", Translation: "thank you"}, {Term: "tạm biệt"}}, fields)
+}
+
+func TestRead_AnkiSkipsHeaderLines(t *testing.T) {
+	input := "#separator:tab\n#html:false\nnước\t\twater\n"
+
+	fields, err := Read(strings.NewReader(input), FormatAnki)
+
+	require.NoError(t, err)
+	assert.Equal(t, []Fields{{Term: "nước", Translation: "water"}}, fields)
+}
+
+func TestRead_InvalidCardReportsLine(t *testing.T) {
+	input := "term,reading,translation,example\nnước,,water\n,,no term\n"
+
+	_, err := Read(strings.NewReader(input), FormatCSV)
+
+	assert.ErrorIs(t, err, ErrEmptyTerm)
+	assert.ErrorContains(t, err, "line 3")
+}
+
+func TestRead_TooManyCards(t *testing.T) {
+	input := strings.Repeat("a\n", MaxImportCards+1)
+
+	_, err := Read(strings.NewReader(input), FormatCSV)
+
+	assert.ErrorIs(t, err, ErrTooManyCards)
+}
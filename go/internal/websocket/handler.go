@@ -2,18 +2,37 @@ package websocket
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"io"
 	"log"
 	"net/http"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 	ai "github.com/hiroky1983/talk/go/gen/ai"
+	"github.com/hiroky1983/talk/go/internal/character"
+	"github.com/hiroky1983/talk/go/internal/conversation"
+	"github.com/hiroky1983/talk/go/internal/entitlement"
+	"github.com/hiroky1983/talk/go/internal/memory"
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/placement"
+	"github.com/hiroky1983/talk/go/internal/proficiency"
+	"github.com/hiroky1983/talk/go/internal/repository"
+	"github.com/hiroky1983/talk/go/internal/scenario"
+	"github.com/hiroky1983/talk/go/internal/usage"
 	"github.com/hiroky1983/talk/go/middleware"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// usageFlushInterval is how often metered usage of a running session is persisted
+const usageFlushInterval = 30 * time.Second
+
 var upgrader = websocket.Upgrader{
 	ReadBufferSize:  4096,
 	WriteBufferSize: 4096,
@@ -26,14 +45,578 @@ type AIClientProvider interface {
 	GetGRPCClient() ai.AIConversationServiceClient
 }
 
+// PlanResolver returns the effective plan of a user, applying any pending subscription change
+type PlanResolver interface {
+	Refresh(ctx context.Context, userID string) (models.UserPlan, error)
+}
+
+// Dependencies bundles what the WebSocket handler needs to run a conversation
+type Dependencies struct {
+	AIProvider       AIClientProvider
+	Users            repository.UserRepository
+	Settings         repository.SettingsRepository
+	Memories         repository.MemoryRepository
+	Plans            PlanResolver
+	Usage            *usage.Service
+	Recorder         *conversation.Recorder
+	Conversations    repository.ConversationRepository
+	Scenarios        repository.ScenarioRepository
+	Characters       repository.CharacterRepository
+	CustomCharacters repository.CustomCharacterRepository
+	Proficiency      repository.ProficiencyRepository
+	HistoryBudget    conversation.HistoryBudget
+}
+
 type Handler struct {
-	aiProvider AIClientProvider
+	aiProvider       AIClientProvider
+	users            repository.UserRepository
+	settings         repository.SettingsRepository
+	memories         repository.MemoryRepository
+	plans            PlanResolver
+	usage            *usage.Service
+	recorder         *conversation.Recorder
+	conversations    repository.ConversationRepository
+	scenarios        repository.ScenarioRepository
+	characters       repository.CharacterRepository
+	customCharacters repository.CustomCharacterRepository
+	proficiency      repository.ProficiencyRepository
+	historyBudget    conversation.HistoryBudget
 }
 
-func NewHandler(provider AIClientProvider) *Handler {
+func NewHandler(deps Dependencies) *Handler {
 	return &Handler{
-		aiProvider: provider,
+		aiProvider:       deps.AIProvider,
+		users:            deps.Users,
+		settings:         deps.Settings,
+		memories:         deps.Memories,
+		plans:            deps.Plans,
+		usage:            deps.Usage,
+		recorder:         deps.Recorder,
+		conversations:    deps.Conversations,
+		scenarios:        deps.Scenarios,
+		characters:       deps.Characters,
+		customCharacters: deps.CustomCharacters,
+		proficiency:      deps.Proficiency,
+		historyBudget:    deps.HistoryBudget,
+	}
+}
+
+// session is a conversation of an authenticated user
+type session struct {
+	setup    *ai.ChatConfiguration
+	plan     models.UserPlan
+	settings *models.UserSettings
+	usage    *usage.Session
+	resume   *conversation.Resumption // Set when the client continues a stored conversation
+	goals    *scenario.Goals          // Set when the conversation plays a scenario
+	test     *placement.Test          // Set in a placement session
+}
+
+// startSession resolves the configuration sent to the AI service and starts metering.
+// The plan is resolved once per session, so plan changes apply to new connections.
+// The language and native_language query parameters must be among conversation.Languages.
+// With a conversation_id query parameter, the user's stored conversation is continued
+// in its language and character, and its latest turns are sent to the AI service as history.
+// The user's most recently updated memories are sent with every setup.
+// In privacy mode the AI service is told not to log the conversation's content.
+// Feedback is explained in the native_language query parameter, Japanese by default.
+// With a scenario_id query parameter, the scenario is played in the conversation's language;
+// a resumed conversation keeps playing the scenario it was started with.
+// The character must be in the catalog and included in the user's plan; a resumed conversation
+// may keep talking to a character retired since it started. Premium users may instead talk to one
+// of their custom characters (custom_character_id) or to one shared with them (share_token).
+// With mode=placement, the character gives the placement test of the language instead of
+// a free conversation; a placement session cannot resume a conversation or play a scenario.
+// Other sessions are pitched at the user's level in the language, when it is known.
+func (h *Handler) startSession(c *gin.Context) (*session, int, error) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		return nil, http.StatusUnauthorized, errors.New("authentication required")
+	}
+	ctx := c.Request.Context()
+	language := c.DefaultQuery("language", "ja")
+	nativeLanguage := c.DefaultQuery("native_language", "ja")
+	if !conversation.SupportedLanguage(language) || !conversation.SupportedLanguage(nativeLanguage) {
+		return nil, http.StatusBadRequest, errors.New("unsupported language")
+	}
+
+	user, err := h.users.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, http.StatusUnauthorized, err
+		}
+		return nil, http.StatusInternalServerError, err
+	}
+	if user.IsDisabled() {
+		return nil, http.StatusForbidden, errors.New("account is disabled")
+	}
+	plan, err := h.plans.Refresh(ctx, user.UsersID)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	settings, err := h.settings.GetSettings(ctx, user.UsersID)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	memories, err := h.memories.ListMemories(ctx, user.UsersID, memory.PromptLimit)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	setup := &ai.ChatConfiguration{
+		UserId:         user.UsersID,
+		Username:       user.Username,
+		Language:       language,
+		Character:      c.DefaultQuery("character", "friend"),
+		Plan:           toAIPlan(plan),
+		Memories:       memory.Contents(memories),
+		PrivacyMode:    settings.PrivacyMode,
+		NativeLanguage: nativeLanguage,
+	}
+	var resume *conversation.Resumption
+	if conversationID := c.Query("conversation_id"); conversationID != "" {
+		if _, err := uuid.Parse(conversationID); err != nil {
+			return nil, http.StatusBadRequest, errors.New("invalid conversation_id")
+		}
+		resume, err = conversation.Resume(ctx, h.conversations, user.UsersID, conversationID, h.historyBudget)
+		if err != nil {
+			if errors.Is(err, repository.ErrConversationNotFound) {
+				return nil, http.StatusNotFound, err
+			}
+			return nil, http.StatusInternalServerError, err
+		}
+		setup.Language = resume.Conversation.Language
+		setup.Character = resume.Conversation.Character
+		setup.History = toAIHistory(resume.History)
+	}
+
+	var shareToken string
+	if resume == nil {
+		if customID := c.Query("custom_character_id"); customID != "" {
+			if _, err := uuid.Parse(customID); err != nil {
+				return nil, http.StatusBadRequest, errors.New("invalid custom_character_id")
+			}
+			setup.Character = character.CustomKey(customID)
+		}
+		shareToken = c.Query("share_token")
+	}
+	var status int
+	if _, custom := character.ParseCustomKey(setup.Character); custom || shareToken != "" {
+		status, err = h.setCustomCharacter(ctx, setup, user.UsersID, plan, shareToken)
+	} else {
+		status, err = h.setCatalogCharacter(ctx, setup, plan, resume != nil)
+	}
+	if err != nil {
+		return nil, status, err
+	}
+
+	var test *placement.Test
+	switch c.DefaultQuery("mode", "conversation") {
+	case "conversation":
+		profile, err := h.proficiency.GetLanguageProfile(ctx, user.UsersID, setup.Language)
+		switch {
+		case errors.Is(err, repository.ErrLanguageProfileNotFound):
+		case err != nil:
+			return nil, http.StatusInternalServerError, err
+		default:
+			setup.Difficulty = string(profile.Level)
+		}
+	case "placement":
+		if resume != nil || c.Query("scenario_id") != "" {
+			return nil, http.StatusBadRequest, errors.New("a placement session cannot resume a conversation or play a scenario")
+		}
+		prompts, ok := placement.Prompts(setup.Language)
+		if !ok {
+			return nil, http.StatusNotFound, errors.New("no placement test in the language")
+		}
+		setup.PlacementTest = true
+		setup.PlacementPrompts = toAIPlacementPrompts(prompts)
+		test = placement.NewTest(prompts)
+	default:
+		return nil, http.StatusBadRequest, errors.New("invalid mode")
+	}
+
+	var goals *scenario.Goals
+	scenarioID := c.Query("scenario_id")
+	if resume != nil {
+		scenarioID = ""
+		if resume.Conversation.ScenarioID != nil {
+			scenarioID = *resume.Conversation.ScenarioID
+		}
 	}
+	if scenarioID != "" {
+		if _, err := uuid.Parse(scenarioID); err != nil {
+			return nil, http.StatusBadRequest, errors.New("invalid scenario_id")
+		}
+		played, err := h.scenarios.GetScenario(ctx, scenarioID, setup.Language)
+		switch {
+		case errors.Is(err, repository.ErrScenarioNotFound) && resume != nil:
+			// The scenario was retired since; the conversation goes on without it
+		case errors.Is(err, repository.ErrScenarioNotFound):
+			return nil, http.StatusNotFound, err
+		case err != nil:
+			return nil, http.StatusInternalServerError, err
+		default:
+			setup.Scenario, err = toAIScenario(played)
+			if err != nil {
+				return nil, http.StatusInternalServerError, err
+			}
+			goals = scenario.NewGoals(len(setup.Scenario.Goals))
+		}
+	}
+
+	metered, err := h.usage.StartSession(ctx, user.UsersID, plan)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	return &session{
+		setup:    setup,
+		plan:     plan,
+		settings: settings,
+		usage:    metered,
+		resume:   resume,
+		goals:    goals,
+		test:     test,
+	}, http.StatusOK, nil
+}
+
+// setCatalogCharacter checks that the setup's character is in the catalog and included in plan,
+// and adds its definition to the setup. A resumed conversation may use a retired character.
+func (h *Handler) setCatalogCharacter(ctx context.Context, setup *ai.ChatConfiguration, plan models.UserPlan, resumed bool) (int, error) {
+	partner, err := h.characters.GetCharacterByKey(ctx, setup.Character)
+	switch {
+	case errors.Is(err, repository.ErrCharacterNotFound):
+		return http.StatusNotFound, err
+	case err != nil:
+		return http.StatusInternalServerError, err
+	case !partner.Active && !resumed:
+		return http.StatusNotFound, repository.ErrCharacterNotFound
+	case !character.Available(partner, plan):
+		return http.StatusForbidden, errors.New("character is not included in the plan")
+	}
+	setup.CharacterDefinition, err = toAICharacter(partner, setup.Language, setup.NativeLanguage)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
+
+// setCustomCharacter resolves a custom character, named by the setup's character key or by a share token,
+// and adds its definition to the setup. Users talk to their own characters and to those still shared;
+// custom characters require the premium plan.
+func (h *Handler) setCustomCharacter(ctx context.Context, setup *ai.ChatConfiguration, userID string, plan models.UserPlan, shareToken string) (int, error) {
+	if entitlement.IsUpgrade(plan, models.PlanPremium) {
+		return http.StatusForbidden, errors.New("custom characters require the premium plan")
+	}
+	var partner *models.CustomCharacter
+	var err error
+	if customID, ok := character.ParseCustomKey(setup.Character); ok {
+		partner, err = h.customCharacters.GetCustomCharacterByID(ctx, customID)
+		if err == nil && partner.UserID != userID && !partner.IsShared() {
+			err = repository.ErrCustomCharacterNotFound
+		}
+	} else {
+		partner, err = h.customCharacters.GetSharedCharacter(ctx, shareToken)
+	}
+	switch {
+	case errors.Is(err, repository.ErrCustomCharacterNotFound):
+		return http.StatusNotFound, err
+	case err != nil:
+		return http.StatusInternalServerError, err
+	}
+	setup.Character = character.CustomKey(partner.CustomCharactersID)
+	setup.CharacterDefinition = &ai.Character{
+		Key:         setup.Character,
+		DisplayName: partner.Name,
+		Persona:     character.CustomPersona(partner),
+		Voice:       partner.Voice,
+	}
+	return http.StatusOK, nil
+}
+
+// toAICharacter converts a character with its voice for the conversation's language,
+// named in the learner's native language
+func toAICharacter(c *models.Character, language, nativeLanguage string) (*ai.Character, error) {
+	voice, err := character.Voice(c, language)
+	if err != nil {
+		return nil, err
+	}
+	return &ai.Character{
+		Key:         c.Key,
+		DisplayName: character.DisplayName(c, nativeLanguage),
+		Persona:     c.Persona,
+		Voice:       voice,
+		Gender:      string(c.Gender),
+		AgeBand:     string(c.AgeBand),
+	}, nil
+}
+
+// toAIPlacementPrompts converts the prompts of a placement test
+func toAIPlacementPrompts(prompts []placement.Prompt) []*ai.PlacementPrompt {
+	converted := make([]*ai.PlacementPrompt, len(prompts))
+	for i, p := range prompts {
+		converted[i] = &ai.PlacementPrompt{
+			PromptId: p.ID,
+			Level:    string(p.Level),
+			Text:     p.Text,
+		}
+	}
+	return converted
+}
+
+// toAIScenario converts a scenario loaded with the localization of the conversation's language
+func toAIScenario(played *models.Scenario) (*ai.Scenario, error) {
+	localization := played.Localizations[0]
+	goals, err := localization.GoalList()
+	if err != nil {
+		return nil, err
+	}
+	vocabulary, err := localization.VocabularyList()
+	if err != nil {
+		return nil, err
+	}
+	return &ai.Scenario{
+		ScenarioId:  played.ScenariosID,
+		Title:       localization.Title,
+		Description: localization.Description,
+		Difficulty:  string(played.Difficulty),
+		Goals:       goals,
+		Vocabulary:  vocabulary,
+		SetupPrompt: localization.SetupPrompt,
+	}, nil
+}
+
+// playedScenarioID returns the ID of the scenario a setup plays, or nil for a free conversation
+func playedScenarioID(setup *ai.ChatConfiguration) *string {
+	if setup.Scenario == nil {
+		return nil
+	}
+	return &setup.Scenario.ScenarioId
+}
+
+// toAIHistory converts stored turns to the history sent to the AI service
+func toAIHistory(turns []models.ConversationTurn) []*ai.HistoryTurn {
+	history := make([]*ai.HistoryTurn, 0, len(turns))
+	for _, turn := range turns {
+		history = append(history, &ai.HistoryTurn{
+			UserTranscript: turn.UserTranscript,
+			AiText:         turn.AIText,
+		})
+	}
+	return history
+}
+
+// toAIPlan converts a user plan to the AI service's plan enum
+func toAIPlan(plan models.UserPlan) ai.Plan {
+	if value, ok := ai.Plan_value[string(plan)]; ok {
+		return ai.Plan(value)
+	}
+	return ai.Plan_PLAN_FREE
+}
+
+// sessionStartedEvent acknowledges the setup to the browser as a JSON text message before any AI response
+type sessionStartedEvent struct {
+	Type           string `json:"type"`
+	ConversationID string `json:"conversation_id,omitempty"` // Omitted in privacy mode, where the conversation is not stored
+	Language       string `json:"language"`
+	Character      string `json:"character"`
+	ScenarioID     string `json:"scenario_id,omitempty"` // Omitted for a free conversation
+	PrivacyMode    bool   `json:"privacy_mode"`
+}
+
+// sendSessionStarted tells the browser how the session was set up, including whether it is recorded
+func sendSessionStarted(conn *connWriter, sess *session, conversationID string) error {
+	event := sessionStartedEvent{
+		Type:        "session_started",
+		Language:    sess.setup.Language,
+		Character:   sess.setup.Character,
+		ScenarioID:  sess.setup.GetScenario().GetScenarioId(),
+		PrivacyMode: sess.setup.PrivacyMode,
+	}
+	if !event.PrivacyMode {
+		event.ConversationID = conversationID
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return conn.WriteMessage(websocket.TextMessage, payload)
+}
+
+// feedbackEvent forwards a correction of the user's speech to the browser as a JSON text message
+type feedbackEvent struct {
+	Type        string `json:"type"`
+	Category    string `json:"category"` // grammar, vocabulary, pronunciation or unspecified
+	Original    string `json:"original"`
+	Correction  string `json:"correction"`
+	Explanation string `json:"explanation"`
+}
+
+// toTurnFeedback converts a correction from the AI service to the stored feedback
+func toTurnFeedback(feedback *ai.Feedback) models.TurnFeedback {
+	category := models.FeedbackCategoryUnspecified
+	if _, ok := ai.FeedbackCategory_name[int32(feedback.GetCategory())]; ok {
+		category = models.FeedbackCategory(feedback.GetCategory().String())
+	}
+	return models.TurnFeedback{
+		Category:    category,
+		Original:    feedback.GetOriginal(),
+		Correction:  feedback.GetCorrection(),
+		Explanation: feedback.GetExplanation(),
+	}
+}
+
+// sendFeedback forwards a correction to the browser
+func sendFeedback(conn *connWriter, feedback models.TurnFeedback) error {
+	payload, err := json.Marshal(feedbackEvent{
+		Type:        "feedback",
+		Category:    feedback.Category.Name(),
+		Original:    feedback.Original,
+		Correction:  feedback.Correction,
+		Explanation: feedback.Explanation,
+	})
+	if err != nil {
+		return err
+	}
+	return conn.WriteMessage(websocket.TextMessage, payload)
+}
+
+// goalAchievedEvent tells the browser that the user accomplished a goal of the scenario
+type goalAchievedEvent struct {
+	Type     string `json:"type"`
+	Index    int    `json:"index"` // Index of the goal in the scenario's goals
+	Goal     string `json:"goal"`
+	Achieved int    `json:"achieved"` // Goals achieved so far in this session
+	Total    int    `json:"total"`
+}
+
+// sendGoalAchieved forwards an achieved goal of the scenario to the browser
+func sendGoalAchieved(conn *connWriter, sess *session, index int) error {
+	payload, err := json.Marshal(goalAchievedEvent{
+		Type:     "goal_achieved",
+		Index:    index,
+		Goal:     sess.setup.GetScenario().GetGoals()[index],
+		Achieved: len(sess.goals.Achieved()),
+		Total:    sess.goals.Total(),
+	})
+	if err != nil {
+		return err
+	}
+	return conn.WriteMessage(websocket.TextMessage, payload)
+}
+
+// placementResultEvent tells the browser the level the placement test estimated
+type placementResultEvent struct {
+	Type    string  `json:"type"`
+	Level   string  `json:"level"` // A1 to C2
+	Score   float64 `json:"score"` // 0 to 100
+	Answers int     `json:"answers"`
+}
+
+// finishPlacement scores the placement test and stores the level on the user's language profile.
+// It does nothing until enough prompts were answered, or once the test was scored.
+// The result is sent to the browser when conn is not nil.
+func (h *Handler) finishPlacement(conn *connWriter, sess *session) error {
+	if sess.test == nil {
+		return nil
+	}
+	result, ok := sess.test.Finish()
+	if !ok {
+		return nil
+	}
+	placedAt := time.Now()
+	h.recorder.RecordPlacement(&models.UserLanguageProfile{
+		UserID:         sess.setup.UserId,
+		Language:       sess.setup.Language,
+		Level:          result.Level,
+		PlacementScore: result.Score,
+		PlacedAt:       &placedAt,
+		Estimate:       proficiency.Anchor(result.Level),
+	})
+	if conn == nil {
+		return nil
+	}
+	payload, err := json.Marshal(placementResultEvent{
+		Type:    "placement_result",
+		Level:   strings.TrimPrefix(string(result.Level), "CEFR_LEVEL_"),
+		Score:   result.Score,
+		Answers: result.Answers,
+	})
+	if err != nil {
+		return err
+	}
+	return conn.WriteMessage(websocket.TextMessage, payload)
+}
+
+// toRubric converts the AI service's assessment of a placement answer
+func toRubric(score *ai.PlacementScore) placement.Rubric {
+	return placement.Rubric{
+		Grammar:        int(score.GetGrammar()),
+		Vocabulary:     int(score.GetVocabulary()),
+		Fluency:        int(score.GetFluency()),
+		TaskCompletion: int(score.GetTaskCompletion()),
+	}
+}
+
+// practiced reports whether a finished session counts as practice:
+// sessions in which the user neither spoke nor finished a turn do not.
+func practiced(sess *session, recording *conversation.Session) bool {
+	return sess.usage.SessionTotals().UserAudio > 0 || recording.SavedTurns() > 0
+}
+
+// recordPractice stores the statistics of a finished session.
+// Conversations, unlike placement tests, are queued for the proficiency analyzer.
+func (h *Handler) recordPractice(sess *session, recording *conversation.Session, startedAt time.Time) {
+	totals := sess.usage.SessionTotals()
+	turns := recording.SavedTurns()
+	conversationID := recording.ConversationID()
+	endedAt := time.Now()
+	var analysisDueAt *time.Time
+	if sess.test == nil {
+		analysisDueAt = &endedAt
+	}
+	h.recorder.RecordPractice(&models.PracticeSession{
+		UserID:         sess.setup.UserId,
+		ConversationID: &conversationID,
+		Language:       sess.setup.Language,
+		Character:      sess.setup.Character,
+		StartedAt:      startedAt,
+		EndedAt:        endedAt,
+		UserAudioMs:    totals.UserAudio.Milliseconds(),
+		AIAudioMs:      totals.AIAudio.Milliseconds(),
+		Turns:          turns,
+		AnalysisDueAt:  analysisDueAt,
+	})
+}
+
+// recordScenario stores which goals the user achieved in a session played in a scenario
+func (h *Handler) recordScenario(sess *session, recording *conversation.Session) {
+	if sess.goals == nil {
+		return
+	}
+	achieved := sess.goals.Achieved()
+	conversationID := recording.ConversationID()
+	h.recorder.RecordScenario(&models.ScenarioCompletion{
+		UserID:         sess.setup.UserId,
+		ScenarioID:     sess.setup.Scenario.ScenarioId,
+		ConversationID: &conversationID,
+		Language:       sess.setup.Language,
+		GoalsAchieved:  len(achieved),
+		GoalsTotal:     sess.goals.Total(),
+		AchievedGoals:  scenario.EncodeIndices(achieved),
+		CompletedAt:    time.Now(),
+	})
+}
+
+// responseTime returns when the AI service sent a response, falling back to now
+// for responses without a timestamp
+func responseTime(resp *ai.ChatResponse) time.Time {
+	if ts := resp.GetTimestamp(); ts.IsValid() && (ts.GetSeconds() != 0 || ts.GetNanos() != 0) {
+		return ts.AsTime()
+	}
+	return time.Now()
 }
 
 // HandleConnection upgrades the HTTP connection to a WebSocket connection
@@ -42,16 +625,68 @@ func (h *Handler) HandleConnection(c *gin.Context) {
 	// Get request ID from context
 	requestID, _ := middleware.GetRequestID(c)
 
-	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	sess, statusCode, err := h.startSession(c)
+	if err != nil {
+		log.Printf("[%s] Rejected websocket session: %v", requestID, err)
+		c.JSON(statusCode, gin.H{"error": http.StatusText(statusCode)})
+		return
+	}
+
+	ws, err := upgrader.Upgrade(c.Writer, c.Request, nil)
 	if err != nil {
 		log.Printf("[%s] Failed to upgrade to websocket: %v", requestID, err)
 		return
 	}
-	defer conn.Close()
+	defer ws.Close()
+	conn := &connWriter{conn: ws}
+
+	// A user already out of quota is turned away before a conversation is created or resumed
+	if exceeded, ok := sess.usage.Exceeded(); ok {
+		closeForQuota(requestID, conn, exceeded)
+		return
+	}
+
+	// Record the conversation in the background; the last turn and close reason are saved when it ends.
+	// In privacy mode nothing is recorded, but usage is still metered below.
+	recording := h.recorder.Start(conversation.Params{
+		UserID:      sess.setup.UserId,
+		Language:    sess.setup.Language,
+		Character:   sess.setup.Character,
+		ScenarioID:  playedScenarioID(sess.setup),
+		Plan:        sess.plan,
+		RecordAudio: !sess.settings.AudioOptOut,
+		Private:     sess.setup.PrivacyMode,
+		Resume:      sess.resume,
+	})
+	startedAt := time.Now()
+	// Summarize the conversation once it ends, if anything was said in this session,
+	// and keep the session's statistics and achieved scenario goals for the user's progress
+	defer func() {
+		recording.End()
+		if recording.SavedTurns() > 0 {
+			h.recorder.RequestSummary(recording.ConversationID())
+		}
+		if !sess.setup.PrivacyMode && practiced(sess, recording) {
+			h.recordPractice(sess, recording, startedAt)
+			h.recordScenario(sess, recording)
+		}
+		// A level is not conversation content, so an unfinished test is scored even in privacy mode
+		if err := h.finishPlacement(nil, sess); err != nil {
+			log.Printf("[%s] Failed to score placement test: %v", requestID, err)
+		}
+	}()
+
+	// Persist the remaining usage once the session ends, even though the request context is canceled by then
+	defer func() {
+		if err := sess.usage.Flush(context.Background()); err != nil {
+			log.Printf("[%s] Failed to persist usage: %v", requestID, err)
+		}
+	}()
 
 	client := h.aiProvider.GetGRPCClient()
 	if client == nil {
 		log.Printf("[%s] AI Service client is not available", requestID)
+		recording.SetCloseReason(models.CloseReasonError)
 		return
 	}
 
@@ -63,34 +698,56 @@ func (h *Handler) HandleConnection(c *gin.Context) {
 	stream, err := client.StreamChat(ctx)
 	if err != nil {
 		log.Printf("[%s] Failed to start StreamChat: %v", requestID, err)
+		recording.SetCloseReason(models.CloseReasonError)
 		return
 	}
 
 	log.Printf("[%s] WebSocket connection established and gRPC stream started", requestID)
 
-	// Helper to send setup message (Temporary hardcoded until FE sends it)
-	// Ideally FE should send a JSON setup message first.
-	// For now, we assume defaults if we just start receiving bytes.
-	// But let's look at what we can do. We'll send a dummy setup first.
-	// Note: In a real app, strict protocol is needed.
-	// We'll use values from query params or assume defaults.
-	// Note: In a real app, strict protocol is needed.
-	// We'll use values from query params or assume defaults.
 	if err := stream.Send(&ai.ChatRequest{
 		Content: &ai.ChatRequest_Setup{
-			Setup: &ai.ChatConfiguration{
-				UserId:    "user-123", // TODO: Get from Auth
-				Username:  "User",
-				Language:  "ja", // Default
-				Character: "friend",
-				Plan:      ai.Plan_PLAN_TEST,
-			},
+			Setup: sess.setup,
 		},
 	}); err != nil {
 		log.Printf("[%s] Failed to send setup message: %v", requestID, err)
+		recording.SetCloseReason(models.CloseReasonError)
+		return
+	}
+	if err := sendSessionStarted(conn, sess, recording.ConversationID()); err != nil {
+		log.Printf("[%s] Error sending session_started to WS: %v", requestID, err)
 		return
 	}
 
+	go func() {
+		ticker := time.NewTicker(usageFlushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := sess.usage.Flush(ctx); err != nil {
+					log.Printf("[%s] Failed to persist usage: %v", requestID, err)
+				}
+			}
+		}
+	}()
+
+	// enforceQuota ends the session once the user runs out of quota.
+	// Both loops meter audio, so the close is sent only once.
+	var quotaOnce sync.Once
+	enforceQuota := func() bool {
+		exceeded, ok := sess.usage.Exceeded()
+		if !ok {
+			return false
+		}
+		quotaOnce.Do(func() {
+			recording.SetCloseReason(models.CloseReasonQuotaExceeded)
+			closeForQuota(requestID, conn, exceeded)
+		})
+		return true
+	}
+
 	// Channel to signal completion or error
 	done := make(chan struct{})
 
@@ -101,6 +758,7 @@ func (h *Handler) HandleConnection(c *gin.Context) {
 			resp, err := stream.Recv()
 			if err == io.EOF {
 				log.Printf("[%s] AI stream finished", requestID)
+				recording.SetCloseReason(models.CloseReasonAIStreamEnded)
 				return
 			}
 			if err != nil {
@@ -109,18 +767,59 @@ func (h *Handler) HandleConnection(c *gin.Context) {
 					return
 				}
 				log.Printf("[%s] Error receiving from AI stream: %v", requestID, err)
+				recording.SetCloseReason(models.CloseReasonError)
 				conn.WriteMessage(websocket.CloseMessage, []byte{})
 				return
 			}
 
 			// Handle different response content types
-			if audio := resp.GetAudioChunk(); len(audio) > 0 {
+			if transcript := resp.GetUserTranscript(); transcript != "" {
+				recording.OnUserTranscript(transcript)
+				if sess.test != nil {
+					sess.test.OnTranscript(transcript)
+				}
+			} else if score := resp.GetPlacementScore(); score != nil {
+				if sess.test == nil || !sess.test.OnScore(score.GetPromptId(), toRubric(score)) || !sess.test.Complete() {
+					continue
+				}
+				if err := h.finishPlacement(conn, sess); err != nil {
+					log.Printf("[%s] Error sending placement_result to WS: %v", requestID, err)
+					return
+				}
+			} else if proposed := resp.GetMemory(); proposed != "" {
+				// Memories are content of the conversation too, so privacy mode drops them
+				if !sess.setup.PrivacyMode {
+					h.recorder.Remember(sess.setup.UserId, recording.ConversationID(), proposed)
+				}
+			} else if fb := resp.GetFeedback(); fb != nil {
+				feedback := toTurnFeedback(fb)
+				recording.OnFeedback(feedback)
+				if err := sendFeedback(conn, feedback); err != nil {
+					log.Printf("[%s] Error sending feedback to WS: %v", requestID, err)
+					return
+				}
+			} else if goal := resp.GetGoalAchieved(); goal != nil {
+				index := int(goal.GetIndex())
+				if sess.goals == nil || !sess.goals.Achieve(index) {
+					continue
+				}
+				if err := sendGoalAchieved(conn, sess, index); err != nil {
+					log.Printf("[%s] Error sending goal_achieved to WS: %v", requestID, err)
+					return
+				}
+			} else if audio := resp.GetAudioChunk(); len(audio) > 0 {
+				sess.usage.AddAIAudio(len(audio))
+				if enforceQuota() {
+					return
+				}
+				recording.OnAIAudio(audio, responseTime(resp))
 				// Send audio as binary message
 				if err := conn.WriteMessage(websocket.BinaryMessage, audio); err != nil {
 					log.Printf("[%s] Error sending audio to WS: %v", requestID, err)
 					return
 				}
 			} else if text := resp.GetTextMessage(); text != "" {
+				recording.OnAIText(resp.GetResponseId(), text, responseTime(resp))
 				// Send text as text message
 				if err := conn.WriteMessage(websocket.TextMessage, []byte(text)); err != nil {
 					log.Printf("[%s] Error sending text to WS: %v", requestID, err)
@@ -132,7 +831,7 @@ func (h *Handler) HandleConnection(c *gin.Context) {
 
 	// Main Loop: Receive from WebSocket (Browser) -> Send to gRPC (AI)
 	for {
-		messageType, p, err := conn.ReadMessage()
+		messageType, p, err := ws.ReadMessage()
 		if err != nil {
 			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
 				log.Printf("[%s] WebSocket error: %v", requestID, err)
@@ -151,12 +850,18 @@ func (h *Handler) HandleConnection(c *gin.Context) {
 
 		if messageType == websocket.BinaryMessage {
 			// Assume binary message is audio chunk
+			sess.usage.AddUserAudio(len(p))
+			if enforceQuota() {
+				break
+			}
+			recording.OnUserAudio(p)
 			if err := stream.Send(&ai.ChatRequest{
 				Content: &ai.ChatRequest_AudioChunk{
 					AudioChunk: p,
 				},
 			}); err != nil {
 				log.Printf("[%s] Error sending audio chunk to AI: %v", requestID, err)
+				recording.SetCloseReason(models.CloseReasonError)
 				break
 			}
 		} else if messageType == websocket.TextMessage {
@@ -170,11 +875,19 @@ func (h *Handler) HandleConnection(c *gin.Context) {
 					},
 				}); err != nil {
 					log.Printf("[%s] Error sending EOS to AI: %v", requestID, err)
+					recording.SetCloseReason(models.CloseReasonError)
 					break
 				}
+			} else if sess.setup.PrivacyMode {
+				log.Printf("[%s] Received text (%d bytes)", requestID, len(text))
 			} else {
 				log.Printf("[%s] Received text: %s", requestID, text)
 			}
 		}
 	}
+
+	// Let the AI service finish the session instead of aborting it
+	if err := stream.CloseSend(); err != nil {
+		log.Printf("[%s] Error closing AI stream: %v", requestID, err)
+	}
 }
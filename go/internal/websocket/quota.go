@@ -0,0 +1,63 @@
+package websocket
+
+import (
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/hiroky1983/talk/go/internal/usage"
+)
+
+// closeWriteTimeout bounds how long sending the close frame may take
+const closeWriteTimeout = time.Second
+
+// connWriter serializes writes to a WebSocket connection, which supports only one concurrent writer
+type connWriter struct {
+	mu   sync.Mutex
+	conn *websocket.Conn
+}
+
+func (w *connWriter) WriteMessage(messageType int, data []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.conn.WriteMessage(messageType, data)
+}
+
+// quotaExceededEvent is sent to the browser as a JSON text message before the session is closed
+type quotaExceededEvent struct {
+	Type         string    `json:"type"`
+	Period       string    `json:"period"`
+	LimitSeconds int64     `json:"limit_seconds"`
+	UsedSeconds  int64     `json:"used_seconds"`
+	ResetsAt     time.Time `json:"resets_at"`
+}
+
+// closeForQuota tells the browser which quota ran out and closes the connection gracefully
+func closeForQuota(requestID string, conn *connWriter, exceeded usage.Exceeded) {
+	log.Printf("[%s] %s quota exceeded (%s of %s), closing session", requestID, exceeded.Period, exceeded.Used.Round(time.Second), exceeded.Limit)
+
+	payload, err := json.Marshal(quotaExceededEvent{
+		Type:         "quota_exceeded",
+		Period:       string(exceeded.Period),
+		LimitSeconds: int64(exceeded.Limit.Seconds()),
+		UsedSeconds:  int64(exceeded.Used.Seconds()),
+		ResetsAt:     exceeded.ResetsAt,
+	})
+	if err != nil {
+		log.Printf("[%s] Failed to encode quota event: %v", requestID, err)
+		return
+	}
+	if err := conn.WriteMessage(websocket.TextMessage, payload); err != nil {
+		log.Printf("[%s] Error sending quota event to WS: %v", requestID, err)
+		return
+	}
+
+	conn.mu.Lock()
+	defer conn.mu.Unlock()
+	closeMessage := websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "quota exceeded")
+	if err := conn.conn.WriteControl(websocket.CloseMessage, closeMessage, time.Now().Add(closeWriteTimeout)); err != nil {
+		log.Printf("[%s] Error sending close frame to WS: %v", requestID, err)
+	}
+}
@@ -1,19 +1,36 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net/http"
+	"os"
 	"time"
+	_ "time/tzdata" // The container image has no system time zone database
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
-	"github.com/joho/godotenv"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 
 	"github.com/hiroky1983/talk/go/gen/app/appv1connect"
+	"github.com/hiroky1983/talk/go/internal/auth"
+	"github.com/hiroky1983/talk/go/internal/billing"
+	"github.com/hiroky1983/talk/go/internal/config"
+	"github.com/hiroky1983/talk/go/internal/conversation"
 	"github.com/hiroky1983/talk/go/internal/database"
+	"github.com/hiroky1983/talk/go/internal/entitlement"
+	"github.com/hiroky1983/talk/go/internal/gateway"
 	"github.com/hiroky1983/talk/go/internal/handlers"
+	"github.com/hiroky1983/talk/go/internal/models"
+	"github.com/hiroky1983/talk/go/internal/notify"
+	"github.com/hiroky1983/talk/go/internal/proficiency"
+	"github.com/hiroky1983/talk/go/internal/reminder"
+	"github.com/hiroky1983/talk/go/internal/retention"
+	"github.com/hiroky1983/talk/go/internal/storage"
+	"github.com/hiroky1983/talk/go/internal/summary"
+	"github.com/hiroky1983/talk/go/internal/usage"
+	"github.com/hiroky1983/talk/go/internal/vocabulary"
 	"github.com/hiroky1983/talk/go/internal/websocket"
 	"github.com/hiroky1983/talk/go/middleware"
 )
@@ -40,29 +57,131 @@ func Logger() gin.HandlerFunc {
 	}
 }
 
+// grantExpiryInterval is how often plans of users whose promo code grant ended are reverted
+const grantExpiryInterval = 10 * time.Minute
+
+// summaryInterval is how often requested conversation summaries are generated
+const summaryInterval = 30 * time.Second
+
+// retentionInterval is how often recorded audio and transcripts past their retention are deleted
+const retentionInterval = time.Hour
+
+// reminderInterval is how often due practice reminders are sent
+const reminderInterval = time.Minute
+
+// proficiencyInterval is how often finished conversations are assessed for the learners' levels
+const proficiencyInterval = time.Minute
+
 func main() {
 	// Load .env file (try multiple paths)
-	err := godotenv.Load()
-	if err != nil {
-		// Try loading from go/.env if running from project root
-		err = godotenv.Load("go/.env")
-		if err != nil {
-			log.Println("No .env file found, using environment variables")
-		}
-	}
+	config.LoadEnv()
 
-	// Initialize Gorm DB (Foundation) - Connection check only
-	_, err = database.NewGormDB()
+	// Initialize Gorm DB (Foundation)
+	db, err := database.NewGormDB()
 	if err != nil {
 		log.Fatal("Failed to connect to database using Gorm:", err)
 	}
 	log.Println("Successfully connected to database via Gorm")
 
+	jwtManager, err := auth.NewJWTManager()
+	if err != nil {
+		log.Fatal("Failed to initialize JWT manager:", err)
+	}
+
+	// Create repositories
+	repos := handlers.Repositories{
+		User:            gateway.NewUserRepository(db),
+		Admin:           gateway.NewAdminRepository(db),
+		Promo:           gateway.NewPromoRepository(db),
+		Conversation:    gateway.NewConversationRepository(db),
+		Settings:        gateway.NewSettingsRepository(db),
+		Memory:          gateway.NewMemoryRepository(db),
+		Summary:         gateway.NewSummaryRepository(db),
+		Vocabulary:      gateway.NewVocabularyRepository(db),
+		Mistake:         gateway.NewMistakeRepository(db),
+		Progress:        gateway.NewProgressRepository(db),
+		Goal:            gateway.NewGoalRepository(db),
+		Notification:    gateway.NewNotificationRepository(db),
+		Scenario:        gateway.NewScenarioRepository(db),
+		Character:       gateway.NewCharacterRepository(db),
+		CustomCharacter: gateway.NewCustomCharacterRepository(db),
+		Proficiency:     gateway.NewProficiencyRepository(db),
+	}
+
+	subscriptions := gateway.NewSubscriptionRepository(db)
+	planResolver := entitlement.NewResolver(repos.Promo)
+	go planResolver.RunGrantExpiry(context.Background(), grantExpiryInterval)
+
+	quotas, err := usage.LoadQuotas()
+	if err != nil {
+		log.Fatal("Failed to load usage quotas:", err)
+	}
+	location, err := time.LoadLocation(database.DefaultTimeZone)
+	if err != nil {
+		log.Fatal("Failed to load time zone:", err)
+	}
+	usageService := usage.NewService(gateway.NewUsageRepository(db), quotas, location)
+
+	blobs, err := storage.NewFileStore(storage.DirFromEnv())
+	if err != nil {
+		log.Fatal("Failed to initialize blob storage:", err)
+	}
+
+	retentionPolicies, err := retention.LoadPolicies()
+	if err != nil {
+		log.Fatal("Failed to load retention policies:", err)
+	}
+	// RETENTION_DRY_RUN=true only logs what would be deleted, e.g. before tightening a policy
+	purger := retention.NewPurger(gateway.NewRetentionRepository(db), blobs, retentionPolicies)
+	go purger.Run(context.Background(), retentionInterval, os.Getenv("RETENTION_DRY_RUN") == "true")
+
+	conversationRecorder := conversation.NewRecorder(repos.Conversation, repos.Memory, repos.Summary, repos.Progress, repos.Scenario, repos.Proficiency, blobs, conversation.DefaultQueueSize)
+	go conversationRecorder.Run(context.Background())
+	historyBudget, err := conversation.LoadHistoryBudget()
+	if err != nil {
+		log.Fatal("Failed to load conversation history budget:", err)
+	}
+
+	// Practice reminders and password resets are emailed through SMTP_HOST; without it they are only logged
+	smtpConfig, smtpEnabled, err := notify.SMTPConfigFromEnv()
+	if err != nil {
+		log.Fatal("Failed to load SMTP config:", err)
+	}
+	var emailNotifier notify.Notifier = notify.NewLog()
+	if smtpEnabled {
+		emailNotifier = notify.NewEmail(smtpConfig)
+	} else {
+		log.Println("SMTP_HOST is not set, emails are only logged")
+	}
+	reminderScheduler := reminder.NewScheduler(repos.Goal, repos.Settings, repos.Progress, map[models.NotificationChannel]notify.Notifier{
+		models.NotificationChannelInApp: notify.NewInApp(repos.Notification),
+		models.NotificationChannelEmail: emailNotifier,
+	}, location)
+	go reminderScheduler.Run(context.Background(), reminderInterval)
+
 	// Create AI service
 	aiService := NewAIConversationService()
+	summaryWorker := summary.NewWorker(repos.Summary, repos.Conversation, aiService)
+	go summaryWorker.Run(context.Background(), summaryInterval)
+	proficiencyAnalyzer := proficiency.NewAnalyzer(repos.Proficiency)
+	go proficiencyAnalyzer.Run(context.Background(), proficiencyInterval)
 
 	// Create WebSocket handler
-	wsHandler := websocket.NewHandler(aiService)
+	wsHandler := websocket.NewHandler(websocket.Dependencies{
+		AIProvider:       aiService,
+		Users:            repos.User,
+		Settings:         repos.Settings,
+		Memories:         repos.Memory,
+		Plans:            planResolver,
+		Usage:            usageService,
+		Recorder:         conversationRecorder,
+		Conversations:    repos.Conversation,
+		Scenarios:        repos.Scenario,
+		Characters:       repos.Character,
+		CustomCharacters: repos.CustomCharacter,
+		Proficiency:      repos.Proficiency,
+		HistoryBudget:    historyBudget,
+	})
 
 	// Create Gin router
 	router := gin.Default()
@@ -87,13 +206,70 @@ func main() {
 	})
 
 	// WebSocket endpoint
-	router.GET("/ws/chat", wsHandler.HandleConnection)
+	router.GET("/ws/chat", middleware.WebSocketAuthMiddleware(jwtManager), wsHandler.HandleConnection)
+
+	// Payment provider webhooks (authenticated by signature)
+	if secret := os.Getenv("BILLING_WEBHOOK_SECRET"); secret != "" {
+		webhookHandler := billing.NewWebhookHandler(billing.NewService(subscriptions, planResolver), secret)
+		router.POST("/webhooks/billing", webhookHandler.HandleWebhook)
+	} else {
+		log.Println("BILLING_WEBHOOK_SECRET is not set, billing webhooks are disabled")
+	}
 
 	// Mount Connect RPC handler with wildcard to match all methods
-	apiHandler := handlers.NewAPIHandler()
+	apiHandler := handlers.NewAPIHandler(repos, handlers.Services{
+		Plans:     planResolver,
+		Usage:     usageService,
+		Blobs:     blobs,
+		Retention: retentionPolicies,
+		TimeZone:  location,
+		Email:     emailNotifier,
+	})
 	userPath, userHandler := appv1connect.NewUserServiceHandler(apiHandler.UserHandler)
 	router.Any(userPath+"*filepath", wrapConnectHandler(userHandler))
 
+	// Authenticated routes
+	authMiddleware := middleware.JWTAuthMiddleware(jwtManager)
+	adminPath, adminHandler := appv1connect.NewAdminServiceHandler(apiHandler.AdminHandler)
+	router.Any(adminPath+"*filepath", authMiddleware, wrapConnectHandler(adminHandler))
+	usagePath, usageHandler := appv1connect.NewUsageServiceHandler(apiHandler.UsageHandler)
+	router.Any(usagePath+"*filepath", authMiddleware, wrapConnectHandler(usageHandler))
+	promoPath, promoHandler := appv1connect.NewPromoServiceHandler(apiHandler.PromoHandler)
+	router.Any(promoPath+"*filepath", authMiddleware, wrapConnectHandler(promoHandler))
+
+	conversationPath, conversationHandler := appv1connect.NewConversationServiceHandler(apiHandler.ConversationHandler)
+	router.Any(conversationPath+"*filepath", authMiddleware, wrapConnectHandler(conversationHandler))
+	settingsPath, settingsHandler := appv1connect.NewSettingsServiceHandler(apiHandler.SettingsHandler)
+	router.Any(settingsPath+"*filepath", authMiddleware, wrapConnectHandler(settingsHandler))
+	memoryPath, memoryHandler := appv1connect.NewMemoryServiceHandler(apiHandler.MemoryHandler)
+	router.Any(memoryPath+"*filepath", authMiddleware, wrapConnectHandler(memoryHandler))
+	vocabularyPath, vocabularyHandler := appv1connect.NewVocabularyServiceHandler(apiHandler.VocabularyHandler)
+	router.Any(vocabularyPath+"*filepath", authMiddleware, wrapConnectHandler(vocabularyHandler))
+	mistakePath, mistakeHandler := appv1connect.NewMistakeServiceHandler(apiHandler.MistakeHandler)
+	router.Any(mistakePath+"*filepath", authMiddleware, wrapConnectHandler(mistakeHandler))
+	progressPath, progressHandler := appv1connect.NewProgressServiceHandler(apiHandler.ProgressHandler)
+	router.Any(progressPath+"*filepath", authMiddleware, wrapConnectHandler(progressHandler))
+	goalPath, goalHandler := appv1connect.NewGoalServiceHandler(apiHandler.GoalHandler)
+	router.Any(goalPath+"*filepath", authMiddleware, wrapConnectHandler(goalHandler))
+	notificationPath, notificationHandler := appv1connect.NewNotificationServiceHandler(apiHandler.NotificationHandler)
+	router.Any(notificationPath+"*filepath", authMiddleware, wrapConnectHandler(notificationHandler))
+	scenarioPath, scenarioHandler := appv1connect.NewScenarioServiceHandler(apiHandler.ScenarioHandler)
+	router.Any(scenarioPath+"*filepath", authMiddleware, wrapConnectHandler(scenarioHandler))
+	characterPath, characterHandler := appv1connect.NewCharacterServiceHandler(apiHandler.CharacterHandler)
+	router.Any(characterPath+"*filepath", authMiddleware, wrapConnectHandler(characterHandler))
+	customCharacterPath, customCharacterHandler := appv1connect.NewCustomCharacterServiceHandler(apiHandler.CustomCharacterHandler)
+	router.Any(customCharacterPath+"*filepath", authMiddleware, wrapConnectHandler(customCharacterHandler))
+	proficiencyPath, proficiencyHandler := appv1connect.NewProficiencyServiceHandler(apiHandler.ProficiencyHandler)
+	router.Any(proficiencyPath+"*filepath", authMiddleware, wrapConnectHandler(proficiencyHandler))
+
+	// Recorded conversation audio, served with range support for seeking
+	playbackHandler := conversation.NewPlaybackHandler(repos.Conversation, blobs)
+	router.GET("/conversations/:conversation_id/turns/:seq/audio/:track", authMiddleware, playbackHandler.ServeTurnAudio)
+	exportHandler := conversation.NewExportHandler(repos.Conversation, repos.Character, repos.CustomCharacter)
+	router.GET("/conversations/:conversation_id/export", authMiddleware, exportHandler.ServeExport)
+	vocabularyExportHandler := vocabulary.NewExportHandler(repos.Vocabulary)
+	router.GET("/vocabulary/export", authMiddleware, vocabularyExportHandler.ServeExport)
+
 	log.Println("Starting AI Language Learning server on :8000")
 	log.Println("WebSocket service available at: /ws/chat")
 
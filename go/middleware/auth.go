@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
@@ -15,6 +16,9 @@ const (
 	EmailKey = "email"
 )
 
+// userIDContextKey is the key used to store user_id in the request context
+type userIDContextKey struct{}
+
 // JWTAuthMiddleware validates JWT tokens and extracts user information
 func JWTAuthMiddleware(jwtManager *auth.JWTManager) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -38,31 +42,60 @@ func JWTAuthMiddleware(jwtManager *auth.JWTManager) gin.HandlerFunc {
 			return
 		}
 
-		token := parts[1]
-
-		// Validate token
-		claims, err := jwtManager.ValidateToken(token)
-		if err != nil {
-			if err == auth.ErrExpiredToken {
-				c.JSON(http.StatusUnauthorized, gin.H{
-					"error": "Token has expired",
-				})
-			} else {
-				c.JSON(http.StatusUnauthorized, gin.H{
-					"error": "Invalid token",
-				})
+		authenticate(c, jwtManager, parts[1])
+	}
+}
+
+// WebSocketAuthMiddleware authenticates WebSocket upgrade requests.
+// Browsers cannot set headers on a WebSocket handshake, so the token may also be
+// passed as the "token" query parameter.
+func WebSocketAuthMiddleware(jwtManager *auth.JWTManager) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := c.Query("token")
+		if authHeader := c.GetHeader("Authorization"); authHeader != "" {
+			parts := strings.SplitN(authHeader, " ", 2)
+			if len(parts) == 2 && parts[0] == "Bearer" {
+				token = parts[1]
 			}
+		}
+		if token == "" {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error": "token query parameter or Authorization header is required",
+			})
 			c.Abort()
 			return
 		}
 
-		// Store user information in context
-		c.Set(UserIDKey, claims.UserID)
-		c.Set(EmailKey, claims.Email)
+		authenticate(c, jwtManager, token)
+	}
+}
 
-		// Continue to next handler
-		c.Next()
+// authenticate validates token and stores the user in the context, aborting on failure
+func authenticate(c *gin.Context, jwtManager *auth.JWTManager, token string) {
+	// Validate token
+	claims, err := jwtManager.ValidateToken(token)
+	if err != nil {
+		if err == auth.ErrExpiredToken {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error": "Token has expired",
+			})
+		} else {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error": "Invalid token",
+			})
+		}
+		c.Abort()
+		return
 	}
+
+	// Store user information in context
+	c.Set(UserIDKey, claims.UserID)
+	c.Set(EmailKey, claims.Email)
+	// Also expose the user to handlers that only see the request context (Connect RPC)
+	c.Request = c.Request.WithContext(WithUserID(c.Request.Context(), claims.UserID))
+
+	// Continue to next handler
+	c.Next()
 }
 
 // GetUserID retrieves the user_id from gin.Context
@@ -86,3 +119,17 @@ func GetEmail(c *gin.Context) (string, bool) {
 	emailStr, ok := email.(string)
 	return emailStr, ok
 }
+
+// WithUserID returns a copy of ctx carrying the authenticated user_id
+func WithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userIDContextKey{}, userID)
+}
+
+// UserIDFromContext retrieves the user_id from a request context
+func UserIDFromContext(ctx context.Context) (string, bool) {
+	userID, ok := ctx.Value(userIDContextKey{}).(string)
+	if !ok || userID == "" {
+		return "", false
+	}
+	return userID, true
+}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -137,3 +138,119 @@ func TestGetUserID_WhenUserIDIsNotString(t *testing.T) {
 	assert.False(t, exists)
 	assert.Empty(t, userID)
 }
+
+func TestJWTAuthMiddleware_StoresUserIDInRequestContext(t *testing.T) {
+	// Setup JWT manager
+	os.Setenv("JWT_SECRET_KEY", "test-secret-key-for-testing-only")
+	jwtManager, err := auth.NewJWTManager()
+	assert.NoError(t, err)
+
+	token, err := jwtManager.GenerateAccessToken("test-user-789", "test@example.com")
+	assert.NoError(t, err)
+
+	// Setup router with a plain http.Handler, as used for Connect RPC
+	router := gin.New()
+	router.Use(JWTAuthMiddleware(jwtManager))
+	router.GET("/test", gin.WrapF(func(w http.ResponseWriter, r *http.Request) {
+		userID, exists := UserIDFromContext(r.Context())
+		assert.True(t, exists)
+		assert.Equal(t, "test-user-789", userID)
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req, _ := http.NewRequest("GET", "/test", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	// Execute request
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestUserIDFromContext_WhenUserIDDoesNotExist(t *testing.T) {
+	// Execute
+	userID, exists := UserIDFromContext(context.Background())
+
+	// Assert
+	assert.False(t, exists)
+	assert.Empty(t, userID)
+}
+
+func TestWebSocketAuthMiddleware_WithQueryToken(t *testing.T) {
+	// Setup JWT manager
+	os.Setenv("JWT_SECRET_KEY", "test-secret-key-for-testing-only")
+	jwtManager, err := auth.NewJWTManager()
+	assert.NoError(t, err)
+
+	token, err := jwtManager.GenerateAccessToken("test-user-123", "test@example.com")
+	assert.NoError(t, err)
+
+	// Setup router
+	router := gin.New()
+	router.Use(WebSocketAuthMiddleware(jwtManager))
+	router.GET("/ws", func(c *gin.Context) {
+		userID, exists := GetUserID(c)
+		assert.True(t, exists)
+		c.JSON(http.StatusOK, gin.H{"user_id": userID})
+	})
+
+	// Create request with the token in the query string
+	req, _ := http.NewRequest("GET", "/ws?token="+token, nil)
+
+	// Execute request
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "test-user-123")
+}
+
+func TestWebSocketAuthMiddleware_WithoutToken(t *testing.T) {
+	// Setup JWT manager
+	os.Setenv("JWT_SECRET_KEY", "test-secret-key-for-testing-only")
+	jwtManager, err := auth.NewJWTManager()
+	assert.NoError(t, err)
+
+	// Setup router
+	router := gin.New()
+	router.Use(WebSocketAuthMiddleware(jwtManager))
+	router.GET("/ws", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"message": "success"})
+	})
+
+	req, _ := http.NewRequest("GET", "/ws", nil)
+
+	// Execute request
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestWebSocketAuthMiddleware_WithInvalidQueryToken(t *testing.T) {
+	// Setup JWT manager
+	os.Setenv("JWT_SECRET_KEY", "test-secret-key-for-testing-only")
+	jwtManager, err := auth.NewJWTManager()
+	assert.NoError(t, err)
+
+	// Setup router
+	router := gin.New()
+	router.Use(WebSocketAuthMiddleware(jwtManager))
+	router.GET("/ws", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"message": "success"})
+	})
+
+	req, _ := http.NewRequest("GET", "/ws?token=invalid-token", nil)
+
+	// Execute request
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Contains(t, w.Body.String(), "Invalid token")
+}
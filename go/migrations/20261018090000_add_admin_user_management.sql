@@ -0,0 +1,33 @@
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "role" character varying(50) NOT NULL DEFAULT 'ROLE_USER', ADD COLUMN "disabled_at" timestamptz NULL;
+-- Create "password_reset_tokens" table
+CREATE TABLE "password_reset_tokens" (
+  "password_reset_tokens_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "user_id" uuid NOT NULL,
+  "token_hash" character varying(64) NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "used_at" timestamptz NULL,
+  "created_at" timestamptz NULL,
+  PRIMARY KEY ("password_reset_tokens_id"),
+  CONSTRAINT "fk_password_reset_tokens_user" FOREIGN KEY ("user_id") REFERENCES "users" ("users_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_password_reset_tokens_token_hash" to table: "password_reset_tokens"
+CREATE UNIQUE INDEX "idx_password_reset_tokens_token_hash" ON "password_reset_tokens" ("token_hash");
+-- Create index "idx_password_reset_tokens_user_id" to table: "password_reset_tokens"
+CREATE INDEX "idx_password_reset_tokens_user_id" ON "password_reset_tokens" ("user_id");
+-- Create "audit_logs" table
+CREATE TABLE "audit_logs" (
+  "audit_logs_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "actor_user_id" uuid NULL,
+  "target_user_id" uuid NULL,
+  "action" character varying(50) NOT NULL,
+  "details" text NOT NULL DEFAULT '{}',
+  "created_at" timestamptz NULL,
+  PRIMARY KEY ("audit_logs_id")
+);
+-- Create index "idx_audit_logs_actor_user_id" to table: "audit_logs"
+CREATE INDEX "idx_audit_logs_actor_user_id" ON "audit_logs" ("actor_user_id");
+-- Create index "idx_audit_logs_created_at" to table: "audit_logs"
+CREATE INDEX "idx_audit_logs_created_at" ON "audit_logs" ("created_at");
+-- Create index "idx_audit_logs_target_user_id" to table: "audit_logs"
+CREATE INDEX "idx_audit_logs_target_user_id" ON "audit_logs" ("target_user_id");
@@ -0,0 +1,53 @@
+-- Create "subscriptions" table
+CREATE TABLE "subscriptions" (
+  "subscriptions_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "user_id" uuid NOT NULL,
+  "provider_subscription_id" character varying(255) NOT NULL,
+  "plan" character varying(50) NOT NULL,
+  "status" character varying(50) NOT NULL,
+  "trial_ends_at" timestamptz NULL,
+  "current_period_end" timestamptz NULL,
+  "canceled_at" timestamptz NULL,
+  "last_event_at" timestamptz NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  PRIMARY KEY ("subscriptions_id"),
+  CONSTRAINT "fk_subscriptions_user" FOREIGN KEY ("user_id") REFERENCES "users" ("users_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_subscriptions_provider_subscription_id" to table: "subscriptions"
+CREATE UNIQUE INDEX "idx_subscriptions_provider_subscription_id" ON "subscriptions" ("provider_subscription_id");
+-- Create index "idx_subscriptions_user_id" to table: "subscriptions"
+CREATE INDEX "idx_subscriptions_user_id" ON "subscriptions" ("user_id");
+-- Create "invoices" table
+CREATE TABLE "invoices" (
+  "invoices_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "subscription_id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "provider_invoice_id" character varying(255) NOT NULL,
+  "amount_due" bigint NOT NULL,
+  "currency" character varying(3) NOT NULL,
+  "status" character varying(50) NOT NULL,
+  "period_start" timestamptz NULL,
+  "period_end" timestamptz NULL,
+  "paid_at" timestamptz NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  PRIMARY KEY ("invoices_id"),
+  CONSTRAINT "fk_invoices_subscription" FOREIGN KEY ("subscription_id") REFERENCES "subscriptions" ("subscriptions_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_invoices_provider_invoice_id" to table: "invoices"
+CREATE UNIQUE INDEX "idx_invoices_provider_invoice_id" ON "invoices" ("provider_invoice_id");
+-- Create index "idx_invoices_subscription_id" to table: "invoices"
+CREATE INDEX "idx_invoices_subscription_id" ON "invoices" ("subscription_id");
+-- Create index "idx_invoices_user_id" to table: "invoices"
+CREATE INDEX "idx_invoices_user_id" ON "invoices" ("user_id");
+-- Create "billing_events" table
+CREATE TABLE "billing_events" (
+  "billing_events_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "provider_event_id" character varying(255) NOT NULL,
+  "type" character varying(100) NOT NULL,
+  "received_at" timestamptz NULL,
+  PRIMARY KEY ("billing_events_id")
+);
+-- Create index "idx_billing_events_provider_event_id" to table: "billing_events"
+CREATE UNIQUE INDEX "idx_billing_events_provider_event_id" ON "billing_events" ("provider_event_id");
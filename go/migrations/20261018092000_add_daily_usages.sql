@@ -0,0 +1,15 @@
+-- Create "daily_usages" table
+CREATE TABLE "daily_usages" (
+  "daily_usages_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "user_id" uuid NOT NULL,
+  "usage_date" date NOT NULL,
+  "user_audio_ms" bigint NOT NULL DEFAULT 0,
+  "ai_audio_ms" bigint NOT NULL DEFAULT 0,
+  "sessions" bigint NOT NULL DEFAULT 0,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  PRIMARY KEY ("daily_usages_id"),
+  CONSTRAINT "fk_daily_usages_user" FOREIGN KEY ("user_id") REFERENCES "users" ("users_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_daily_usages_user_id_usage_date" to table: "daily_usages"
+CREATE UNIQUE INDEX "idx_daily_usages_user_id_usage_date" ON "daily_usages" ("user_id", "usage_date");
@@ -0,0 +1,37 @@
+-- Create "promo_codes" table
+CREATE TABLE "promo_codes" (
+  "promo_codes_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "code" character varying(64) NOT NULL,
+  "plan" character varying(50) NOT NULL,
+  "duration_days" bigint NOT NULL,
+  "max_redemptions" bigint NOT NULL DEFAULT 0,
+  "redemption_count" bigint NOT NULL DEFAULT 0,
+  "expires_at" timestamptz NULL,
+  "campaign" character varying(100) NOT NULL DEFAULT '',
+  "created_by_user_id" uuid NULL,
+  "created_at" timestamptz NULL,
+  PRIMARY KEY ("promo_codes_id")
+);
+-- Create index "idx_promo_codes_campaign" to table: "promo_codes"
+CREATE INDEX "idx_promo_codes_campaign" ON "promo_codes" ("campaign");
+-- Create index "idx_promo_codes_code" to table: "promo_codes"
+CREATE UNIQUE INDEX "idx_promo_codes_code" ON "promo_codes" ("code");
+-- Create "plan_grants" table
+CREATE TABLE "plan_grants" (
+  "plan_grants_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "user_id" uuid NOT NULL,
+  "promo_code_id" uuid NOT NULL,
+  "plan" character varying(50) NOT NULL,
+  "previous_plan" character varying(50) NOT NULL,
+  "starts_at" timestamptz NOT NULL,
+  "ends_at" timestamptz NOT NULL,
+  "reverted_at" timestamptz NULL,
+  "created_at" timestamptz NULL,
+  PRIMARY KEY ("plan_grants_id"),
+  CONSTRAINT "fk_plan_grants_promo_code" FOREIGN KEY ("promo_code_id") REFERENCES "promo_codes" ("promo_codes_id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "fk_plan_grants_user" FOREIGN KEY ("user_id") REFERENCES "users" ("users_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_plan_grants_ends_at" to table: "plan_grants"
+CREATE INDEX "idx_plan_grants_ends_at" ON "plan_grants" ("ends_at");
+-- Create index "idx_plan_grants_user_id_promo_code_id" to table: "plan_grants"
+CREATE UNIQUE INDEX "idx_plan_grants_user_id_promo_code_id" ON "plan_grants" ("user_id", "promo_code_id");
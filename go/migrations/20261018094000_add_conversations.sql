@@ -0,0 +1,34 @@
+-- Create "conversations" table
+CREATE TABLE "conversations" (
+  "conversations_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "user_id" uuid NOT NULL,
+  "language" character varying(10) NOT NULL,
+  "character" character varying(50) NOT NULL,
+  "plan" character varying(50) NOT NULL,
+  "started_at" timestamptz NOT NULL,
+  "ended_at" timestamptz NULL,
+  "close_reason" character varying(50) NOT NULL DEFAULT '',
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  PRIMARY KEY ("conversations_id"),
+  CONSTRAINT "fk_conversations_user" FOREIGN KEY ("user_id") REFERENCES "users" ("users_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_conversations_user_id_started_at" to table: "conversations"
+CREATE INDEX "idx_conversations_user_id_started_at" ON "conversations" ("user_id", "started_at");
+-- Create "conversation_turns" table
+CREATE TABLE "conversation_turns" (
+  "conversation_turns_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "conversation_id" uuid NOT NULL,
+  "seq" bigint NOT NULL,
+  "response_id" character varying(255) NOT NULL DEFAULT '',
+  "user_transcript" text NOT NULL DEFAULT '',
+  "ai_text" text NOT NULL DEFAULT '',
+  "started_at" timestamptz NOT NULL,
+  "ended_at" timestamptz NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  PRIMARY KEY ("conversation_turns_id"),
+  CONSTRAINT "fk_conversation_turns_conversation" FOREIGN KEY ("conversation_id") REFERENCES "conversations" ("conversations_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_conversation_turns_conversation_id_seq" to table: "conversation_turns"
+CREATE UNIQUE INDEX "idx_conversation_turns_conversation_id_seq" ON "conversation_turns" ("conversation_id", "seq");
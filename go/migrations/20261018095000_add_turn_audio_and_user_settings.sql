@@ -0,0 +1,14 @@
+-- Modify "conversation_turns" table
+ALTER TABLE "conversation_turns" ADD COLUMN "user_audio_key" character varying(255) NOT NULL DEFAULT '', ADD COLUMN "ai_audio_key" character varying(255) NOT NULL DEFAULT '';
+-- Create "user_settings" table
+CREATE TABLE "user_settings" (
+  "user_settings_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "user_id" uuid NOT NULL,
+  "audio_opt_out" boolean NOT NULL DEFAULT false,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  PRIMARY KEY ("user_settings_id"),
+  CONSTRAINT "fk_user_settings_user" FOREIGN KEY ("user_id") REFERENCES "users" ("users_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_user_settings_user_id" to table: "user_settings"
+CREATE UNIQUE INDEX "idx_user_settings_user_id" ON "user_settings" ("user_id");
@@ -0,0 +1,4 @@
+-- Modify "conversation_turns" table
+ALTER TABLE "conversation_turns" ADD COLUMN "search_text" text NOT NULL DEFAULT '';
+-- Create index "idx_conversation_turns_search_text" to table: "conversation_turns"
+CREATE INDEX "idx_conversation_turns_search_text" ON "conversation_turns" USING GIN ((array_to_tsvector(string_to_array(search_text, ' '::text))));
@@ -0,0 +1,2 @@
+-- Modify "conversation_turns" table
+ALTER TABLE "conversation_turns" ADD COLUMN "ai_started_at" timestamptz NULL, ADD COLUMN "ai_ended_at" timestamptz NULL;
@@ -0,0 +1,14 @@
+-- Create "user_memories" table
+CREATE TABLE "user_memories" (
+  "user_memories_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "user_id" uuid NOT NULL,
+  "content" text NOT NULL,
+  "conversation_id" uuid NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  PRIMARY KEY ("user_memories_id"),
+  CONSTRAINT "fk_user_memories_conversation" FOREIGN KEY ("conversation_id") REFERENCES "conversations" ("conversations_id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "fk_user_memories_user" FOREIGN KEY ("user_id") REFERENCES "users" ("users_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_user_memories_user_id_updated_at" to table: "user_memories"
+CREATE INDEX "idx_user_memories_user_id_updated_at" ON "user_memories" ("user_id", "updated_at");
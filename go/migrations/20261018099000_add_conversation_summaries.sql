@@ -0,0 +1,19 @@
+-- Create "conversation_summaries" table
+CREATE TABLE "conversation_summaries" (
+  "conversation_summaries_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "conversation_id" uuid NOT NULL,
+  "status" character varying(30) NOT NULL,
+  "attempts" bigint NOT NULL DEFAULT 0,
+  "requested_at" timestamptz NOT NULL,
+  "next_attempt_at" timestamptz NOT NULL,
+  "last_error" text NOT NULL DEFAULT '',
+  "content" text NOT NULL DEFAULT '{}',
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  PRIMARY KEY ("conversation_summaries_id"),
+  CONSTRAINT "fk_conversation_summaries_conversation" FOREIGN KEY ("conversation_id") REFERENCES "conversations" ("conversations_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_conversation_summaries_conversation_id" to table: "conversation_summaries"
+CREATE UNIQUE INDEX "idx_conversation_summaries_conversation_id" ON "conversation_summaries" ("conversation_id");
+-- Create index "idx_conversation_summaries_status_next_attempt_at" to table: "conversation_summaries"
+CREATE INDEX "idx_conversation_summaries_status_next_attempt_at" ON "conversation_summaries" ("status", "next_attempt_at");
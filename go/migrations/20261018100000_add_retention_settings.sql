@@ -0,0 +1,2 @@
+-- Modify "user_settings" table
+ALTER TABLE "user_settings" ADD COLUMN "audio_retention_days" bigint NOT NULL DEFAULT 0, ADD COLUMN "transcript_retention_days" bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+-- Modify "user_settings" table
+ALTER TABLE "user_settings" ADD COLUMN "privacy_mode" boolean NOT NULL DEFAULT false;
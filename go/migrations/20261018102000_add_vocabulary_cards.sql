@@ -0,0 +1,22 @@
+-- Create "vocabulary_cards" table
+CREATE TABLE "vocabulary_cards" (
+  "vocabulary_cards_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "user_id" uuid NOT NULL,
+  "term" text NOT NULL,
+  "reading" text NOT NULL DEFAULT '',
+  "translation" text NOT NULL DEFAULT '',
+  "example" text NOT NULL DEFAULT '',
+  "source_turn_id" uuid NULL,
+  "ease_factor" numeric NOT NULL DEFAULT 2.5,
+  "interval_days" bigint NOT NULL DEFAULT 0,
+  "repetitions" bigint NOT NULL DEFAULT 0,
+  "due_at" timestamptz NOT NULL,
+  "last_reviewed_at" timestamptz NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  PRIMARY KEY ("vocabulary_cards_id"),
+  CONSTRAINT "fk_vocabulary_cards_source_turn" FOREIGN KEY ("source_turn_id") REFERENCES "conversation_turns" ("conversation_turns_id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "fk_vocabulary_cards_user" FOREIGN KEY ("user_id") REFERENCES "users" ("users_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_vocabulary_cards_user_id_due_at" to table: "vocabulary_cards"
+CREATE INDEX "idx_vocabulary_cards_user_id_due_at" ON "vocabulary_cards" ("user_id", "due_at");
@@ -0,0 +1,15 @@
+-- Create "turn_feedbacks" table
+CREATE TABLE "turn_feedbacks" (
+  "turn_feedbacks_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "conversation_turn_id" uuid NOT NULL,
+  "position" bigint NOT NULL,
+  "category" character varying(40) NOT NULL,
+  "original" text NOT NULL DEFAULT '',
+  "correction" text NOT NULL DEFAULT '',
+  "explanation" text NOT NULL DEFAULT '',
+  "created_at" timestamptz NULL,
+  PRIMARY KEY ("turn_feedbacks_id"),
+  CONSTRAINT "fk_turn_feedbacks_conversation_turn" FOREIGN KEY ("conversation_turn_id") REFERENCES "conversation_turns" ("conversation_turns_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_turn_feedbacks_conversation_turn_id_position" to table: "turn_feedbacks"
+CREATE UNIQUE INDEX "idx_turn_feedbacks_conversation_turn_id_position" ON "turn_feedbacks" ("conversation_turn_id", "position");
@@ -0,0 +1,2 @@
+-- Modify "turn_feedbacks" table
+ALTER TABLE "turn_feedbacks" ADD COLUMN "pattern" text NOT NULL DEFAULT '';
@@ -0,0 +1,21 @@
+-- Modify "user_settings" table
+ALTER TABLE "user_settings" ADD COLUMN "time_zone" varchar(64) NOT NULL DEFAULT '';
+-- Create "practice_sessions" table
+CREATE TABLE "practice_sessions" (
+  "practice_sessions_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "user_id" uuid NOT NULL,
+  "conversation_id" uuid NULL,
+  "language" varchar(10) NOT NULL,
+  "character" varchar(50) NOT NULL,
+  "started_at" timestamptz NOT NULL,
+  "ended_at" timestamptz NOT NULL,
+  "user_audio_ms" bigint NOT NULL DEFAULT 0,
+  "ai_audio_ms" bigint NOT NULL DEFAULT 0,
+  "turns" bigint NOT NULL DEFAULT 0,
+  "created_at" timestamptz NULL,
+  PRIMARY KEY ("practice_sessions_id"),
+  CONSTRAINT "fk_practice_sessions_conversation" FOREIGN KEY ("conversation_id") REFERENCES "conversations" ("conversations_id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "fk_practice_sessions_user" FOREIGN KEY ("user_id") REFERENCES "users" ("users_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_practice_sessions_user_id_started_at" to table: "practice_sessions"
+CREATE INDEX "idx_practice_sessions_user_id_started_at" ON "practice_sessions" ("user_id", "started_at");
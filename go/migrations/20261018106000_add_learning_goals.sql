@@ -0,0 +1,34 @@
+-- Create "learning_goals" table
+CREATE TABLE "learning_goals" (
+  "learning_goals_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "user_id" uuid NOT NULL,
+  "metric" varchar(30) NOT NULL,
+  "target" bigint NOT NULL,
+  "reminder_enabled" boolean NOT NULL DEFAULT false,
+  "reminder_minute" bigint NOT NULL DEFAULT 0,
+  "reminder_channel" varchar(40) NOT NULL DEFAULT 'NOTIFICATION_CHANNEL_IN_APP',
+  "next_reminder_at" timestamptz NULL,
+  "last_reminded_at" timestamptz NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  PRIMARY KEY ("learning_goals_id"),
+  CONSTRAINT "fk_learning_goals_user" FOREIGN KEY ("user_id") REFERENCES "users" ("users_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_learning_goals_next_reminder_at" to table: "learning_goals"
+CREATE INDEX "idx_learning_goals_next_reminder_at" ON "learning_goals" ("next_reminder_at");
+-- Create index "idx_learning_goals_user_id" to table: "learning_goals"
+CREATE UNIQUE INDEX "idx_learning_goals_user_id" ON "learning_goals" ("user_id");
+-- Create "notifications" table
+CREATE TABLE "notifications" (
+  "notifications_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "user_id" uuid NOT NULL,
+  "kind" varchar(50) NOT NULL,
+  "title" text NOT NULL,
+  "body" text NOT NULL DEFAULT '',
+  "read_at" timestamptz NULL,
+  "created_at" timestamptz NULL,
+  PRIMARY KEY ("notifications_id"),
+  CONSTRAINT "fk_notifications_user" FOREIGN KEY ("user_id") REFERENCES "users" ("users_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_notifications_user_id_created_at" to table: "notifications"
+CREATE INDEX "idx_notifications_user_id_created_at" ON "notifications" ("user_id", "created_at");
@@ -0,0 +1,51 @@
+-- Create "scenarios" table
+CREATE TABLE "scenarios" (
+  "scenarios_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "slug" varchar(100) NOT NULL,
+  "difficulty" varchar(40) NOT NULL,
+  "position" bigint NOT NULL DEFAULT 0,
+  "active" boolean NOT NULL DEFAULT true,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  PRIMARY KEY ("scenarios_id")
+);
+-- Create index "idx_scenarios_slug" to table: "scenarios"
+CREATE UNIQUE INDEX "idx_scenarios_slug" ON "scenarios" ("slug");
+-- Modify "conversations" table
+ALTER TABLE "conversations" ADD COLUMN "scenario_id" uuid NULL, ADD CONSTRAINT "fk_conversations_scenario" FOREIGN KEY ("scenario_id") REFERENCES "scenarios" ("scenarios_id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create "scenario_completions" table
+CREATE TABLE "scenario_completions" (
+  "scenario_completions_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "user_id" uuid NOT NULL,
+  "scenario_id" uuid NOT NULL,
+  "conversation_id" uuid NULL,
+  "language" varchar(10) NOT NULL,
+  "goals_achieved" bigint NOT NULL DEFAULT 0,
+  "goals_total" bigint NOT NULL DEFAULT 0,
+  "achieved_goals" text NOT NULL DEFAULT '[]',
+  "completed_at" timestamptz NOT NULL,
+  "created_at" timestamptz NULL,
+  PRIMARY KEY ("scenario_completions_id"),
+  CONSTRAINT "fk_scenario_completions_conversation" FOREIGN KEY ("conversation_id") REFERENCES "conversations" ("conversations_id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "fk_scenario_completions_scenario" FOREIGN KEY ("scenario_id") REFERENCES "scenarios" ("scenarios_id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "fk_scenario_completions_user" FOREIGN KEY ("user_id") REFERENCES "users" ("users_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_scenario_completions_user_id_scenario_id" to table: "scenario_completions"
+CREATE INDEX "idx_scenario_completions_user_id_scenario_id" ON "scenario_completions" ("user_id", "scenario_id");
+-- Create "scenario_localizations" table
+CREATE TABLE "scenario_localizations" (
+  "scenario_localizations_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "scenario_id" uuid NOT NULL,
+  "language" varchar(10) NOT NULL,
+  "title" varchar(200) NOT NULL,
+  "description" text NOT NULL DEFAULT '',
+  "goals" text NOT NULL DEFAULT '[]',
+  "vocabulary" text NOT NULL DEFAULT '[]',
+  "setup_prompt" text NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  PRIMARY KEY ("scenario_localizations_id"),
+  CONSTRAINT "fk_scenario_localizations_scenario" FOREIGN KEY ("scenario_id") REFERENCES "scenarios" ("scenarios_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_scenario_localizations_scenario_id_language" to table: "scenario_localizations"
+CREATE UNIQUE INDEX "idx_scenario_localizations_scenario_id_language" ON "scenario_localizations" ("scenario_id", "language");
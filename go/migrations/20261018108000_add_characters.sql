@@ -0,0 +1,29 @@
+-- Create "characters" table
+CREATE TABLE "characters" (
+  "characters_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "key" varchar(50) NOT NULL,
+  "persona" text NOT NULL,
+  "gender" varchar(40) NOT NULL,
+  "age_band" varchar(40) NOT NULL,
+  "min_plan" varchar(50) NOT NULL DEFAULT 'PLAN_FREE',
+  "voices" text NOT NULL DEFAULT '{}',
+  "display_names" text NOT NULL DEFAULT '{}',
+  "position" bigint NOT NULL DEFAULT 0,
+  "active" boolean NOT NULL DEFAULT true,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  PRIMARY KEY ("characters_id")
+);
+-- Create index "idx_characters_key" to table: "characters"
+CREATE UNIQUE INDEX "idx_characters_key" ON "characters" ("key");
+-- Seed the characters that used to be hardcoded in the AI service
+INSERT INTO "characters" ("key", "persona", "gender", "age_band", "min_plan", "voices", "display_names", "position", "created_at", "updated_at") VALUES
+('friend', 'You are Juan, a friendly and casual AI companion.
+You are helpful, witty, and engaging. You speak naturally with a friendly tone.
+Keep your responses concise and conversational.', 'CHARACTER_GENDER_MALE', 'CHARACTER_AGE_BAND_YOUNG_ADULT', 'PLAN_FREE', '{"en":"Puck","ja":"Puck","vi":"Puck"}', '{"en":"Juan","ja":"フアン","vi":"Juan"}', 0, now(), now()),
+('parent', 'You are a caring parent figure.
+You are supportive, wise, and patient. You give good advice and care about the user''s well-being.
+Speak with a warm and nurturing tone.', 'CHARACTER_GENDER_FEMALE', 'CHARACTER_AGE_BAND_ADULT', 'PLAN_FREE', '{"en":"Aoede","ja":"Aoede","vi":"Aoede"}', '{"en":"Mother","ja":"お母さん","vi":"Mẹ"}', 1, now(), now()),
+('sister', 'You are a playful younger sister.
+You are energetic, sometimes teasing, but affectionate. You like to share stories and ask questions.
+Speak with a lively and youthful tone.', 'CHARACTER_GENDER_FEMALE', 'CHARACTER_AGE_BAND_TEEN', 'PLAN_FREE', '{"en":"Fenrir","ja":"Fenrir","vi":"Fenrir"}', '{"en":"Sister","ja":"妹","vi":"Em gái"}', 2, now(), now());
@@ -0,0 +1,19 @@
+-- Create "custom_characters" table
+CREATE TABLE "custom_characters" (
+  "custom_characters_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "user_id" uuid NOT NULL,
+  "name" varchar(50) NOT NULL,
+  "personality" text NOT NULL,
+  "speaking_style" text NOT NULL DEFAULT '',
+  "formality" varchar(40) NOT NULL,
+  "voice" varchar(50) NOT NULL,
+  "share_token" varchar(64) NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  PRIMARY KEY ("custom_characters_id"),
+  CONSTRAINT "fk_custom_characters_user" FOREIGN KEY ("user_id") REFERENCES "users" ("users_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_custom_characters_share_token" to table: "custom_characters"
+CREATE UNIQUE INDEX "idx_custom_characters_share_token" ON "custom_characters" ("share_token");
+-- Create index "idx_custom_characters_user_id" to table: "custom_characters"
+CREATE INDEX "idx_custom_characters_user_id" ON "custom_characters" ("user_id");
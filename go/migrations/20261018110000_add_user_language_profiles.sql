@@ -0,0 +1,15 @@
+-- Create "user_language_profiles" table
+CREATE TABLE "user_language_profiles" (
+  "user_language_profiles_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "user_id" uuid NOT NULL,
+  "language" varchar(10) NOT NULL,
+  "level" varchar(20) NOT NULL,
+  "placement_score" numeric NOT NULL DEFAULT 0,
+  "placed_at" timestamptz NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  PRIMARY KEY ("user_language_profiles_id"),
+  CONSTRAINT "fk_user_language_profiles_user" FOREIGN KEY ("user_id") REFERENCES "users" ("users_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_user_language_profiles_user_id_language" to table: "user_language_profiles"
+CREATE UNIQUE INDEX "idx_user_language_profiles_user_id_language" ON "user_language_profiles" ("user_id", "language");
@@ -0,0 +1,28 @@
+-- Modify "practice_sessions" table
+ALTER TABLE "practice_sessions" ADD COLUMN "analysis_due_at" timestamptz NULL;
+-- Create index "idx_practice_sessions_analysis_due_at" to table: "practice_sessions"
+CREATE INDEX "idx_practice_sessions_analysis_due_at" ON "practice_sessions" ("analysis_due_at");
+-- Modify "user_language_profiles" table
+ALTER TABLE "user_language_profiles" ADD COLUMN "estimate" numeric NOT NULL DEFAULT 0, ADD COLUMN "words_per_utterance" numeric NOT NULL DEFAULT 0, ADD COLUMN "lexical_diversity" numeric NOT NULL DEFAULT 0, ADD COLUMN "error_rate" numeric NOT NULL DEFAULT 0, ADD COLUMN "translation_rate" numeric NOT NULL DEFAULT 0, ADD COLUMN "assessed_sessions" bigint NOT NULL DEFAULT 0, ADD COLUMN "assessed_at" timestamptz NULL;
+-- Create "level_changes" table
+CREATE TABLE "level_changes" (
+  "level_changes_id" uuid NOT NULL DEFAULT gen_random_uuid(),
+  "user_id" uuid NOT NULL,
+  "language" varchar(10) NOT NULL,
+  "source" varchar(40) NOT NULL,
+  "previous_level" varchar(20) NOT NULL DEFAULT '',
+  "level" varchar(20) NOT NULL,
+  "estimate" numeric NOT NULL DEFAULT 0,
+  "words_per_utterance" numeric NOT NULL DEFAULT 0,
+  "lexical_diversity" numeric NOT NULL DEFAULT 0,
+  "error_rate" numeric NOT NULL DEFAULT 0,
+  "translation_rate" numeric NOT NULL DEFAULT 0,
+  "placement_score" numeric NOT NULL DEFAULT 0,
+  "practice_session_id" uuid NULL,
+  "created_at" timestamptz NULL,
+  PRIMARY KEY ("level_changes_id"),
+  CONSTRAINT "fk_level_changes_practice_session" FOREIGN KEY ("practice_session_id") REFERENCES "practice_sessions" ("practice_sessions_id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "fk_level_changes_user" FOREIGN KEY ("user_id") REFERENCES "users" ("users_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_level_changes_user_id_created_at" to table: "level_changes"
+CREATE INDEX "idx_level_changes_user_id_created_at" ON "level_changes" ("user_id", "created_at");
@@ -7,11 +7,37 @@ import { AudioPlayer } from './player'
 
 type Language = 'en' | 'ja' | 'vi'
 
+// A correction of the learner's speech sent by the server as a feedback event
+export interface Feedback {
+  category: 'grammar' | 'vocabulary' | 'pronunciation' | 'unspecified'
+  original: string
+  correction: string
+  explanation: string
+}
+
+// A goal of the role-play scenario the learner accomplished, sent by the server as a goal_achieved event
+export interface GoalAchieved {
+  index: number
+  goal: string
+  achieved: number
+  total: number
+}
+
+// The level estimated by a placement test, sent by the server as a placement_result event
+export interface PlacementResult {
+  level: string // A1 to C2
+  score: number // 0 to 100
+  answers: number
+}
+
 interface UseWebSocketChatProps {
   username: string
   language: Language
   character: string
   onMessageReceived?: (message: unknown) => void
+  onFeedbackReceived?: (feedback: Feedback) => void
+  onGoalAchieved?: (goal: GoalAchieved) => void
+  onPlacementResult?: (result: PlacementResult) => void
 }
 
 export const useWebSocketChat = ({
@@ -19,12 +45,16 @@ export const useWebSocketChat = ({
   language,
   character,
   onMessageReceived,
+  onFeedbackReceived,
+  onGoalAchieved,
+  onPlacementResult,
 }: UseWebSocketChatProps) => {
   const [isConnected, setIsConnected] = useState(false)
   const [status, setStatus] = useState<
     'idle' | 'listening' | 'processing' | 'speaking'
   >('idle')
   const [error, setError] = useState<string | null>(null)
+  const [privacyMode, setPrivacyMode] = useState(false)
 
   const socketRef = useRef<WebSocket | null>(null)
   const recorderRef = useRef<AudioRecorder | null>(null)
@@ -81,6 +111,24 @@ export const useWebSocketChat = ({
 
     socket.onmessage = async (event) => {
       if (typeof event.data === 'string') {
+        // The server acknowledges the setup with a session_started event before any AI response
+        if (event.data.startsWith('{"type":"session_started"')) {
+          const session = JSON.parse(event.data)
+          setPrivacyMode(session.privacy_mode === true)
+          return
+        }
+        if (event.data.startsWith('{"type":"feedback"')) {
+          onFeedbackReceived?.(JSON.parse(event.data) as Feedback)
+          return
+        }
+        if (event.data.startsWith('{"type":"goal_achieved"')) {
+          onGoalAchieved?.(JSON.parse(event.data) as GoalAchieved)
+          return
+        }
+        if (event.data.startsWith('{"type":"placement_result"')) {
+          onPlacementResult?.(JSON.parse(event.data) as PlacementResult)
+          return
+        }
         onMessageReceived?.(event.data)
       } else if (event.data instanceof ArrayBuffer) {
         const uint8Array = new Uint8Array(event.data)
@@ -96,7 +144,7 @@ export const useWebSocketChat = ({
         }
       }
     }
-  }, [onMessageReceived])
+  }, [onMessageReceived, onFeedbackReceived, onGoalAchieved, onPlacementResult])
 
   const disconnect = useCallback(() => {
     if (socketRef.current) {
@@ -224,6 +272,7 @@ export const useWebSocketChat = ({
     isConnected,
     status,
     error,
+    privacyMode,
     connect,
     disconnect,
     startStreaming,
@@ -1,29 +1,66 @@
 /**
  * Hook for managing WebSocket-based conversation
  */
-import { useTranslations } from "next-intl";
+import { useFormatter, useLocale, useTranslations } from "next-intl";
 import { useCallback, useEffect, useRef, useState } from "react";
 import { AudioRecorder } from "../audio/recorder";
 import { AudioPlayer } from "../audio/player";
 import { Language } from "@/types/types";
+import { authAPI } from "../api/auth";
+
+// A correction of the learner's speech sent by the server as a feedback event
+export interface Feedback {
+  category: "grammar" | "vocabulary" | "pronunciation" | "unspecified";
+  original: string;
+  correction: string;
+  explanation: string;
+}
+
+// A goal of the role-play scenario the learner accomplished, sent by the server as a goal_achieved event
+export interface GoalAchieved {
+  index: number;
+  goal: string;
+  achieved: number;
+  total: number;
+}
+
+// The level estimated by a placement test, sent by the server as a placement_result event
+export interface PlacementResult {
+  level: string; // A1 to C2
+  score: number; // 0 to 100
+  answers: number;
+}
 
 interface UseWebSocketChatProps {
   username: string;
   language: Language;
   character: string;
+  scenarioId?: string; // Plays a scenario listed by ScenarioService.ListScenarios for the language
+  placement?: boolean; // Takes the placement test of the language instead of a free conversation
   onMessageReceived?: (message: unknown) => void;
+  onFeedbackReceived?: (feedback: Feedback) => void;
+  onGoalAchieved?: (goal: GoalAchieved) => void;
+  onPlacementResult?: (result: PlacementResult) => void;
 }
 
 export const useWebSocketChat = ({
   username,
   language,
   character,
-  onMessageReceived
+  scenarioId,
+  placement,
+  onMessageReceived,
+  onFeedbackReceived,
+  onGoalAchieved,
+  onPlacementResult
 }: UseWebSocketChatProps) => {
   const t = useTranslations('common');
+  const locale = useLocale();
+  const format = useFormatter();
   const [isConnected, setIsConnected] = useState(false);
   const [isStreaming, setIsStreaming] = useState(false);
   const [error, setError] = useState<string | null>(null);
+  const [privacyMode, setPrivacyMode] = useState(false);
 
   const socketRef = useRef<WebSocket | null>(null);
   const recorderRef = useRef<AudioRecorder | null>(null);
@@ -48,8 +85,22 @@ export const useWebSocketChat = ({
     if (socketRef.current?.readyState === WebSocket.OPEN) return;
 
     // Use specific endpoint for chat
-    const wsUrl = "ws://localhost:8000/ws/chat"; 
-    console.log("Connecting to WebSocket:", wsUrl);
+    // Browsers cannot set headers on a WebSocket handshake, so the token goes in the query
+    const params = new URLSearchParams({
+      token: authAPI.getAccessToken() ?? "",
+      language,
+      character,
+      // Corrections are explained in the language of the UI
+      native_language: locale,
+    });
+    if (scenarioId) {
+      params.set("scenario_id", scenarioId);
+    }
+    if (placement) {
+      params.set("mode", "placement");
+    }
+    const wsUrl = `ws://localhost:8000/ws/chat?${params.toString()}`;
+    console.log("Connecting to WebSocket: ws://localhost:8000/ws/chat");
     
     const socket = new WebSocket(wsUrl);
     socketRef.current = socket;
@@ -76,6 +127,32 @@ export const useWebSocketChat = ({
     socket.onmessage = async (event) => {
       if (typeof event.data === 'string') {
           console.log("Received text message:", event.data);
+          // The server acknowledges the setup with a session_started event before any AI response
+          if (event.data.startsWith('{"type":"session_started"')) {
+            const session = JSON.parse(event.data);
+            setPrivacyMode(session.privacy_mode === true);
+            return;
+          }
+          if (event.data.startsWith('{"type":"feedback"')) {
+            onFeedbackReceived?.(JSON.parse(event.data) as Feedback);
+            return;
+          }
+          if (event.data.startsWith('{"type":"goal_achieved"')) {
+            onGoalAchieved?.(JSON.parse(event.data) as GoalAchieved);
+            return;
+          }
+          if (event.data.startsWith('{"type":"placement_result"')) {
+            onPlacementResult?.(JSON.parse(event.data) as PlacementResult);
+            return;
+          }
+          // The server sends a quota_exceeded event right before closing the session
+          if (event.data.startsWith('{"type":"quota_exceeded"')) {
+            const quota = JSON.parse(event.data);
+            const resetsAt = format.dateTime(new Date(quota.resets_at), { dateStyle: "medium", timeStyle: "short" });
+            setError(t(quota.period === "monthly" ? 'errors.quotaExceededMonthly' : 'errors.quotaExceededDaily', { resetsAt }));
+            setIsStreaming(false);
+            return;
+          }
           onMessageReceived?.(event.data);
       } else if (event.data instanceof Blob) {
           console.log("Received audio blob:", event.data.size);
@@ -92,7 +169,7 @@ export const useWebSocketChat = ({
       }
     };
 
-  }, [onMessageReceived]);
+  }, [language, character, scenarioId, placement, locale, t, format, onMessageReceived, onFeedbackReceived, onGoalAchieved, onPlacementResult]);
 
   const disconnect = useCallback(() => {
      if (socketRef.current) {
@@ -165,6 +242,7 @@ export const useWebSocketChat = ({
     isConnected,
     isStreaming,
     error, // Expose error state
+    privacyMode, // Whether the server records nothing of this session
     connect, // Expose connect method
     disconnect,
     startStreaming,
@@ -26,7 +26,7 @@ from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__
 from ai import user_pb2 as ai_dot_user__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x18\x61i/ai_conversation.proto\x12\x05\x61i.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\rai/user.proto\"\xb6\x01\n\x0b\x43hatRequest\x12\x30\n\x05setup\x18\x01 \x01(\x0b\x32\x18.ai.v1.ChatConfigurationH\x00R\x05setup\x12!\n\x0b\x61udio_chunk\x18\x02 \x01(\x0cH\x00R\naudioChunk\x12#\n\x0ctext_message\x18\x03 \x01(\tH\x00R\x0btextMessage\x12\"\n\x0c\x65nd_of_input\x18\x04 \x01(\x08H\x00R\nendOfInputB\t\n\x07\x63ontent\"\xa3\x01\n\x11\x43hatConfiguration\x12\x17\n\x07user_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n\x08username\x18\x02 \x01(\tR\x08username\x12\x1a\n\x08language\x18\x03 \x01(\tR\x08language\x12\x1c\n\tcharacter\x18\x04 \x01(\tR\tcharacter\x12\x1f\n\x04plan\x18\x05 \x01(\x0e\x32\x0b.ai.v1.PlanR\x04plan\"\xd8\x01\n\x0c\x43hatResponse\x12\x1f\n\x0bresponse_id\x18\x01 \x01(\tR\nresponseId\x12!\n\x0b\x61udio_chunk\x18\x02 \x01(\x0cH\x00R\naudioChunk\x12#\n\x0ctext_message\x18\x03 \x01(\tH\x00R\x0btextMessage\x12\x1a\n\x08language\x18\x04 \x01(\tR\x08language\x12\x38\n\ttimestamp\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestampB\t\n\x07\x63ontentB\x80\x01\n\tcom.ai.v1B\x13\x41iConversationProtoP\x01Z)github.com/hiroky1983/talk/go/gen/ai;aiv1\xa2\x02\x03\x41XX\xaa\x02\x05\x41i.V1\xca\x02\x05\x41i\\V1\xe2\x02\x11\x41i\\V1\\GPBMetadata\xea\x02\x06\x41i::V1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x18\x61i/ai_conversation.proto\x12\x05\x61i.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\rai/user.proto\"\xb6\x01\n\x0b\x43hatRequest\x12\x30\n\x05setup\x18\x01 \x01(\x0b\x32\x18.ai.v1.ChatConfigurationH\x00R\x05setup\x12!\n\x0b\x61udio_chunk\x18\x02 \x01(\x0cH\x00R\naudioChunk\x12#\n\x0ctext_message\x18\x03 \x01(\tH\x00R\x0btextMessage\x12\"\n\x0c\x65nd_of_input\x18\x04 \x01(\x08H\x00R\nendOfInputB\t\n\x07\x63ontent\"\xb7\x04\n\x11\x43hatConfiguration\x12\x17\n\x07user_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n\x08username\x18\x02 \x01(\tR\x08username\x12\x1a\n\x08language\x18\x03 \x01(\tR\x08language\x12\x1c\n\tcharacter\x18\x04 \x01(\tR\tcharacter\x12\x1f\n\x04plan\x18\x05 \x01(\x0e\x32\x0b.ai.v1.PlanR\x04plan\x12,\n\x07history\x18\x06 \x03(\x0b\x32\x12.ai.v1.HistoryTurnR\x07history\x12\x1a\n\x08memories\x18\x07 \x03(\tR\x08memories\x12!\n\x0cprivacy_mode\x18\x08 \x01(\x08R\x0bprivacyMode\x12\'\n\x0fnative_language\x18\t \x01(\tR\x0enativeLanguage\x12+\n\x08scenario\x18\n \x01(\x0b\x32\x0f.ai.v1.ScenarioR\x08scenario\x12\x43\n\x14\x63haracter_definition\x18\x0b \x01(\x0b\x32\x10.ai.v1.CharacterR\x13\x63haracterDefinition\x12%\n\x0eplacement_test\x18\x0c \x01(\x08R\rplacementTest\x12\x43\n\x11placement_prompts\x18\r \x03(\x0b\x32\x16.ai.v1.PlacementPromptR\x10placementPrompts\x12\x1e\n\ndifficulty\x18\x0e \x01(\tR\ndifficulty\"X\n\x0fPlacementPrompt\x12\x1b\n\tprompt_id\x18\x01 \x01(\tR\x08promptId\x12\x14\n\x05level\x18\x02 \x01(\tR\x05level\x12\x12\n\x04text\x18\x03 \x01(\tR\x04text\"\xaa\x01\n\x0ePlacementScore\x12\x1b\n\tprompt_id\x18\x01 \x01(\tR\x08promptId\x12\x18\n\x07grammar\x18\x02 \x01(\x05R\x07grammar\x12\x1e\n\nvocabulary\x18\x03 \x01(\x05R\nvocabulary\x12\x18\n\x07\x66luency\x18\x04 \x01(\x05R\x07\x66luency\x12\'\n\x0ftask_completion\x18\x05 \x01(\x05R\x0etaskCompletion\"\xa3\x01\n\tCharacter\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12!\n\x0c\x64isplay_name\x18\x02 \x01(\tR\x0b\x64isplayName\x12\x18\n\x07persona\x18\x03 \x01(\tR\x07persona\x12\x14\n\x05voice\x18\x04 \x01(\tR\x05voice\x12\x16\n\x06gender\x18\x05 \x01(\tR\x06gender\x12\x19\n\x08\x61ge_band\x18\x06 \x01(\tR\x07\x61geBand\"\xdc\x01\n\x08Scenario\x12\x1f\n\x0bscenario_id\x18\x01 \x01(\tR\nscenarioId\x12\x14\n\x05title\x18\x02 \x01(\tR\x05title\x12 \n\x0b\x64\x65scription\x18\x03 \x01(\tR\x0b\x64\x65scription\x12\x1e\n\ndifficulty\x18\x04 \x01(\tR\ndifficulty\x12\x14\n\x05goals\x18\x05 \x03(\tR\x05goals\x12\x1e\n\nvocabulary\x18\x06 \x03(\tR\nvocabulary\x12!\n\x0csetup_prompt\x18\x07 \x01(\tR\x0bsetupPrompt\"$\n\x0cScenarioGoal\x12\x14\n\x05index\x18\x01 \x01(\x05R\x05index\"O\n\x0bHistoryTurn\x12\'\n\x0fuser_transcript\x18\x01 \x01(\tR\x0euserTranscript\x12\x17\n\x07\x61i_text\x18\x02 \x01(\tR\x06\x61iText\"\xca\x03\n\x0c\x43hatResponse\x12\x1f\n\x0bresponse_id\x18\x01 \x01(\tR\nresponseId\x12!\n\x0b\x61udio_chunk\x18\x02 \x01(\x0cH\x00R\naudioChunk\x12#\n\x0ctext_message\x18\x03 \x01(\tH\x00R\x0btextMessage\x12)\n\x0fuser_transcript\x18\x06 \x01(\tH\x00R\x0euserTranscript\x12\x18\n\x06memory\x18\x07 \x01(\tH\x00R\x06memory\x12-\n\x08\x66\x65\x65\x64\x62\x61\x63k\x18\x08 \x01(\x0b\x32\x0f.ai.v1.FeedbackH\x00R\x08\x66\x65\x65\x64\x62\x61\x63k\x12:\n\rgoal_achieved\x18\t \x01(\x0b\x32\x13.ai.v1.ScenarioGoalH\x00R\x0cgoalAchieved\x12@\n\x0fplacement_score\x18\n \x01(\x0b\x32\x15.ai.v1.PlacementScoreH\x00R\x0eplacementScore\x12\x1a\n\x08language\x18\x04 \x01(\tR\x08language\x12\x38\n\ttimestamp\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestampB\t\n\x07\x63ontent\"\x9d\x01\n\x08\x46\x65\x65\x64\x62\x61\x63k\x12\x1a\n\x08original\x18\x01 \x01(\tR\x08original\x12\x1e\n\ncorrection\x18\x02 \x01(\tR\ncorrection\x12\x33\n\x08\x63\x61tegory\x18\x03 \x01(\x0e\x32\x17.ai.v1.FeedbackCategoryR\x08\x63\x61tegory\x12 \n\x0b\x65xplanation\x18\x04 \x01(\tR\x0b\x65xplanation\"\x9f\x01\n\x10SummarizeRequest\x12\'\n\x0f\x63onversation_id\x18\x01 \x01(\tR\x0e\x63onversationId\x12\x1a\n\x08language\x18\x02 \x01(\tR\x08language\x12\x1c\n\tcharacter\x18\x03 \x01(\tR\tcharacter\x12(\n\x05turns\x18\x04 \x03(\x0b\x32\x12.ai.v1.HistoryTurnR\x05turns\">\n\x0eVocabularyItem\x12\x12\n\x04term\x18\x01 \x01(\tR\x04term\x12\x18\n\x07meaning\x18\x02 \x01(\tR\x07meaning\"g\n\x07Mistake\x12\x1a\n\x08original\x18\x01 \x01(\tR\x08original\x12\x1e\n\ncorrection\x18\x02 \x01(\tR\ncorrection\x12 \n\x0b\x65xplanation\x18\x03 \x01(\tR\x0b\x65xplanation\"\x8e\x01\n\x11SummarizeResponse\x12\x16\n\x06topics\x18\x01 \x03(\tR\x06topics\x12\x35\n\nvocabulary\x18\x02 \x03(\x0b\x32\x15.ai.v1.VocabularyItemR\nvocabulary\x12*\n\x08mistakes\x18\x03 \x03(\x0b\x32\x0e.ai.v1.MistakeR\x08mistakes*\x9b\x01\n\x10\x46\x65\x65\x64\x62\x61\x63kCategory\x12!\n\x1d\x46\x45\x45\x44\x42\x41\x43K_CATEGORY_UNSPECIFIED\x10\x00\x12\x1d\n\x19\x46\x45\x45\x44\x42\x41\x43K_CATEGORY_GRAMMAR\x10\x01\x12 \n\x1c\x46\x45\x45\x44\x42\x41\x43K_CATEGORY_VOCABULARY\x10\x02\x12#\n\x1f\x46\x45\x45\x44\x42\x41\x43K_CATEGORY_PRONUNCIATION\x10\x03\x42\x80\x01\n\tcom.ai.v1B\x13\x41iConversationProtoP\x01Z)github.com/hiroky1983/talk/go/gen/ai;aiv1\xa2\x02\x03\x41XX\xaa\x02\x05\x41i.V1\xca\x02\x05\x41i\\V1\xe2\x02\x11\x41i\\V1\\GPBMetadata\xea\x02\x06\x41i::V1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -34,10 +34,34 @@ _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'ai.ai_conversation_pb2', _g
 if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._loaded_options = None
   _globals['DESCRIPTOR']._serialized_options = b'\n\tcom.ai.v1B\023AiConversationProtoP\001Z)github.com/hiroky1983/talk/go/gen/ai;aiv1\242\002\003AXX\252\002\005Ai.V1\312\002\005Ai\\V1\342\002\021Ai\\V1\\GPBMetadata\352\002\006Ai::V1'
+  _globals['_FEEDBACKCATEGORY']._serialized_start=2707
+  _globals['_FEEDBACKCATEGORY']._serialized_end=2862
   _globals['_CHATREQUEST']._serialized_start=84
   _globals['_CHATREQUEST']._serialized_end=266
   _globals['_CHATCONFIGURATION']._serialized_start=269
-  _globals['_CHATCONFIGURATION']._serialized_end=432
-  _globals['_CHATRESPONSE']._serialized_start=435
-  _globals['_CHATRESPONSE']._serialized_end=651
+  _globals['_CHATCONFIGURATION']._serialized_end=836
+  _globals['_PLACEMENTPROMPT']._serialized_start=838
+  _globals['_PLACEMENTPROMPT']._serialized_end=926
+  _globals['_PLACEMENTSCORE']._serialized_start=929
+  _globals['_PLACEMENTSCORE']._serialized_end=1099
+  _globals['_CHARACTER']._serialized_start=1102
+  _globals['_CHARACTER']._serialized_end=1265
+  _globals['_SCENARIO']._serialized_start=1268
+  _globals['_SCENARIO']._serialized_end=1488
+  _globals['_SCENARIOGOAL']._serialized_start=1490
+  _globals['_SCENARIOGOAL']._serialized_end=1526
+  _globals['_HISTORYTURN']._serialized_start=1528
+  _globals['_HISTORYTURN']._serialized_end=1607
+  _globals['_CHATRESPONSE']._serialized_start=1610
+  _globals['_CHATRESPONSE']._serialized_end=2068
+  _globals['_FEEDBACK']._serialized_start=2071
+  _globals['_FEEDBACK']._serialized_end=2228
+  _globals['_SUMMARIZEREQUEST']._serialized_start=2231
+  _globals['_SUMMARIZEREQUEST']._serialized_end=2390
+  _globals['_VOCABULARYITEM']._serialized_start=2392
+  _globals['_VOCABULARYITEM']._serialized_end=2454
+  _globals['_MISTAKE']._serialized_start=2456
+  _globals['_MISTAKE']._serialized_end=2559
+  _globals['_SUMMARIZERESPONSE']._serialized_start=2562
+  _globals['_SUMMARIZERESPONSE']._serialized_end=2704
 # @@protoc_insertion_point(module_scope)
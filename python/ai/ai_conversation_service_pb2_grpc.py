@@ -20,6 +20,11 @@ class AIConversationServiceStub(object):
                 request_serializer=ai_dot_ai__conversation__pb2.ChatRequest.SerializeToString,
                 response_deserializer=ai_dot_ai__conversation__pb2.ChatResponse.FromString,
                 _registered_method=True)
+        self.Summarize = channel.unary_unary(
+                '/ai.v1.AIConversationService/Summarize',
+                request_serializer=ai_dot_ai__conversation__pb2.SummarizeRequest.SerializeToString,
+                response_deserializer=ai_dot_ai__conversation__pb2.SummarizeResponse.FromString,
+                _registered_method=True)
 
 
 class AIConversationServiceServicer(object):
@@ -34,6 +39,13 @@ class AIConversationServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def Summarize(self, request, context):
+        """Summarizes a finished conversation into topics, vocabulary and mistakes
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_AIConversationServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -42,6 +54,11 @@ def add_AIConversationServiceServicer_to_server(servicer, server):
                     request_deserializer=ai_dot_ai__conversation__pb2.ChatRequest.FromString,
                     response_serializer=ai_dot_ai__conversation__pb2.ChatResponse.SerializeToString,
             ),
+            'Summarize': grpc.unary_unary_rpc_method_handler(
+                    servicer.Summarize,
+                    request_deserializer=ai_dot_ai__conversation__pb2.SummarizeRequest.FromString,
+                    response_serializer=ai_dot_ai__conversation__pb2.SummarizeResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'ai.v1.AIConversationService', rpc_method_handlers)
@@ -80,3 +97,30 @@ class AIConversationService(object):
             timeout,
             metadata,
             _registered_method=True)
+
+    @staticmethod
+    def Summarize(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/ai.v1.AIConversationService/Summarize',
+            ai_dot_ai__conversation__pb2.SummarizeRequest.SerializeToString,
+            ai_dot_ai__conversation__pb2.SummarizeResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
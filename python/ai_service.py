@@ -1,8 +1,12 @@
 import os
 import logging
 import asyncio
+import uuid
 from typing import Dict, Optional
 from dotenv import load_dotenv
+from google.protobuf.timestamp_pb2 import Timestamp
+from ai import ai_conversation_pb2 as ai_pb2
+from controllers.base import TEXT_MESSAGE, AUDIO_CHUNK, TURN_COMPLETE
 from controllers.premium import PremiumController
 from controllers.lite import LiteController
 
@@ -22,10 +26,6 @@ class AIConversationService:
         """
         Handle bidirectional streaming chat using controller's streaming interface.
         """
-        from ai import ai_conversation_pb2 as ai_pb2
-        from google.protobuf.timestamp_pb2 import Timestamp
-        import uuid
-
         # Helper to extract ONLY audio bytes from request_iterator
         async def audio_generator():
             async for request in request_iterator:
@@ -49,118 +49,79 @@ class AIConversationService:
                 logger.info(f"Streaming chat with PremiumController (plan={config.plan})")
                 
                 # Pass the audio generator to the controller
-                async for chunk in controller.process_stream(
-                    audio_generator(), 
-                    config.language, 
-                    config.user_id, 
-                    config.character
-                ):
-                    timestamp = Timestamp()
-                    timestamp.GetCurrentTime()
-                    
-                    yield ai_pb2.ChatResponse(
-                        response_id=str(uuid.uuid4()),
-                        language=config.language,
-                        timestamp=timestamp,
-                        audio_chunk=chunk
-                    )
+                events = controller.process_stream(audio_generator(), config)
             elif config.plan == user_pb2.PLAN_TEST:
-                test_message = "テストモードです、正常に通信できています"
-                logger.info(f"Test mode: {test_message}")
-                
-                async for request in request_iterator:
-                    ct = request.WhichOneof('content')
-                    if ct == 'end_of_input':
-                        logger.info("Test mode: Received end_of_input, sending sample audio and text response")
-                        
-                        # Send text message first
-                        yield ai_pb2.ChatResponse(
-                            response_id=str(uuid.uuid4()),
-                            language=config.language,
-                            timestamp=Timestamp(),
-                            text_message=test_message
-                        )
-
-                        # Send sample file
-                        try:
-                            file_path = os.path.join(os.path.dirname(__file__), 'sample_response.wav')
-                            with open(file_path, 'rb') as f:
-                                audio_data = f.read()
-                            
-                            chunk_size = 4096
-                            for i in range(0, len(audio_data), chunk_size):
-                                chunk = audio_data[i:i+chunk_size]
-                                timestamp = Timestamp()
-                                timestamp.GetCurrentTime()
-                                yield ai_pb2.ChatResponse(
-                                    response_id=str(uuid.uuid4()),
-                                    language=config.language,
-                                    timestamp=timestamp,
-                                    audio_chunk=chunk
-                                )
-                                # Small delay to simulate streaming
-                                await asyncio.sleep(0.01)
-                        except Exception as e:
-                            logger.error(f"Error sending sample audio: {e}")
+                logger.info("Streaming chat in test mode")
+                events = self._test_events(request_iterator)
             else:
                 # Fallback for Lite (non-streaming)
-                controller = LiteController(self.api_key)
+                controller = LiteController(self.api_key, privacy_mode=config.privacy_mode)
                 logger.info(f"Buffered chat with LiteController")
-                
-                audio_buffer = bytearray()
-                async for request in request_iterator:
-                    ct = request.WhichOneof('content')
-                    if ct == 'audio_chunk':
-                        audio_buffer.extend(request.audio_chunk)
-                    elif ct == 'end_of_input':
-                         # Process buffered audio
-                         if len(audio_buffer) > 0:
-                            async for chunk in controller.process_audio(
-                                bytes(audio_buffer), 
-                                config.language, 
-                                config.user_id, 
-                                config.character
-                            ):
-                                timestamp = Timestamp()
-                                timestamp.GetCurrentTime()
-                                
-                                yield ai_pb2.ChatResponse(
-                                    response_id=str(uuid.uuid4()),
-                                    language=config.language,
-                                    timestamp=timestamp,
-                                    audio_chunk=chunk
-                                )
-                            audio_buffer = bytearray() # Clear buffer
-                    elif ct == 'text_message':
-                        pass
+                events = self._buffered_events(controller, request_iterator, config)
+
+            async for response in self._responses(events, config):
+                yield response
 
         except Exception as e:
             logger.error(f"Error in stream_chat: {e}")
 
-    async def process_audio_message(self, audio_data: bytes, language: str, user_id: str, character: str = 'friend', plan_type: int = 2):
-        """Process audio message using the selected controller
+    async def _responses(self, events, config):
+        """Convert the (kind, value) events of a controller into ChatResponses.
+        The messages of a turn share a response_id, so the proxy records them as one turn."""
+        response_id = str(uuid.uuid4())
+        async for kind, value in events:
+            if kind == TURN_COMPLETE:
+                response_id = str(uuid.uuid4())
+                continue
+            timestamp = Timestamp()
+            timestamp.GetCurrentTime()
+            yield ai_pb2.ChatResponse(
+                response_id=response_id,
+                language=config.language,
+                timestamp=timestamp,
+                **{kind: value}
+            )
+
+    async def _buffered_events(self, controller, request_iterator, config):
+        """Buffer the user's audio until end_of_input and process each turn at once"""
+        audio_buffer = bytearray()
+        async for request in request_iterator:
+            ct = request.WhichOneof('content')
+            if ct == 'audio_chunk':
+                audio_buffer.extend(request.audio_chunk)
+            elif ct == 'end_of_input':
+                 # Process buffered audio
+                 if len(audio_buffer) > 0:
+                    async for event in controller.process_audio(bytes(audio_buffer), config):
+                        yield event
+                    audio_buffer = bytearray() # Clear buffer
+            elif ct == 'text_message':
+                pass
+
+    async def _test_events(self, request_iterator):
+        """Answer every end_of_input with a fixed text and sample audio"""
+        test_message = "テストモードです、正常に通信できています"
+        logger.info(f"Test mode: {test_message}")
         
-        Args:
-            plan_type: Plan enum value (0=UNSPECIFIED, 1=FREE, 2=LITE, 3=PREMIUM)
-        Yields:
-            bytes: Audio chunks
-        """
-        try:
-            # Import here to avoid circular dependency
-            from ai import user_pb2
-            
-            # Select controller based on plan_type
-            # PLAN_LITE = 2, PLAN_PREMIUM = 3
-            if plan_type == user_pb2.PLAN_PREMIUM:
-                controller = PremiumController(self.api_key)
-            else:  # LITE, FREE, or UNSPECIFIED
-                controller = LiteController(self.api_key)
-            
-            logger.info(f"Using {controller.__class__.__name__} for plan_type={plan_type}")
+        async for request in request_iterator:
+            ct = request.WhichOneof('content')
+            if ct == 'end_of_input':
+                logger.info("Test mode: Received end_of_input, sending sample audio and text response")
                 
-            async for chunk in controller.process_audio(audio_data, language, user_id, character):
-                yield chunk
-            
-        except Exception as e:
-            logger.error(f"Error processing audio message: {e}")
-            yield b""
+                # Send text message first
+                yield TEXT_MESSAGE, test_message
+
+                # Send sample file
+                try:
+                    file_path = os.path.join(os.path.dirname(__file__), 'sample_response.wav')
+                    with open(file_path, 'rb') as f:
+                        audio_data = f.read()
+                    
+                    chunk_size = 4096
+                    for i in range(0, len(audio_data), chunk_size):
+                        yield AUDIO_CHUNK, audio_data[i:i+chunk_size]
+                        # Small delay to simulate streaming
+                        await asyncio.sleep(0.01)
+                except Exception as e:
+                    logger.error(f"Error sending sample audio: {e}")
+                yield TURN_COMPLETE, None
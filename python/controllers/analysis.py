@@ -0,0 +1,143 @@
+import json
+import logging
+from google import genai
+from google.genai import types
+from ai import ai_conversation_pb2 as ai_pb2
+from .base import MEMORY, FEEDBACK, GOAL_ACHIEVED, PLACEMENT_SCORE
+from .prompts import language_name
+
+logger = logging.getLogger(__name__)
+
+ANALYSIS_INSTRUCTION = """You are a language teacher listening to a learner practicing {language_name} with an AI character.
+Review the learner's latest utterance and answer as JSON with these keys:
+{keys}
+Use empty lists when there is nothing to report."""
+
+PLACEMENT_CRITERIA = ('grammar', 'vocabulary', 'fluency', 'task_completion')
+
+FEEDBACK_CATEGORIES = {
+    'grammar': ai_pb2.FEEDBACK_CATEGORY_GRAMMAR,
+    'vocabulary': ai_pb2.FEEDBACK_CATEGORY_VOCABULARY,
+    'pronunciation': ai_pb2.FEEDBACK_CATEGORY_PRONUNCIATION,
+}
+
+
+class TurnAnalyzer:
+    """Reviews each utterance of the learner for what the proxy keeps besides the transcript"""
+
+    def __init__(self, client: genai.Client, model_id: str, config):
+        self.client = client
+        self.model_id = model_id
+        self.config = config
+        # Indexes of the scenario goals already reported, which are not asked about again
+        self.achieved = set()
+        # IDs of the placement prompts already scored
+        self.scored = set()
+
+    def _keys(self) -> list:
+        """Describe the JSON keys to answer with; nothing is asked for in privacy mode but what the session needs"""
+        if self.config.placement_test:
+            return self._placement_keys()
+        native = language_name(self.config.native_language or self.config.language)
+        # Feedback is only shown to the learner, so it is given in privacy mode too
+        keys = [
+            f'- "feedback": up to 3 corrections of clear mistakes in the learner\'s utterance, each an object with '
+            f'"original" (the wrong span, copied from the utterance), "correction" (what a native speaker would say instead), '
+            f'"category" ("grammar", "vocabulary" or "pronunciation") and "explanation" (one short sentence in {native}). '
+            f'Do not correct valid informal speech or style.'
+        ]
+        if not self.config.privacy_mode:
+            known = "; ".join(self.config.memories) or "nothing yet"
+            keys.append(
+                f'- "memories": up to 2 short facts the learner revealed about themselves that are worth remembering '
+                f'in later conversations (e.g. family, pets, work, plans), each one sentence in {native}. '
+                f'Already known: {known}. Do not repeat known facts.'
+            )
+        if self.config.HasField('scenario'):
+            goals = "; ".join(
+                f"{i}: {goal}" for i, goal in enumerate(self.config.scenario.goals) if i not in self.achieved
+            )
+            if goals:
+                keys.append(
+                    f'- "goals_achieved": the numbers of the role-play goals the learner accomplished with this utterance, '
+                    f'from these open goals: {goals}'
+                )
+        return keys
+
+    def _placement_keys(self) -> list:
+        """Placement tests are only scored: the learner gets no feedback while being assessed"""
+        prompts = "\n".join(
+            f'  {prompt.prompt_id} ({prompt.level}): {prompt.text}'
+            for prompt in self.config.placement_prompts if prompt.prompt_id not in self.scored
+        )
+        if not prompts:
+            return []
+        return [
+            f'- "placement_score": null unless the utterance answers one of these questions of a placement test:\n{prompts}\n'
+            f'  Otherwise an object with "prompt_id" (the question answered) and the integers "grammar", "vocabulary", '
+            f'"fluency" and "task_completion", each from 0 (none) to 4 (native-like) for the level of the question'
+        ]
+
+    def _placement_score(self, score) -> ai_pb2.PlacementScore | None:
+        """Convert a score of the model, or return None when it is malformed or scores a prompt again"""
+        if not isinstance(score, dict):
+            return None
+        prompt_id = score.get('prompt_id')
+        if prompt_id in self.scored or prompt_id not in {p.prompt_id for p in self.config.placement_prompts}:
+            return None
+        rubric = {}
+        for criterion in PLACEMENT_CRITERIA:
+            value = score.get(criterion)
+            if not isinstance(value, int):
+                return None
+            rubric[criterion] = min(max(value, 0), 4)
+        self.scored.add(prompt_id)
+        return ai_pb2.PlacementScore(prompt_id=prompt_id, **rubric)
+
+    async def analyze(self, question: str, answer: str) -> list:
+        """Analyze the learner's answer to the AI's question and return (kind, value) events"""
+        keys = self._keys()
+        if not keys or not answer.strip():
+            return []
+        try:
+            response = await self.client.aio.models.generate_content(
+                model=self.model_id,
+                contents=[
+                    types.Content(
+                        parts=[
+                            types.Part(text=ANALYSIS_INSTRUCTION.format(
+                                language_name=language_name(self.config.language),
+                                keys="\n".join(keys),
+                            )),
+                            types.Part(text=f"AI: {question}\nLearner: {answer}" if question else f"Learner: {answer}"),
+                        ]
+                    )
+                ],
+                config=types.GenerateContentConfig(response_mime_type="application/json"),
+            )
+            result = json.loads(response.text or "{}")
+        except Exception as e:
+            logger.error(f"Error analyzing the learner's utterance: {e}")
+            return []
+
+        events = []
+        score = self._placement_score(result.get('placement_score'))
+        if score is not None:
+            events.append((PLACEMENT_SCORE, score))
+        for item in result.get('feedback', []):
+            if not isinstance(item, dict) or not item.get('original') or not item.get('correction'):
+                continue
+            events.append((FEEDBACK, ai_pb2.Feedback(
+                original=str(item['original']),
+                correction=str(item['correction']),
+                category=FEEDBACK_CATEGORIES.get(str(item.get('category', '')).lower(), ai_pb2.FEEDBACK_CATEGORY_UNSPECIFIED),
+                explanation=str(item.get('explanation', '')),
+            )))
+        for index in result.get('goals_achieved', []):
+            if isinstance(index, int) and 0 <= index < len(self.config.scenario.goals) and index not in self.achieved:
+                self.achieved.add(index)
+                events.append((GOAL_ACHIEVED, ai_pb2.ScenarioGoal(index=index)))
+        for memory in result.get('memories', []):
+            if isinstance(memory, str) and memory.strip():
+                events.append((MEMORY, memory.strip()))
+        return events
@@ -1,7 +1,19 @@
 from abc import ABC, abstractmethod
 
+# Kinds of the events controllers yield as (kind, value) pairs. All but TURN_COMPLETE are
+# fields of ChatResponse the value is sent in; TURN_COMPLETE ends the AI's answer to a turn.
+USER_TRANSCRIPT = 'user_transcript'
+TEXT_MESSAGE = 'text_message'
+AUDIO_CHUNK = 'audio_chunk'
+MEMORY = 'memory'
+FEEDBACK = 'feedback'
+GOAL_ACHIEVED = 'goal_achieved'
+PLACEMENT_SCORE = 'placement_score'
+TURN_COMPLETE = 'turn_complete'
+
+
 class AIController(ABC):
     @abstractmethod
-    async def process_audio(self, audio_data: bytes, language: str, user_id: str, character: str) -> bytes:
-        """Process audio input and return audio output"""
+    async def process_audio(self, audio_data: bytes, config):
+        """Process a turn of the user's audio and yield (kind, value) events of the answer"""
         pass
@@ -1,4 +1,5 @@
 import os
+import asyncio
 import logging
 import io
 import re
@@ -7,35 +8,23 @@ from google import genai
 from google.genai import types
 from gtts import gTTS
 from pydub import AudioSegment
-from .base import AIController
+from .base import AIController, USER_TRANSCRIPT, TEXT_MESSAGE, AUDIO_CHUNK, TURN_COMPLETE
+from .prompts import conversation_instruction, language_name
+from .analysis import TurnAnalyzer
 
 logger = logging.getLogger(__name__)
 
-# Character definitions (Should be shared)
-CHARACTERS = {
-    'friend': {
-        'system_instruction': """You are Juan, a friendly and casual AI companion. 
-You are helpful, witty, and engaging. You speak naturally with a friendly tone.
-Keep your responses concise and conversational."""
-    },
-    'parent': {
-        'system_instruction': """You are a caring parent figure. 
-You are supportive, wise, and patient. You give good advice and care about the user's well-being.
-Speak with a warm and nurturing tone."""
-    },
-    'sister': {
-        'system_instruction': """You are a playful younger sister. 
-You are energetic, sometimes teasing, but affectionate. You like to share stories and ask questions.
-Speak with a lively and youthful tone."""
-    }
-}
-
 class LiteController(AIController):
-    def __init__(self, api_key: str):
+    def __init__(self, api_key: str, privacy_mode: bool = False):
         self.client = genai.Client(api_key=api_key)
         self.model_id = "gemini-2.0-flash"
+        # In privacy mode the user wants nothing recorded, so content is never logged
+        self.privacy_mode = privacy_mode
+        # The last answer, which the user's next utterance replies to
+        self.last_reply = ""
+        self.analyzer = None
 
-    async def process_stream(self, audio_iterator, language: str, user_id: str, character: str):
+    async def process_stream(self, audio_iterator, config):
         """Process continuous audio stream (Bridge to non-streaming for Light model for now)"""
         # Light controller might not support true streaming yet or uses different API
         # So we accumulate and call process_audio
@@ -47,11 +36,11 @@ class LiteController(AIController):
         # Ah, we need a way to detect "turn end" inside the stream if we want partial responses.
         # But if we are bridging, we wait for full input.
         
-        async for chunk in self.process_audio(bytes(audio_buffer), language, user_id, character):
-            yield chunk
+        async for event in self.process_audio(bytes(audio_buffer), config):
+            yield event
 
-    async def process_audio(self, audio_data: bytes, language: str, user_id: str, character: str):
-        """Process audio message using Gemini 1.5 Flash (Light) STT -> LLM) + TTS with streaming"""
+    async def process_audio(self, audio_data: bytes, config):
+        """Process a turn of audio: Gemini STT -> LLM -> TTS, yielding the transcript, then the answer sentence by sentence"""
         try:
             # 1. Audio to Text (using Gemini Multimodal)
             # Convert raw PCM 16kHz to WAV for Gemini
@@ -65,45 +54,29 @@ class LiteController(AIController):
             audio_segment.export(wav_io, format="wav")
             wav_bytes = wav_io.getvalue()
 
-            # Generate content with audio
-            char_config = CHARACTERS.get(character, CHARACTERS['friend'])
-
-            # Map language codes to full language names for better clarity
-            language_map = {
-                'en': 'English',
-                'ja': 'Japanese',
-                'vi': 'Vietnamese'
-            }
-            language_name = language_map.get(language, language)
-
-            # Enhanced system instruction with language requirement
-            enhanced_instruction = f"""{char_config['system_instruction']}
-
-CRITICAL REQUIREMENTS:
-- You MUST respond ONLY in {language_name} language (language code: {language})
-- The user is speaking to you in {language_name}
-- ALL of your responses must be in {language_name}
-- Do NOT use any other language in your response
-- Match the language that the user is using in the audio
-- Do NOT use emojis or emoticons (e.g. 😊, ^^, :))
-- Do NOT use Markdown formatting (e.g. **bold**, *italic*)
-- Do NOT describe actions or expressions in text (e.g. *laughs*, (smiling))
-- Provide ONLY the spoken response text"""
+            language = config.language
+            name = language_name(language)
+            transcript = await self._transcribe(wav_bytes, name)
+            if not transcript:
+                logger.info("No speech in the user's audio")
+                return
+            logger.info(f"User said: {self._loggable(transcript)}")
+            yield USER_TRANSCRIPT, transcript
+            # The utterance is analyzed while it is answered; the results are sent once ready, within the turn
+            if self.analyzer is None:
+                self.analyzer = TurnAnalyzer(self.client, self.model_id, config)
+            analysis = asyncio.create_task(self.analyzer.analyze(self.last_reply, transcript))
+            reply = ""
 
+            # 2. Answer the transcript
             # Use generate_content_stream for streaming response
-            response_stream = self.client.models.generate_content_stream(
+            response_stream = await self.client.aio.models.generate_content_stream(
                 model=self.model_id,
                 contents=[
                     types.Content(
                         parts=[
-                            types.Part(text=enhanced_instruction),
-                            types.Part(text=f"Listen to the audio message from the user (speaking in {language_name}) and respond naturally in the SAME language ({language_name})."),
-                            types.Part(
-                                inline_data=types.Blob(
-                                    mime_type="audio/wav",
-                                    data=wav_bytes
-                                )
-                            )
+                            types.Part(text=conversation_instruction(config, config.character_definition.persona)),
+                            types.Part(text=f"The user said (in {name}): {transcript}\nRespond naturally in the SAME language ({name})."),
                         ]
                     )
                 ]
@@ -111,7 +84,7 @@ CRITICAL REQUIREMENTS:
             
             text_buffer = ""
             
-            for chunk in response_stream:
+            async for chunk in response_stream:
                 text_chunk = chunk.text
                 if not text_chunk: continue
                 
@@ -140,10 +113,16 @@ CRITICAL REQUIREMENTS:
                         clean_sentence = self._clean_text_for_tts(sentence)
                         if not clean_sentence.strip(): continue
 
-                        logger.info(f"Generating TTS for chunk: {clean_sentence}")
+                        logger.info(f"Generating TTS for chunk: {self._loggable(clean_sentence)}")
+                        reply += sentence
+                        yield TEXT_MESSAGE, sentence
                         audio_chunk = self._generate_tts(clean_sentence, language)
                         if audio_chunk:
-                            yield audio_chunk
+                            yield AUDIO_CHUNK, audio_chunk
+                        if analysis is not None and analysis.done():
+                            for event in analysis.result():
+                                yield event
+                            analysis = None
                     
                     # Keep the incomplete part in buffer
                     text_buffer = current_sentence
@@ -152,15 +131,48 @@ CRITICAL REQUIREMENTS:
             if text_buffer.strip():
                 clean_text = self._clean_text_for_tts(text_buffer)
                 if clean_text.strip():
-                    logger.info(f"Generating TTS for final chunk: {clean_text}")
+                    logger.info(f"Generating TTS for final chunk: {self._loggable(clean_text)}")
+                    reply += text_buffer
+                    yield TEXT_MESSAGE, text_buffer
                     audio_chunk = self._generate_tts(clean_text, language)
                     if audio_chunk:
-                        yield audio_chunk
+                        yield AUDIO_CHUNK, audio_chunk
+            if analysis is not None:
+                for event in await analysis:
+                    yield event
+            self.last_reply = reply
+            yield TURN_COMPLETE, None
 
         except Exception as e:
             logger.error(f"Error in LightController: {e}")
             raise
 
+    async def _transcribe(self, wav_bytes: bytes, name: str) -> str:
+        """Transcribe the user's speech, returning an empty string when nothing was said"""
+        response = await self.client.aio.models.generate_content(
+            model=self.model_id,
+            contents=[
+                types.Content(
+                    parts=[
+                        types.Part(text=f"Transcribe what the speaker says in {name}, word for word, without translating or correcting it. Reply with the transcript only, or with nothing if there is no speech."),
+                        types.Part(
+                            inline_data=types.Blob(
+                                mime_type="audio/wav",
+                                data=wav_bytes
+                            )
+                        )
+                    ]
+                )
+            ]
+        )
+        return (response.text or "").strip()
+
+    def _loggable(self, text: str) -> str:
+        """Return the text to log, or only its length in privacy mode"""
+        if self.privacy_mode:
+            return f"<{len(text)} chars>"
+        return text
+
     def _clean_text_for_tts(self, text: str) -> str:
         """Clean text for TTS: remove markdown, emojis, actions in brackets"""
         # Remove bold/italic markdown
@@ -4,35 +4,12 @@ import logging
 from typing import Dict, Optional
 from google import genai
 from google.genai import types
-from .base import AIController
+from .base import AIController, USER_TRANSCRIPT, TEXT_MESSAGE, AUDIO_CHUNK, TURN_COMPLETE
+from .prompts import conversation_instruction
+from .analysis import TurnAnalyzer
 
 logger = logging.getLogger(__name__)
 
-# Character definitions (Shared configuration could be moved to a config file)
-CHARACTERS = {
-    'friend': {
-        'name': 'Juan',
-        'voice_name': 'Puck',
-        'system_instruction': """You are Juan, a friendly and casual AI companion. 
-You are helpful, witty, and engaging. You speak naturally with a friendly tone.
-Keep your responses concise and conversational."""
-    },
-    'parent': {
-        'name': 'Mother',
-        'voice_name': 'Aoede',
-        'system_instruction': """You are a caring parent figure. 
-You are supportive, wise, and patient. You give good advice and care about the user's well-being.
-Speak with a warm and nurturing tone."""
-    },
-    'sister': {
-        'name': 'Sister',
-        'voice_name': 'Fenrir',
-        'system_instruction': """You are a playful younger sister. 
-You are energetic, sometimes teasing, but affectionate. You like to share stories and ask questions.
-Speak with a lively and youthful tone."""
-    }
-}
-
 class GeminiLiveSession:
     def __init__(self, client: genai.Client, model_id: str, config: types.LiveConnectConfig):
         self.client = client
@@ -70,43 +47,51 @@ class GeminiLiveSession:
                 raise
 
     async def _receive_loop(self):
-        """Background loop to receive responses from Gemini"""
+        """Background loop to receive responses from Gemini as (kind, value) events; None ends the session"""
         try:
             logger.info("Starting receive loop")
             async for response in self.session.receive():
-                if response.server_content:
-                    if response.server_content.model_turn:
-                        for part in response.server_content.model_turn.parts:
+                content = response.server_content
+                if content:
+                    # The learner's speech and the spoken answer are transcribed as they stream
+                    if content.input_transcription and content.input_transcription.text:
+                        await self.response_queue.put((USER_TRANSCRIPT, content.input_transcription.text))
+                    if content.model_turn:
+                        for part in content.model_turn.parts:
                             if part.inline_data:
                                 # logger.info(f"Received audio chunk: {len(part.inline_data.data)} bytes")
-                                await self.response_queue.put(part.inline_data.data)
+                                await self.response_queue.put((AUDIO_CHUNK, part.inline_data.data))
+                    if content.output_transcription and content.output_transcription.text:
+                        await self.response_queue.put((TEXT_MESSAGE, content.output_transcription.text))
                     
-                    if response.server_content.turn_complete:
+                    if content.turn_complete:
                         logger.info("Turn complete signal received")
-                        await self.response_queue.put(None)
+                        await self.response_queue.put((TURN_COMPLETE, None))
                 else:
                     # logger.info("Received response without server_content")
                     pass
                     
         except Exception as e:
             logger.error(f"Error in receive loop: {e}")
-            await self.response_queue.put(None)
+        await self.response_queue.put(None)
 
     async def process_audio(self, audio_data: bytes):
-        """Send audio and yield response chunks"""
+        """Send audio and yield the events of the response"""
         if not self.session:
             await self.connect()
 
         # Send audio chunk
         await self.session.send(input=audio_data, end_of_turn=True)
         
-        # Yield response audio chunks as they arrive
+        # Yield response events as they arrive
         while True:
             try:
-                chunk = await asyncio.wait_for(self.response_queue.get(), timeout=10.0)
-                if chunk is None:
+                event = await asyncio.wait_for(self.response_queue.get(), timeout=10.0)
+                if event is None:
+                    break
+                yield event
+                if event[0] == TURN_COMPLETE:
                     break
-                yield chunk
             except asyncio.TimeoutError:
                 logger.warning("Timeout waiting for audio response")
                 break
@@ -134,39 +119,47 @@ class PremiumController(AIController):
         self.sessions: Dict[str, GeminiLiveSession] = {}
         self.model_id = "gemini-2.0-flash-exp"
         # self.model_id = "gemini-2.0-flash-live-001" # Experimental model for Live API
+        # Utterances are analyzed with a text model, as the Live model only answers in audio
+        self.analysis_model_id = "gemini-2.0-flash"
 
-    async def get_session(self, user_id: str, character: str = 'friend') -> GeminiLiveSession:
+    async def get_session(self, config) -> GeminiLiveSession:
         """Get or create a session for the user"""
-        session_key = f"{user_id}_{character}"
+        session_key = f"{config.user_id}_{config.character}"
         
         if session_key not in self.sessions:
-            char_config = CHARACTERS.get(character, CHARACTERS['friend'])
-            
-            config = types.LiveConnectConfig(
+            character = config.character_definition
+            live_config = types.LiveConnectConfig(
                 response_modalities=["AUDIO"],
-                system_instruction=types.Content(parts=[types.Part(text=char_config['system_instruction'])]),
-                speech_config=types.SpeechConfig(
+                system_instruction=types.Content(parts=[types.Part(text=conversation_instruction(config, character.persona))]),
+                input_audio_transcription=types.AudioTranscriptionConfig(),
+                output_audio_transcription=types.AudioTranscriptionConfig(),
+            )
+            # Without a voice for the language the Live API picks its default one
+            if character.voice:
+                live_config.speech_config = types.SpeechConfig(
                     voice_config=types.VoiceConfig(
                         prebuilt_voice_config=types.PrebuiltVoiceConfig(
-                            voice_name=char_config['voice_name']
+                            voice_name=character.voice
                         )
                     )
                 )
-            )
             
-            session = GeminiLiveSession(self.client, self.model_id, config)
+            session = GeminiLiveSession(self.client, self.model_id, live_config)
             await session.connect()
             self.sessions[session_key] = session
             
         return self.sessions[session_key]
 
-    async def process_stream(self, audio_iterator, language: str, user_id: str, character: str):
+    async def process_stream(self, audio_iterator, config):
         """Process continuous audio stream using Gemini Live API"""
         try:
-            session = await self.get_session(user_id, character)
+            session = await self.get_session(config)
             
             # Start a background task to send incoming audio to Gemini
             send_task = asyncio.create_task(self._send_stream_to_gemini(session, audio_iterator))
+            analyzer = TurnAnalyzer(self.client, self.analysis_model_id, config)
+            analysis_tasks = set()
+            utterance, reply, last_reply = "", "", ""
             
             # Yield responses as they come back from Gemini
             while True:
@@ -175,21 +168,30 @@ class PremiumController(AIController):
                     if send_task.done() and send_task.exception():
                         raise send_task.exception()
                     
-                    # Wait for next response chunk from Gemini session queue
+                    # Wait for next event from Gemini session queue
                     # We use a short timeout to periodically check send_task status and stream status
                     try:
-                        chunk = await asyncio.wait_for(session.response_queue.get(), timeout=0.1)
-                        if chunk is None: # Turn complete or stream end?
-                             # In bidirectional stream, we might get multiple turns.
-                             # If we treat specific token as turn complete, we can notify or just continue.
-                             # For now, let's continue yielding if it's just end of a turn, 
-                             # but session might put None on close.
-                             # Let's adjust session logic later.
-                             pass
-                        else:
-                            yield chunk
+                        event = await asyncio.wait_for(session.response_queue.get(), timeout=0.1)
                     except asyncio.TimeoutError:
                         continue
+                    # The session puts None once Gemini closes it; a turn_complete only ends a turn
+                    if event is None:
+                        break
+                    kind, value = event
+                    if kind == USER_TRANSCRIPT:
+                        utterance += value
+                    elif kind in (AUDIO_CHUNK, TEXT_MESSAGE) and utterance:
+                        # The answer has started, so the utterance is complete. Its analysis is queued
+                        # with the session's events, so it arrives while the answer is still playing.
+                        task = asyncio.create_task(self._analyze(session, analyzer, last_reply, utterance))
+                        analysis_tasks.add(task)
+                        task.add_done_callback(analysis_tasks.discard)
+                        utterance = ""
+                    if kind == TEXT_MESSAGE:
+                        reply += value
+                    elif kind == TURN_COMPLETE:
+                        last_reply, reply = reply, ""
+                    yield event
                     
                 except asyncio.CancelledError:
                     break
@@ -198,15 +200,22 @@ class PremiumController(AIController):
                     break
             
             send_task.cancel()
+            for task in analysis_tasks:
+                task.cancel()
             
         except Exception as e:
             logger.error(f"Error processing stream in PremiumController: {e}")
-            session_key = f"{user_id}_{character}"
+            session_key = f"{config.user_id}_{config.character}"
             if session_key in self.sessions:
                 await self.sessions[session_key].close()
                 del self.sessions[session_key]
             raise
 
+    async def _analyze(self, session: GeminiLiveSession, analyzer: TurnAnalyzer, question: str, answer: str):
+        """Analyze an utterance and queue the resulting events with the session's"""
+        for event in await analyzer.analyze(question, answer):
+            await session.response_queue.put(event)
+
     async def _send_stream_to_gemini(self, session: GeminiLiveSession, audio_iterator):
         """Consume audio_iterator and send to Gemini session"""
         try:
@@ -215,16 +224,16 @@ class PremiumController(AIController):
         except Exception as e:
             logger.error(f"Error sending stream to Gemini: {e}")
 
-    async def process_audio(self, audio_data: bytes, language: str, user_id: str, character: str):
+    async def process_audio(self, audio_data: bytes, config):
         """Process audio message using Gemini Live API (Legacy full buffer mode)"""
         try:
-            session = await self.get_session(user_id, character)
-            async for chunk in session.process_audio(audio_data):
-                yield chunk
+            session = await self.get_session(config)
+            async for event in session.process_audio(audio_data):
+                yield event
         except Exception as e:
             logger.error(f"Error processing audio message in PremiumController: {e}")
             # Clean up session on error
-            session_key = f"{user_id}_{character}"
+            session_key = f"{config.user_id}_{config.character}"
             if session_key in self.sessions:
                 await self.sessions[session_key].close()
                 del self.sessions[session_key]
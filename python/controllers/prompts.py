@@ -0,0 +1,70 @@
+LANGUAGE_NAMES = {
+    'en': 'English',
+    'ja': 'Japanese',
+    'vi': 'Vietnamese'
+}
+
+# How to speak to a learner of each CEFR level, sent as ChatConfiguration.difficulty
+LEVEL_GUIDANCE = {
+    'CEFR_LEVEL_A1': 'a beginner (CEFR A1): use very short sentences, the most common words and the present tense, and speak slowly',
+    'CEFR_LEVEL_A2': 'an elementary learner (CEFR A2): use short, simple sentences and everyday vocabulary',
+    'CEFR_LEVEL_B1': 'an intermediate learner (CEFR B1): use clear sentences on familiar topics and explain uncommon words',
+    'CEFR_LEVEL_B2': 'an upper-intermediate learner (CEFR B2): speak naturally, with some idioms and complex sentences',
+    'CEFR_LEVEL_C1': 'an advanced learner (CEFR C1): speak as with a native speaker, including idioms and nuance',
+    'CEFR_LEVEL_C2': 'a near-native speaker (CEFR C2): speak as with a native speaker, including idioms, slang and nuance',
+}
+
+
+def language_name(language: str) -> str:
+    """Return the English name of a language code, or the code when it is unknown"""
+    return LANGUAGE_NAMES.get(language, language)
+
+
+def conversation_instruction(config, persona: str) -> str:
+    """Build the system instruction of a conversation from the character's persona and the session's configuration"""
+    name = language_name(config.language)
+    instruction = f"""{persona}
+
+CRITICAL REQUIREMENTS:
+- You MUST respond ONLY in {name} language (language code: {config.language})
+- The user is speaking to you in {name}
+- ALL of your responses must be in {name}
+- Do NOT use any other language in your response
+- Match the language that the user is using in the audio
+- Do NOT use emojis or emoticons (e.g. 😊, ^^, :))
+- Do NOT use Markdown formatting (e.g. **bold**, *italic*)
+- Do NOT describe actions or expressions in text (e.g. *laughs*, (smiling))
+- Provide ONLY the spoken response text"""
+    # The placement test assesses the level, so its questions are not adapted to a previous one
+    if config.difficulty in LEVEL_GUIDANCE and not config.placement_test:
+        instruction += f"""
+- The user is {LEVEL_GUIDANCE[config.difficulty]}"""
+    if config.placement_test:
+        questions = "\n".join(f"{i + 1}. {prompt.text}" for i, prompt in enumerate(config.placement_prompts))
+        instruction += f"""
+
+PLACEMENT TEST: you are assessing the user's level. Ask these questions one at a time, in order, exactly as written.
+After each answer, react in one short sentence and ask the next question. Do not correct, teach or help with the answers.
+After the last answer, thank the user and tell them the test is finished:
+{questions}"""
+    if config.HasField('scenario'):
+        scenario = config.scenario
+        goals = "\n".join(f"{i + 1}. {goal}" for i, goal in enumerate(scenario.goals))
+        instruction += f"""
+
+ROLE-PLAY SCENARIO: {scenario.title}
+{scenario.description}
+{scenario.setup_prompt}
+Stay in the scenario and lead the user through its goals, in order, without listing them:
+{goals}"""
+        if scenario.vocabulary:
+            instruction += f"""
+Give the user chances to use these words and phrases: {", ".join(scenario.vocabulary)}"""
+    if config.memories:
+        facts = "\n".join(f"- {memory}" for memory in config.memories)
+        instruction += f"""
+
+WHAT YOU REMEMBER ABOUT THE USER from earlier conversations, most recent first.
+Bring it up naturally when it fits the conversation; never recite the list:
+{facts}"""
+    return instruction
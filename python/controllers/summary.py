@@ -0,0 +1,49 @@
+import json
+import logging
+from google import genai
+from google.genai import types
+from .prompts import LANGUAGE_NAMES
+
+logger = logging.getLogger(__name__)
+
+SUMMARY_INSTRUCTION = """You are a language teacher reviewing a conversation between a learner and an AI character.
+The learner is practicing {language_name}. Write a short recap for the learner as JSON with these keys:
+- "topics": up to 5 short phrases describing what was talked about
+- "vocabulary": up to 10 objects with "term" (a {language_name} word or phrase from the conversation worth learning) and "meaning" (a short explanation)
+- "mistakes": objects with "original" (what the learner said wrong), "correction" (the natural way to say it) and "explanation" (why)
+Only list mistakes the learner actually made. Use empty lists when there is nothing to report."""
+
+
+class SummaryController:
+    def __init__(self, api_key: str):
+        self.client = genai.Client(api_key=api_key)
+        self.model_id = "gemini-2.0-flash"
+
+    async def summarize(self, language: str, turns) -> dict:
+        """Summarize the turns of a conversation into topics, vocabulary and mistakes"""
+        language_name = LANGUAGE_NAMES.get(language, language)
+        transcript = "\n".join(
+            line
+            for turn in turns
+            for line in (
+                f"Learner: {turn.user_transcript}" if turn.user_transcript else "",
+                f"AI: {turn.ai_text}" if turn.ai_text else "",
+            )
+            if line
+        )
+
+        response = await self.client.aio.models.generate_content(
+            model=self.model_id,
+            contents=[
+                types.Content(
+                    parts=[
+                        types.Part(text=SUMMARY_INSTRUCTION.format(language_name=language_name)),
+                        types.Part(text=transcript),
+                    ]
+                )
+            ],
+            config=types.GenerateContentConfig(response_mime_type="application/json"),
+        )
+        result = json.loads(response.text or "{}")
+        logger.info(f"Summarized {len(turns)} turns into {len(result.get('topics', []))} topics")
+        return result
@@ -18,6 +18,7 @@ from ai import ai_conversation_pb2 as ai_pb2
 from ai import ai_conversation_service_pb2_grpc as ai_grpc
 
 from ai_service import AIConversationService
+from controllers.summary import SummaryController
 
 logging.basicConfig(
     level=logging.INFO,
@@ -83,6 +84,11 @@ class AIConversationServicer(ai_grpc.AIConversationServiceServicer):
                 return
 
             config = first_msg.setup
+            # Characters are defined in the proxy's catalog, which sends the definition of every session
+            if not config.HasField('character_definition') or not config.character_definition.persona:
+                context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+                context.set_details(f"Setup must define character {config.character!r}")
+                return
             logger.info(f"[{request_id}] Starting chat for user {config.username} ({config.user_id})")
 
             async for response in self.ai_service.stream_chat(
@@ -96,6 +102,36 @@ class AIConversationServicer(ai_grpc.AIConversationServiceServicer):
             context.set_code(grpc.StatusCode.INTERNAL)
             context.set_details(f"StreamChat error: {str(e)}")
 
+    async def Summarize(self, request, context):
+        """Summarize a finished conversation for the learner to review"""
+        metadata = dict(context.invocation_metadata())
+        request_id = metadata.get('request-id', 'unknown')
+
+        try:
+            logger.info(f"[{request_id}] Summarizing conversation {request.conversation_id} ({len(request.turns)} turns)")
+            controller = SummaryController(self.ai_service.api_key)
+            result = await controller.summarize(request.language, request.turns)
+
+            return ai_pb2.SummarizeResponse(
+                topics=[str(topic) for topic in result.get('topics', [])],
+                vocabulary=[
+                    ai_pb2.VocabularyItem(term=item.get('term', ''), meaning=item.get('meaning', ''))
+                    for item in result.get('vocabulary', [])
+                ],
+                mistakes=[
+                    ai_pb2.Mistake(
+                        original=item.get('original', ''),
+                        correction=item.get('correction', ''),
+                        explanation=item.get('explanation', '')
+                    )
+                    for item in result.get('mistakes', [])
+                ],
+            )
+
+        except Exception as e:
+            logger.error(f"[{request_id}] Summarize error: {e}")
+            await context.abort(grpc.StatusCode.UNAVAILABLE, f"Summarize error: {str(e)}")
+
 async def serve():
     """Start the gRPC server"""
     # Create interceptors